@@ -46,7 +46,7 @@ func main() {
 	}
 
 	// Initialize application with dependency injection
-	r, cleanup, err := wire.InitializeApp(cfg)
+	app, cleanup, err := wire.InitializeApp(cfg)
 	if err != nil {
 		log.Fatalf("Failed to initialize application: %v", err)
 	}
@@ -54,8 +54,11 @@ func main() {
 
 	log.Printf("Starting Viblog API server on port %s (env: %s)", cfg.Server.Port, cfg.Server.Env)
 
+	// Start background jobs; cleanup stops them
+	app.Scheduler.Start()
+
 	// Setup routes
-	engine := r.Setup()
+	engine := app.Router.Setup()
 
 	// Create HTTP server
 	srv := &http.Server{
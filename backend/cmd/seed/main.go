@@ -40,8 +40,8 @@ func main() {
 		Email:    "admin@viblog.com",
 		Password: string(hashedPassword),
 		Nickname: "Admin",
-		IsAdmin:  true,
 	}
+	admin.SetRole(entity.RoleAdmin)
 	db.Create(admin)
 	fmt.Println("✓ Created admin user (email: admin@viblog.com, password: admin123)")
 
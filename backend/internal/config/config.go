@@ -16,6 +16,16 @@ type Config struct {
 	RateLimit  RateLimitConfig
 	Logging    LoggingConfig
 	Monitoring MonitoringConfig
+	I18n       I18nConfig
+	Mail       MailConfig
+	Account    AccountConfig
+	OAuth      OAuthConfig
+	MFA        MFAConfig
+	Login      LoginThrottleConfig
+	Comment    CommentConfig
+	Spam       SpamConfig
+	Analytics  AnalyticsConfig
+	Audit      AuditConfig
 }
 
 // ServerConfig holds server-related configuration
@@ -38,12 +48,32 @@ type DatabaseConfig struct {
 	ConnMaxLifetime time.Duration
 }
 
+// JWT signing algorithms
+const (
+	JWTAlgorithmHS256 = "HS256" // Shared secrets; tokens can only be verified by this service
+	JWTAlgorithmRS256 = "RS256"
+	JWTAlgorithmEdDSA = "EdDSA"
+)
+
 // JWTConfig holds JWT-related configuration
 type JWTConfig struct {
-	Secret              string
-	RefreshSecret       string
-	AccessTokenExpires  time.Duration
-	RefreshTokenExpires time.Duration
+	Algorithm     string // HS256, RS256 or EdDSA
+	Secret        string // HS256 only
+	RefreshSecret string // HS256 only
+	// KeysDir holds the RS256/EdDSA private keys. Instances behind a load
+	// balancer must share it so they sign and verify with the same keys.
+	KeysDir string
+	// KeyRotationInterval is the age at which a new signing key is generated.
+	// Replaced keys keep verifying until tokens signed with them expire. 0 disables rotation.
+	KeyRotationInterval    time.Duration
+	AccessTokenExpires     time.Duration
+	RefreshTokenExpires    time.Duration
+	MFAPendingTokenExpires time.Duration // Time allowed to enter a second factor after the password
+}
+
+// IsAsymmetric reports whether tokens are signed with a private key
+func (c JWTConfig) IsAsymmetric() bool {
+	return c.Algorithm != JWTAlgorithmHS256
 }
 
 // CORSConfig holds CORS-related configuration
@@ -60,6 +90,8 @@ type RateLimitConfig struct {
 	APIWindow       time.Duration
 	CommentRequests int
 	CommentWindow   time.Duration
+	AccountRequests int
+	AccountWindow   time.Duration
 }
 
 // LoggingConfig holds logging configuration
@@ -75,6 +107,154 @@ type MonitoringConfig struct {
 	Port    string
 }
 
+// I18nConfig holds localization configuration
+type I18nConfig struct {
+	DefaultLocale    string
+	SupportedLocales []string
+}
+
+// MailConfig holds outgoing mail configuration
+type MailConfig struct {
+	Driver       string // smtp, file or log
+	From         string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	FileDir      string
+
+	// AddressLimit caps the queued emails sent to one address per
+	// AddressWindow, so comment notifications cannot flood an inbox; 0
+	// disables the limit
+	AddressLimit  int
+	AddressWindow time.Duration
+}
+
+// AccountConfig holds account lifecycle configuration
+type AccountConfig struct {
+	FrontendURL          string
+	EmailVerificationTTL time.Duration
+	PasswordResetTTL     time.Duration
+	DeletionGracePeriod  time.Duration // Time to change one's mind before the account is anonymized
+}
+
+// OAuthConfig holds social login configuration. Pending logins are kept in
+// memory, so instances behind a load balancer need sticky sessions for the
+// provider callback to reach the instance that started the login.
+type OAuthConfig struct {
+	StateTTL         time.Duration
+	MaxPendingStates int // Logins started but not completed that are kept at once
+	GitHub           OAuthProviderConfig
+	Google           OAuthProviderConfig
+}
+
+// OAuthProviderConfig holds the client settings of one OAuth2 provider.
+// Endpoint URLs can be overridden, e.g. to point at a local fake server.
+type OAuthProviderConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	AuthURL      string
+	TokenURL     string
+	UserInfoURL  string // OIDC userinfo endpoint
+	APIURL       string // GitHub REST API base URL
+	IssuerURL    string // OIDC issuer used for discovery
+}
+
+// Enabled reports whether the provider has client credentials
+func (p OAuthProviderConfig) Enabled() bool {
+	return p.ClientID != ""
+}
+
+// MFAConfig holds two-factor authentication configuration
+type MFAConfig struct {
+	Issuer           string // Shown by authenticator apps
+	RequireForAdmins bool   // Admin and post-write routes reject sessions without a second factor
+}
+
+// LoginThrottleConfig holds brute-force protection settings for login.
+// Failures are counted per account and per client IP. After FreeAttempts
+// failures each further failure blocks logins for BaseDelay, doubling up to
+// MaxDelay; reaching LockoutThreshold locks for LockoutDuration.
+type LoginThrottleConfig struct {
+	FailureWindow   time.Duration // Failures older than this no longer count
+	BaseDelay       time.Duration
+	MaxDelay        time.Duration
+	LockoutDuration time.Duration
+	Account         LoginThrottleLimits
+	IP              LoginThrottleLimits
+}
+
+// LoginThrottleLimits holds the failure limits of one throttle scope
+type LoginThrottleLimits struct {
+	FreeAttempts     int
+	LockoutThreshold int
+}
+
+// CommentConfig holds comment moderation rules. A new comment matching any
+// enabled hold rule is kept pending until an admin approves it; comments by
+// admins are never held.
+type CommentConfig struct {
+	HoldAll       bool // Premoderate every comment
+	HoldAnonymous bool // Hold comments from visitors who are not signed in
+	HoldLinks     bool // Hold comments containing a URL
+
+	// ReportThreshold is the number of open reader reports after which a
+	// comment is hidden until an admin reviews it; 0 disables auto-hiding
+	ReportThreshold int
+
+	// ReporterKeySecret keys the hash that tells anonymous reporters apart
+	// by IP address
+	ReporterKeySecret string
+
+	// SubscriptionConfirmTTL is how long anonymous commenters have to
+	// confirm their reply notification subscription
+	SubscriptionConfirmTTL time.Duration
+}
+
+// SpamConfig holds the comment spam-check pipeline settings. Each check adds
+// to a comment's score; comments reaching HoldScore are held for moderation
+// and comments reaching SpamScore are filed as spam.
+type SpamConfig struct {
+	Enabled        bool
+	HoldScore      float64
+	SpamScore      float64
+	MaxLinks       int // Links allowed before each extra one adds to the score
+	BlockedWords   []string
+	BlockedDomains []string // Also matches subdomains and author email domains
+	// MinSubmitTime is the least time a person needs to write a comment,
+	// measured from the signed form token issued with the comment form
+	MinSubmitTime time.Duration
+	// FormTokenSecret signs form tokens. Instances behind a load balancer must
+	// share it; when empty a random secret is generated at startup.
+	FormTokenSecret   string
+	FormTokenTTL      time.Duration
+	DuplicateWindow   time.Duration // Identical comments within this window add to the score
+	ClassifierURL     string        // Optional external classifier; empty disables it
+	ClassifierTimeout time.Duration
+}
+
+// AnalyticsConfig holds the daily analytics rollup settings
+type AnalyticsConfig struct {
+	// RollupInterval is how often views, likes and comments are rolled up
+	// into daily per-post totals; each run catches up from the last
+	// rolled-up day through today
+	RollupInterval time.Duration
+	// ViewLogRetention is how long raw view logs are kept once rolled up.
+	// Repeat views are detected from the last 24 hours of logs.
+	ViewLogRetention time.Duration
+	// GeoIPDatabase is the path of a MaxMind-format country or city
+	// database used to log the country of views; empty disables lookups
+	GeoIPDatabase string
+}
+
+// AuditConfig holds the admin audit log settings
+type AuditConfig struct {
+	// Retention is how long audit log entries are kept; 0 keeps them forever
+	Retention time.Duration
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	cfg := &Config{
@@ -95,10 +275,14 @@ func Load() (*Config, error) {
 			ConnMaxLifetime: getEnvAsDuration("DB_CONN_MAX_LIFETIME", 5*time.Minute),
 		},
 		JWT: JWTConfig{
-			Secret:              getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
-			RefreshSecret:       getEnv("JWT_REFRESH_SECRET", "your-refresh-secret-key-change-in-production"),
-			AccessTokenExpires:  getEnvAsDuration("JWT_ACCESS_TOKEN_EXPIRES", 15*time.Minute),
-			RefreshTokenExpires: getEnvAsDuration("JWT_REFRESH_TOKEN_EXPIRES", 168*time.Hour),
+			Algorithm:              getEnv("JWT_ALGORITHM", JWTAlgorithmHS256),
+			Secret:                 getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
+			RefreshSecret:          getEnv("JWT_REFRESH_SECRET", "your-refresh-secret-key-change-in-production"),
+			KeysDir:                getEnv("JWT_KEYS_DIR", "data/jwt-keys"),
+			KeyRotationInterval:    getEnvAsDuration("JWT_KEY_ROTATION_INTERVAL", 720*time.Hour),
+			AccessTokenExpires:     getEnvAsDuration("JWT_ACCESS_TOKEN_EXPIRES", 15*time.Minute),
+			RefreshTokenExpires:    getEnvAsDuration("JWT_REFRESH_TOKEN_EXPIRES", 168*time.Hour),
+			MFAPendingTokenExpires: getEnvAsDuration("JWT_MFA_PENDING_TOKEN_EXPIRES", 5*time.Minute),
 		},
 		CORS: CORSConfig{
 			AllowedOrigins:   getEnvAsSlice("CORS_ALLOWED_ORIGINS", []string{"http://localhost:30001"}),
@@ -111,6 +295,8 @@ func Load() (*Config, error) {
 			APIWindow:       getEnvAsDuration("RATELIMIT_API_WINDOW", 1*time.Minute),
 			CommentRequests: getEnvAsInt("RATELIMIT_COMMENT_REQUESTS", 5),
 			CommentWindow:   getEnvAsDuration("RATELIMIT_COMMENT_WINDOW", 1*time.Minute),
+			AccountRequests: getEnvAsInt("RATELIMIT_ACCOUNT_REQUESTS", 5),
+			AccountWindow:   getEnvAsDuration("RATELIMIT_ACCOUNT_WINDOW", 15*time.Minute),
 		},
 		Logging: LoggingConfig{
 			Level:  getEnv("LOG_LEVEL", "debug"),
@@ -121,6 +307,100 @@ func Load() (*Config, error) {
 			Enabled: getEnvAsBool("METRICS_ENABLED", true),
 			Port:    getEnv("METRICS_PORT", "30003"),
 		},
+		I18n: I18nConfig{
+			DefaultLocale:    getEnv("I18N_DEFAULT_LOCALE", "ko"),
+			SupportedLocales: getEnvAsSlice("I18N_SUPPORTED_LOCALES", []string{"ko", "en"}),
+		},
+		Mail: MailConfig{
+			Driver:       getEnv("MAIL_DRIVER", "log"),
+			From:         getEnv("MAIL_FROM", "Viblog <no-reply@viblog.local>"),
+			SMTPHost:     getEnv("MAIL_SMTP_HOST", "localhost"),
+			SMTPPort:     getEnvAsInt("MAIL_SMTP_PORT", 587),
+			SMTPUsername: getEnv("MAIL_SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("MAIL_SMTP_PASSWORD", ""),
+			FileDir:      getEnv("MAIL_FILE_DIR", "tmp/mail"),
+
+			AddressLimit:  getEnvAsInt("MAIL_ADDRESS_LIMIT", 5),
+			AddressWindow: getEnvAsDuration("MAIL_ADDRESS_WINDOW", 1*time.Hour),
+		},
+		Account: AccountConfig{
+			FrontendURL:          getEnv("ACCOUNT_FRONTEND_URL", "http://localhost:30001"),
+			EmailVerificationTTL: getEnvAsDuration("ACCOUNT_EMAIL_VERIFICATION_TTL", 24*time.Hour),
+			PasswordResetTTL:     getEnvAsDuration("ACCOUNT_PASSWORD_RESET_TTL", 1*time.Hour),
+			DeletionGracePeriod:  getEnvAsDuration("ACCOUNT_DELETION_GRACE_PERIOD", 720*time.Hour),
+		},
+		OAuth: OAuthConfig{
+			StateTTL:         getEnvAsDuration("OAUTH_STATE_TTL", 10*time.Minute),
+			MaxPendingStates: getEnvAsInt("OAUTH_MAX_PENDING_STATES", 10000),
+			GitHub: OAuthProviderConfig{
+				ClientID:     getEnv("OAUTH_GITHUB_CLIENT_ID", ""),
+				ClientSecret: getEnv("OAUTH_GITHUB_CLIENT_SECRET", ""),
+				RedirectURL:  getEnv("OAUTH_GITHUB_REDIRECT_URL", "http://localhost:30001/oauth/github/callback"),
+				Scopes:       getEnvAsSlice("OAUTH_GITHUB_SCOPES", nil),
+				AuthURL:      getEnv("OAUTH_GITHUB_AUTH_URL", "https://github.com/login/oauth/authorize"),
+				TokenURL:     getEnv("OAUTH_GITHUB_TOKEN_URL", "https://github.com/login/oauth/access_token"),
+				APIURL:       getEnv("OAUTH_GITHUB_API_URL", "https://api.github.com"),
+			},
+			Google: OAuthProviderConfig{
+				ClientID:     getEnv("OAUTH_GOOGLE_CLIENT_ID", ""),
+				ClientSecret: getEnv("OAUTH_GOOGLE_CLIENT_SECRET", ""),
+				RedirectURL:  getEnv("OAUTH_GOOGLE_REDIRECT_URL", "http://localhost:30001/oauth/google/callback"),
+				Scopes:       getEnvAsSlice("OAUTH_GOOGLE_SCOPES", nil),
+				AuthURL:      getEnv("OAUTH_GOOGLE_AUTH_URL", ""),
+				TokenURL:     getEnv("OAUTH_GOOGLE_TOKEN_URL", ""),
+				UserInfoURL:  getEnv("OAUTH_GOOGLE_USERINFO_URL", ""),
+				IssuerURL:    getEnv("OAUTH_GOOGLE_ISSUER_URL", "https://accounts.google.com"),
+			},
+		},
+		MFA: MFAConfig{
+			Issuer:           getEnv("MFA_ISSUER", "Viblog"),
+			RequireForAdmins: getEnvAsBool("MFA_REQUIRE_FOR_ADMINS", false),
+		},
+		Login: LoginThrottleConfig{
+			FailureWindow:   getEnvAsDuration("LOGIN_THROTTLE_FAILURE_WINDOW", 1*time.Hour),
+			BaseDelay:       getEnvAsDuration("LOGIN_THROTTLE_BASE_DELAY", 1*time.Second),
+			MaxDelay:        getEnvAsDuration("LOGIN_THROTTLE_MAX_DELAY", 5*time.Minute),
+			LockoutDuration: getEnvAsDuration("LOGIN_THROTTLE_LOCKOUT_DURATION", 30*time.Minute),
+			Account: LoginThrottleLimits{
+				FreeAttempts:     getEnvAsInt("LOGIN_THROTTLE_ACCOUNT_FREE_ATTEMPTS", 3),
+				LockoutThreshold: getEnvAsInt("LOGIN_THROTTLE_ACCOUNT_LOCKOUT_THRESHOLD", 10),
+			},
+			IP: LoginThrottleLimits{
+				FreeAttempts:     getEnvAsInt("LOGIN_THROTTLE_IP_FREE_ATTEMPTS", 10),
+				LockoutThreshold: getEnvAsInt("LOGIN_THROTTLE_IP_LOCKOUT_THRESHOLD", 50),
+			},
+		},
+		Comment: CommentConfig{
+			HoldAll:         getEnvAsBool("COMMENT_HOLD_ALL", false),
+			HoldAnonymous:   getEnvAsBool("COMMENT_HOLD_ANONYMOUS", false),
+			HoldLinks:       getEnvAsBool("COMMENT_HOLD_LINKS", true),
+			ReportThreshold: getEnvAsInt("COMMENT_REPORT_THRESHOLD", 3),
+
+			ReporterKeySecret:      getEnv("COMMENT_REPORTER_KEY_SECRET", "your-reporter-key-secret-change-in-production"),
+			SubscriptionConfirmTTL: getEnvAsDuration("COMMENT_SUBSCRIPTION_CONFIRM_TTL", 48*time.Hour),
+		},
+		Spam: SpamConfig{
+			Enabled:           getEnvAsBool("SPAM_ENABLED", true),
+			HoldScore:         getEnvAsFloat("SPAM_HOLD_SCORE", 1.0),
+			SpamScore:         getEnvAsFloat("SPAM_SPAM_SCORE", 2.0),
+			MaxLinks:          getEnvAsInt("SPAM_MAX_LINKS", 2),
+			BlockedWords:      getEnvAsSlice("SPAM_BLOCKED_WORDS", nil),
+			BlockedDomains:    getEnvAsSlice("SPAM_BLOCKED_DOMAINS", nil),
+			MinSubmitTime:     getEnvAsDuration("SPAM_MIN_SUBMIT_TIME", 3*time.Second),
+			FormTokenSecret:   getEnv("SPAM_FORM_TOKEN_SECRET", ""),
+			FormTokenTTL:      getEnvAsDuration("SPAM_FORM_TOKEN_TTL", 24*time.Hour),
+			DuplicateWindow:   getEnvAsDuration("SPAM_DUPLICATE_WINDOW", 24*time.Hour),
+			ClassifierURL:     getEnv("SPAM_CLASSIFIER_URL", ""),
+			ClassifierTimeout: getEnvAsDuration("SPAM_CLASSIFIER_TIMEOUT", 2*time.Second),
+		},
+		Analytics: AnalyticsConfig{
+			RollupInterval:   getEnvAsDuration("ANALYTICS_ROLLUP_INTERVAL", 1*time.Hour),
+			ViewLogRetention: getEnvAsDuration("ANALYTICS_VIEW_LOG_RETENTION", 30*24*time.Hour),
+			GeoIPDatabase:    getEnv("ANALYTICS_GEOIP_DATABASE", ""),
+		},
+		Audit: AuditConfig{
+			Retention: getEnvAsDuration("AUDIT_LOG_RETENTION", 365*24*time.Hour),
+		},
 	}
 
 	// Validate configuration
@@ -142,11 +422,38 @@ func (c *Config) Validate() error {
 	if c.Database.DBName == "" {
 		return fmt.Errorf("DB_NAME is required")
 	}
-	if c.JWT.Secret == "" {
-		return fmt.Errorf("JWT_SECRET is required")
+	switch c.JWT.Algorithm {
+	case JWTAlgorithmHS256:
+		if c.JWT.Secret == "" {
+			return fmt.Errorf("JWT_SECRET is required")
+		}
+		if c.JWT.RefreshSecret == "" {
+			return fmt.Errorf("JWT_REFRESH_SECRET is required")
+		}
+	case JWTAlgorithmRS256, JWTAlgorithmEdDSA:
+		if c.JWT.KeysDir == "" {
+			return fmt.Errorf("JWT_KEYS_DIR is required for %s", c.JWT.Algorithm)
+		}
+	default:
+		return fmt.Errorf("JWT_ALGORITHM must be one of HS256, RS256, EdDSA")
+	}
+	if !containsString(c.I18n.SupportedLocales, c.I18n.DefaultLocale) {
+		return fmt.Errorf("I18N_DEFAULT_LOCALE must be one of I18N_SUPPORTED_LOCALES")
+	}
+	if !containsString([]string{"smtp", "file", "log"}, c.Mail.Driver) {
+		return fmt.Errorf("MAIL_DRIVER must be one of smtp, file, log")
+	}
+	if c.Spam.Enabled && (c.Spam.HoldScore <= 0 || c.Spam.SpamScore < c.Spam.HoldScore) {
+		return fmt.Errorf("SPAM_HOLD_SCORE must be positive and not above SPAM_SPAM_SCORE")
 	}
-	if c.JWT.RefreshSecret == "" {
-		return fmt.Errorf("JWT_REFRESH_SECRET is required")
+	if c.Analytics.RollupInterval <= 0 {
+		return fmt.Errorf("ANALYTICS_ROLLUP_INTERVAL must be positive")
+	}
+	if c.Analytics.ViewLogRetention < 48*time.Hour {
+		return fmt.Errorf("ANALYTICS_VIEW_LOG_RETENTION must be at least 48h")
+	}
+	if c.Audit.Retention < 0 {
+		return fmt.Errorf("AUDIT_LOG_RETENTION must not be negative")
 	}
 	return nil
 }
@@ -189,6 +496,14 @@ func getEnvAsBool(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	valueStr := os.Getenv(key)
+	if value, err := strconv.ParseFloat(valueStr, 64); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
 func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	valueStr := os.Getenv(key)
 	if value, err := time.ParseDuration(valueStr); err == nil {
@@ -220,3 +535,12 @@ func getEnvAsSlice(key string, defaultValue []string) []string {
 	}
 	return result
 }
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+)
+
+// AuditTargetType is the kind of record an audited action changed
+type AuditTargetType string
+
+const (
+	AuditTargetUser          AuditTargetType = "user"
+	AuditTargetPost          AuditTargetType = "post"
+	AuditTargetComment       AuditTargetType = "comment"
+	AuditTargetCommentReport AuditTargetType = "comment_report"
+	AuditTargetCategory      AuditTargetType = "category"
+	AuditTargetTag           AuditTargetType = "tag"
+	AuditTargetBan           AuditTargetType = "ban"
+	AuditTargetLockout       AuditTargetType = "lockout"
+	AuditTargetAnalytics     AuditTargetType = "analytics"
+)
+
+// AuditLog is an append-only record of a privileged action. Before and
+// After hold JSON snapshots of the target around the action; After holds
+// the request instead when the target cannot be loaded, e.g. for bulk
+// actions. Entries are never updated, except to drop the actor's IP address
+// when their account is anonymized, and are deleted once past retention.
+type AuditLog struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+
+	ActorID    uint            `gorm:"not null;index" json:"actor_id"`
+	Action     string          `gorm:"type:varchar(64);not null;index" json:"action"` // e.g. comment.delete
+	TargetType AuditTargetType `gorm:"type:varchar(32);not null;index:idx_audit_target,priority:1" json:"target_type"`
+	TargetID   *uint           `gorm:"index:idx_audit_target,priority:2" json:"target_id,omitempty"`
+
+	Before string `gorm:"type:text" json:"before,omitempty"` // JSON, empty when the target did not exist
+	After  string `gorm:"type:text" json:"after,omitempty"`  // JSON, empty when the target was deleted
+
+	Method    string `gorm:"type:varchar(10);not null" json:"method"`
+	Path      string `gorm:"type:varchar(255);not null" json:"path"`
+	Status    int    `gorm:"not null" json:"status"` // HTTP status of the response
+	IPAddress string `gorm:"type:varchar(45)" json:"ip_address"`
+	RequestID string `gorm:"type:varchar(64);index" json:"request_id"`
+
+	// Relations
+	Actor *User `gorm:"foreignKey:ActorID" json:"actor,omitempty"`
+}
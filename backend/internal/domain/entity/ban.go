@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"time"
+)
+
+// BanType identifies what a ban matches
+type BanType string
+
+const (
+	BanTypeIP    BanType = "ip"    // Value is a CIDR network; single addresses are stored as /32 or /128
+	BanTypeEmail BanType = "email" // Value is the SHA-256 of the lowercased email, never the address itself
+	BanTypeUser  BanType = "user"  // Value is the user ID
+)
+
+// IsValid checks if the ban type is known
+func (t BanType) IsValid() bool {
+	switch t {
+	case BanTypeIP, BanTypeEmail, BanTypeUser:
+		return true
+	}
+	return false
+}
+
+// Ban keeps an IP network, email address or user from commenting,
+// registering and signing in
+type Ban struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Type      BanType    `gorm:"type:varchar(10);not null;index:idx_ban_type_value" json:"type"`
+	Value     string     `gorm:"type:varchar(64);not null;index:idx_ban_type_value" json:"value"`
+	Reason    string     `gorm:"type:varchar(500);not null" json:"reason"`
+	ExpiresAt *time.Time `gorm:"index" json:"expires_at,omitempty"` // nil for permanent bans
+	CreatedBy *uint      `json:"created_by,omitempty"`              // Admin who added the ban
+
+	// Enforcement statistics
+	HitCount  int        `gorm:"not null;default:0" json:"hit_count"`
+	LastHitAt *time.Time `json:"last_hit_at,omitempty"`
+}
+
+// IsActive reports whether the ban is enforced at the given time
+func (b *Ban) IsActive(now time.Time) bool {
+	return b.ExpiresAt == nil || now.Before(*b.ExpiresAt)
+}
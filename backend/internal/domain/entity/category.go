@@ -22,5 +22,19 @@ type Category struct {
 	PostCount int `gorm:"default:0" json:"post_count"`
 
 	// Relationships
-	Posts []Post `gorm:"foreignKey:CategoryID" json:"posts,omitempty"`
+	Posts        []Post                `gorm:"foreignKey:CategoryID" json:"posts,omitempty"`
+	Translations []CategoryTranslation `gorm:"foreignKey:CategoryID" json:"translations,omitempty"`
+}
+
+// Localize replaces the category name and description with the given locale's translation, if any
+func (c *Category) Localize(locale string) {
+	for _, t := range c.Translations {
+		if t.Locale == locale {
+			c.Name = t.Name
+			if t.Description != nil {
+				c.Description = t.Description
+			}
+			return
+		}
+	}
 }
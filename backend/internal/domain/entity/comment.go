@@ -6,6 +6,25 @@ import (
 	"gorm.io/gorm"
 )
 
+// CommentStatus is the moderation state of a comment
+type CommentStatus string
+
+const (
+	CommentStatusPending  CommentStatus = "pending"  // Held for review
+	CommentStatusApproved CommentStatus = "approved" // Publicly visible
+	CommentStatusSpam     CommentStatus = "spam"
+	CommentStatusRejected CommentStatus = "rejected"
+)
+
+// IsValid checks if the status is a known comment status
+func (s CommentStatus) IsValid() bool {
+	switch s {
+	case CommentStatusPending, CommentStatusApproved, CommentStatusSpam, CommentStatusRejected:
+		return true
+	}
+	return false
+}
+
 // Comment represents a comment on a blog post
 type Comment struct {
 	ID        uint           `gorm:"primarykey" json:"id"`
@@ -14,7 +33,8 @@ type Comment struct {
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 
 	// Content
-	Content string `gorm:"type:text;not null" json:"content"`
+	Content     string `gorm:"type:text;not null" json:"content"`      // Markdown as written by the author
+	ContentHTML string `gorm:"type:text" json:"content_html"`          // Sanitized HTML rendered from Content
 
 	// Relationships
 	PostID   uint  `gorm:"not null;index" json:"post_id"`
@@ -29,10 +49,25 @@ type Comment struct {
 	AuthorName   *string `gorm:"type:varchar(100)" json:"author_name,omitempty"`   // For anonymous comments
 	AuthorEmail  *string `gorm:"type:varchar(255)" json:"author_email,omitempty"`  // For anonymous comments
 	AuthorPassword *string `gorm:"type:varchar(255)" json:"-"` // Hashed password for anonymous comment modification
+	AuthorIPHash   string  `gorm:"type:varchar(45);index" json:"-"` // Commenter's IP with the host part zeroed (utils.HashIP)
+
+	// Moderation
+	Status      CommentStatus `gorm:"type:varchar(20);not null;default:'approved';index" json:"status"`
+	ModeratedAt *time.Time    `json:"moderated_at,omitempty"`
+	ModeratedBy *uint         `json:"moderated_by,omitempty"` // Admin who last changed the status
+	SpamScore   float64       `gorm:"not null;default:0" json:"spam_score"`
+	SpamReasons string        `gorm:"type:varchar(255)" json:"spam_reasons,omitempty"` // Comma-separated scorers that flagged the comment
+	PinnedAt    *time.Time    `gorm:"index" json:"pinned_at,omitempty"`                 // Set when an admin pins the comment to the top of its post
+
+	// Users mentioned with @nickname
+	Mentions []CommentMention `gorm:"foreignKey:CommentID" json:"mentions,omitempty"`
 
 	// Metadata
 	LikeCount int  `gorm:"default:0" json:"like_count"`
 	IsEdited  bool `gorm:"default:false" json:"is_edited"`
+
+	// Computed when listing (not stored)
+	ReplyCount int64 `gorm:"-" json:"reply_count"` // Approved replies
 }
 
 // IsAnonymous checks if the comment is from an anonymous user
@@ -44,3 +79,34 @@ func (c *Comment) IsAnonymous() bool {
 func (c *Comment) IsReply() bool {
 	return c.ParentID != nil
 }
+
+// IsPinned checks if the comment is pinned to the top of its post
+func (c *Comment) IsPinned() bool {
+	return c.PinnedAt != nil
+}
+
+// IsByPostAuthor checks if the comment was written by the author of its
+// post. The post must be loaded.
+func (c *Comment) IsByPostAuthor() bool {
+	return c.Post != nil && c.UserID != nil && *c.UserID == c.Post.AuthorID
+}
+
+// IsByAdmin checks if the comment was written by an admin. The user must be loaded.
+func (c *Comment) IsByAdmin() bool {
+	return c.User != nil && c.User.Role == RoleAdmin
+}
+
+// IsApproved checks if the comment is publicly visible
+func (c *Comment) IsApproved() bool {
+	return c.Status == CommentStatusApproved
+}
+
+// CommentMention records a user mentioned with @nickname in a comment
+type CommentMention struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	CommentID uint  `gorm:"not null;uniqueIndex:idx_comment_mention" json:"comment_id"`
+	UserID    uint  `gorm:"not null;uniqueIndex:idx_comment_mention;index" json:"user_id"`
+	User      *User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"time"
+)
+
+// ReportReason is the category a reader picks when reporting a comment
+type ReportReason string
+
+const (
+	ReportReasonSpam       ReportReason = "spam"
+	ReportReasonHarassment ReportReason = "harassment"
+	ReportReasonHate       ReportReason = "hate"
+	ReportReasonOffTopic   ReportReason = "off_topic"
+	ReportReasonOther      ReportReason = "other"
+)
+
+// IsValid checks if the reason is a known report reason
+func (r ReportReason) IsValid() bool {
+	switch r {
+	case ReportReasonSpam, ReportReasonHarassment, ReportReasonHate, ReportReasonOffTopic, ReportReasonOther:
+		return true
+	}
+	return false
+}
+
+// ReportStatus is the state of a comment report in the admin inbox
+type ReportStatus string
+
+const (
+	ReportStatusOpen      ReportStatus = "open"
+	ReportStatusDismissed ReportStatus = "dismissed" // The comment was kept
+	ReportStatusResolved  ReportStatus = "resolved"  // The comment was deleted
+)
+
+// IsValid checks if the status is a known report status
+func (s ReportStatus) IsValid() bool {
+	switch s {
+	case ReportStatusOpen, ReportStatusDismissed, ReportStatusResolved:
+		return true
+	}
+	return false
+}
+
+// CommentReport is a reader's report of an abusive comment. Each reader can
+// report a comment once, identified by their user ID or, when anonymous, by
+// a keyed hash of their IP address.
+type CommentReport struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	CommentID uint     `gorm:"not null;uniqueIndex:idx_comment_report_reporter" json:"comment_id"`
+	Comment   *Comment `gorm:"foreignKey:CommentID" json:"comment,omitempty"`
+
+	Reason  ReportReason `gorm:"type:varchar(20);not null" json:"reason"`
+	Details string       `gorm:"type:varchar(500)" json:"details,omitempty"`
+
+	// Reporter
+	ReporterID  *uint  `gorm:"index" json:"reporter_id,omitempty"`
+	Reporter    *User  `gorm:"foreignKey:ReporterID" json:"reporter,omitempty"`
+	ReporterKey string `gorm:"type:varchar(64);not null;uniqueIndex:idx_comment_report_reporter" json:"-"` // "user:<id>" or the HMAC-SHA256 of the IP
+
+	// Resolution
+	Status     ReportStatus `gorm:"type:varchar(20);not null;default:'open';index" json:"status"`
+	ResolvedAt *time.Time   `json:"resolved_at,omitempty"`
+	ResolvedBy *uint        `json:"resolved_by,omitempty"` // Admin who dismissed or resolved the report
+}
+
+// IsOpen checks if the report still needs a decision
+func (r *CommentReport) IsOpen() bool {
+	return r.Status == ReportStatusOpen
+}
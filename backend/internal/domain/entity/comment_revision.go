@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+)
+
+// CommentRevision records one edit of a comment: the content before and
+// after it, and who made it. Signed-in editors are identified by their user
+// ID, anonymous authors by the network of their IP address.
+type CommentRevision struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `gorm:"index" json:"created_at"` // When the edit was made
+
+	CommentID uint `gorm:"not null;index" json:"comment_id"`
+
+	PreviousContent string `gorm:"type:text;not null" json:"previous_content"`
+	Content         string `gorm:"type:text;not null" json:"content"`
+
+	// Editor
+	EditorID     *uint  `gorm:"index" json:"editor_id,omitempty"`
+	Editor       *User  `gorm:"foreignKey:EditorID" json:"editor,omitempty"`
+	EditorIPHash string `gorm:"type:varchar(45)" json:"-"` // Anonymous editor's IP with the host part zeroed (utils.HashIP)
+}
+
+// IsAnonymous checks if the edit was made without signing in
+func (r *CommentRevision) IsAnonymous() bool {
+	return r.EditorID == nil
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+)
+
+// CommentSubscription asks for an email when someone replies to a comment.
+// Anonymous commenters opt in when posting; nothing is sent until they
+// confirm the address with the link emailed to them. Only the SHA-256 hash
+// of the confirmation token is stored; the unsubscribe token is kept as is
+// because every notification links to it, and it can only unsubscribe.
+type CommentSubscription struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	CommentID uint     `gorm:"not null;uniqueIndex:idx_comment_subscription" json:"comment_id"`
+	Comment   *Comment `gorm:"foreignKey:CommentID" json:"comment,omitempty"`
+	Email     string   `gorm:"type:varchar(255);not null;uniqueIndex:idx_comment_subscription" json:"email"`
+	Locale    string   `gorm:"type:varchar(10)" json:"locale"` // Language of the emails
+
+	// Confirmation
+	ConfirmTokenHash *string    `gorm:"type:char(64);uniqueIndex" json:"-"` // Cleared once confirmed
+	ConfirmExpiresAt time.Time  `json:"confirm_expires_at"`
+	ConfirmedAt      *time.Time `gorm:"index" json:"confirmed_at,omitempty"`
+
+	UnsubscribeToken string `gorm:"type:varchar(64);uniqueIndex;not null" json:"-"`
+}
+
+// IsConfirmed checks if the subscriber confirmed their address
+func (s *CommentSubscription) IsConfirmed() bool {
+	return s.ConfirmedAt != nil
+}
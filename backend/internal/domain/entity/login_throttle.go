@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+)
+
+// LoginThrottleScope identifies what a login throttle counts failures for
+type LoginThrottleScope string
+
+const (
+	LoginThrottleScopeAccount LoginThrottleScope = "account" // Identifier is the normalized email
+	LoginThrottleScopeIP      LoginThrottleScope = "ip"      // Identifier is the normalized client IP
+)
+
+// LoginThrottle tracks recent failed logins for an account or client IP
+type LoginThrottle struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Scope         LoginThrottleScope `gorm:"type:varchar(10);not null;uniqueIndex:idx_login_throttle_scope_identifier" json:"scope"`
+	Identifier    string             `gorm:"type:varchar(255);not null;uniqueIndex:idx_login_throttle_scope_identifier" json:"identifier"`
+	Failures      int                `gorm:"not null;default:0" json:"failures"`
+	LastFailureAt time.Time          `gorm:"not null" json:"last_failure_at"`
+	LockedUntil   *time.Time         `gorm:"index" json:"locked_until,omitempty"`
+}
+
+// IsLocked reports whether logins are blocked at the given time
+func (t *LoginThrottle) IsLocked(now time.Time) bool {
+	return t.LockedUntil != nil && now.Before(*t.LockedUntil)
+}
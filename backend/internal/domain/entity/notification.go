@@ -15,6 +15,8 @@ const (
 	NotificationTypeCommentLike    NotificationType = "comment_like"    // 댓글 좋아요
 	NotificationTypePostLike       NotificationType = "post_like"       // 글 좋아요
 	NotificationTypePostBookmark   NotificationType = "post_bookmark"   // 글 북마크
+	NotificationTypeCommentApproved NotificationType = "comment_approved" // 댓글 승인
+	NotificationTypeMention         NotificationType = "mention"          // 댓글에서 언급
 )
 
 // Notification represents a user notification
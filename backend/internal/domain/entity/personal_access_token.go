@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// PersonalAccessTokenPrefix starts every personal access token, telling it
+// apart from a JWT and making leaked tokens easy to find in logs and commits
+const PersonalAccessTokenPrefix = "vbl_"
+
+// PersonalAccessToken is a long-lived credential a user creates for scripts
+// and CI. It is looked up by Prefix; only the SHA-256 hash of the secret is
+// stored.
+type PersonalAccessToken struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	UserID     uint       `gorm:"not null;index" json:"user_id"`
+	Name       string     `gorm:"type:varchar(100);not null" json:"name"`
+	Prefix     string     `gorm:"type:varchar(16);uniqueIndex;not null" json:"prefix"`
+	SecretHash string     `gorm:"type:char(64);not null" json:"-"`
+	Scopes     string     `gorm:"type:varchar(255);not null" json:"-"` // Comma-separated permissions
+	ExpiresAt  *time.Time `gorm:"index" json:"expires_at,omitempty"`   // Nil never expires
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP *string    `gorm:"type:varchar(45)" json:"last_used_ip,omitempty"`
+	// CreatedWithMFA records that the token was created from a session that
+	// used a second factor, so it may reach routes that require one
+	CreatedWithMFA bool `gorm:"default:false" json:"created_with_mfa"`
+
+	// Relationships
+	User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// ScopeList returns the permissions the token was granted
+func (t *PersonalAccessToken) ScopeList() []Permission {
+	if t.Scopes == "" {
+		return nil
+	}
+	parts := strings.Split(t.Scopes, ",")
+	scopes := make([]Permission, len(parts))
+	for i, p := range parts {
+		scopes[i] = Permission(p)
+	}
+	return scopes
+}
+
+// SetScopes stores the permissions the token is granted
+func (t *PersonalAccessToken) SetScopes(scopes []Permission) {
+	parts := make([]string, len(scopes))
+	for i, s := range scopes {
+		parts[i] = string(s)
+	}
+	t.Scopes = strings.Join(parts, ",")
+}
+
+// IsExpired reports whether the token has expired at the given time
+func (t *PersonalAccessToken) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+// EffectivePermissions returns the token's scopes that the role still grants.
+// Demoting a user narrows their tokens without revoking them.
+func (t *PersonalAccessToken) EffectivePermissions(role Role) []Permission {
+	var granted []Permission
+	for _, scope := range t.ScopeList() {
+		if role.Has(scope) {
+			granted = append(granted, scope)
+		}
+	}
+	return granted
+}
@@ -6,6 +6,29 @@ import (
 	"gorm.io/gorm"
 )
 
+// PostStatus is the publication state of a post
+type PostStatus string
+
+const (
+	PostStatusDraft     PostStatus = "draft"
+	PostStatusPublished PostStatus = "published"
+	PostStatusScheduled PostStatus = "scheduled" // Published at PublishedAt
+)
+
+// PostStatuses returns every post status
+func PostStatuses() []PostStatus {
+	return []PostStatus{PostStatusDraft, PostStatusPublished, PostStatusScheduled}
+}
+
+// IsValid checks if the status is a known post status
+func (s PostStatus) IsValid() bool {
+	switch s {
+	case PostStatusDraft, PostStatusPublished, PostStatusScheduled:
+		return true
+	}
+	return false
+}
+
 // Post represents a blog post
 type Post struct {
 	ID        uint           `gorm:"primarykey" json:"id"`
@@ -41,6 +64,66 @@ type Post struct {
 	Tags       []Tag      `gorm:"many2many:post_tags" json:"tags,omitempty"`
 	Comments   []Comment  `gorm:"foreignKey:PostID" json:"comments,omitempty"`
 	ViewLogs   []ViewLog  `gorm:"foreignKey:PostID" json:"-"` // For tracking unique views
+
+	// Localized versions of this post (the post itself is in the default locale)
+	Translations []PostTranslation `gorm:"foreignKey:PostID" json:"translations,omitempty"`
+
+	// Localization state, populated by Localize (not persisted)
+	Locale           string   `gorm:"-" json:"locale,omitempty"`
+	AvailableLocales []string `gorm:"-" json:"available_locales,omitempty"`
+}
+
+// FindTranslation returns the translation for a locale, or nil if none exists
+func (p *Post) FindTranslation(locale string) *PostTranslation {
+	for i := range p.Translations {
+		if p.Translations[i].Locale == locale {
+			return &p.Translations[i]
+		}
+	}
+	return nil
+}
+
+// Localize swaps the post's content, category and tag names for the given locale.
+// Locale is set to the locale actually served, which is the default locale when
+// no translation exists, and AvailableLocales lists every locale the post has.
+func (p *Post) Localize(locale, defaultLocale string) {
+	p.Locale = defaultLocale
+	p.AvailableLocales = []string{defaultLocale}
+	for _, t := range p.Translations {
+		if t.Locale != defaultLocale {
+			p.AvailableLocales = append(p.AvailableLocales, t.Locale)
+		}
+	}
+
+	if p.Category != nil {
+		p.Category.Localize(locale)
+	}
+	for i := range p.Tags {
+		p.Tags[i].Localize(locale)
+	}
+
+	if locale == defaultLocale {
+		return
+	}
+
+	t := p.FindTranslation(locale)
+	if t == nil {
+		return
+	}
+
+	p.Title = t.Title
+	p.Excerpt = t.Excerpt
+	p.Content = t.Content
+	if t.MetaTitle != nil {
+		p.MetaTitle = t.MetaTitle
+	}
+	if t.MetaDescription != nil {
+		p.MetaDescription = t.MetaDescription
+	}
+	if t.MetaKeywords != nil {
+		p.MetaKeywords = t.MetaKeywords
+	}
+	p.Locale = locale
 }
 
 // ViewLog represents a view record for IP-based duplicate prevention
@@ -51,4 +134,14 @@ type ViewLog struct {
 	PostID    uint   `gorm:"not null;index:idx_post_ip" json:"post_id"`
 	IPAddress string `gorm:"type:varchar(45);not null;index:idx_post_ip" json:"ip_address"` // Supports both IPv4 and IPv6
 	UserAgent string `gorm:"type:varchar(500)" json:"user_agent"`
+
+	// Traffic source and client, see ViewDimension
+	ReferrerHost string `gorm:"type:varchar(255)" json:"referrer_host"`
+	UTMSource    string `gorm:"type:varchar(100)" json:"utm_source"`
+	UTMMedium    string `gorm:"type:varchar(100)" json:"utm_medium"`
+	UTMCampaign  string `gorm:"type:varchar(100)" json:"utm_campaign"`
+	Device       string `gorm:"type:varchar(20)" json:"device"`
+	Browser      string `gorm:"type:varchar(50)" json:"browser"`
+	OS           string `gorm:"type:varchar(50)" json:"os"`
+	Country      string `gorm:"type:varchar(2)" json:"country"`
 }
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"time"
+)
+
+// PostDailyStat holds one post's activity on one day, rolled up from view
+// logs, likes and comments so trends are read without scanning raw rows.
+// Days are in the server time zone.
+type PostDailyStat struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	Day    string `gorm:"type:char(10);not null;uniqueIndex:idx_post_daily_stat,priority:1" json:"day"` // YYYY-MM-DD
+	PostID uint   `gorm:"not null;uniqueIndex:idx_post_daily_stat,priority:2;index" json:"post_id"`
+
+	Views          int64 `gorm:"not null;default:0" json:"views"`
+	UniqueVisitors int64 `gorm:"not null;default:0" json:"unique_visitors"` // Distinct IP addresses
+	Likes          int64 `gorm:"not null;default:0" json:"likes"`
+	Comments       int64 `gorm:"not null;default:0" json:"comments"` // Approved comments
+}
+
+// ViewDimension is a property of post views that traffic is broken down by
+type ViewDimension string
+
+const (
+	ViewDimensionReferrer    ViewDimension = "referrer" // Referring site host, "" for direct and internal visits
+	ViewDimensionUTMSource   ViewDimension = "utm_source"
+	ViewDimensionUTMMedium   ViewDimension = "utm_medium"
+	ViewDimensionUTMCampaign ViewDimension = "utm_campaign"
+	ViewDimensionDevice      ViewDimension = "device" // desktop, mobile, tablet or other
+	ViewDimensionBrowser     ViewDimension = "browser"
+	ViewDimensionOS          ViewDimension = "os"
+	ViewDimensionCountry     ViewDimension = "country" // ISO 3166-1 alpha-2 code, "" when unknown
+)
+
+// ViewDimensions lists every dimension in the order they are reported
+var ViewDimensions = []ViewDimension{
+	ViewDimensionReferrer,
+	ViewDimensionUTMSource,
+	ViewDimensionUTMMedium,
+	ViewDimensionUTMCampaign,
+	ViewDimensionDevice,
+	ViewDimensionBrowser,
+	ViewDimensionOS,
+	ViewDimensionCountry,
+}
+
+// IsValid checks if the dimension is known
+func (d ViewDimension) IsValid() bool {
+	for _, dimension := range ViewDimensions {
+		if d == dimension {
+			return true
+		}
+	}
+	return false
+}
+
+// PostDailyBreakdown holds one post's views on one day that share a value
+// of a dimension, rolled up from view logs alongside PostDailyStat
+type PostDailyBreakdown struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	Day       string        `gorm:"type:char(10);not null;uniqueIndex:idx_post_daily_breakdown,priority:1" json:"day"` // YYYY-MM-DD
+	PostID    uint          `gorm:"not null;uniqueIndex:idx_post_daily_breakdown,priority:2;index" json:"post_id"`
+	Dimension ViewDimension `gorm:"type:varchar(20);not null;uniqueIndex:idx_post_daily_breakdown,priority:3" json:"dimension"`
+	Value     string        `gorm:"type:varchar(255);not null;uniqueIndex:idx_post_daily_breakdown,priority:4" json:"value"`
+
+	Views int64 `gorm:"not null;default:0" json:"views"`
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+)
+
+// QueuedEmail is an email waiting to be delivered by the mail queue
+type QueuedEmail struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	To      string `gorm:"column:recipient;type:varchar(255);not null;index" json:"to"`
+	Subject string `gorm:"type:varchar(255);not null" json:"subject"`
+	Body    string `gorm:"type:text;not null" json:"body"`
+
+	Unsubscribe string `gorm:"type:varchar(512)" json:"unsubscribe,omitempty"` // List-Unsubscribe link
+
+	// Delivery
+	SentAt    *time.Time `gorm:"index" json:"sent_at,omitempty"`
+	Attempts  int        `gorm:"not null;default:0" json:"attempts"`
+	LastError string     `gorm:"type:varchar(255)" json:"last_error,omitempty"`
+}
+
+// IsSent checks if the email was delivered
+func (e *QueuedEmail) IsSent() bool {
+	return e.SentAt != nil
+}
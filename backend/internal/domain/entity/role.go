@@ -0,0 +1,77 @@
+package entity
+
+// Role is a named set of permissions assigned to a user
+type Role string
+
+const (
+	RoleAdmin     Role = "admin"     // Full access, including user management
+	RoleEditor    Role = "editor"    // Writes posts and manages categories and tags
+	RoleModerator Role = "moderator" // Moderates comments
+	RoleMember    Role = "member"    // Regular account without admin access
+)
+
+// Permission is a single capability checked by the HTTP layer
+type Permission string
+
+const (
+	PermissionDashboardView    Permission = "dashboard:view"
+	PermissionPostsWrite       Permission = "posts:write"
+	PermissionTaxonomyManage   Permission = "taxonomy:manage"
+	PermissionCommentsModerate Permission = "comments:moderate"
+	PermissionUsersManage      Permission = "users:manage"
+	PermissionAuditView        Permission = "audit:view"
+)
+
+// rolePermissions lists what each role may do
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin: {
+		PermissionDashboardView,
+		PermissionPostsWrite,
+		PermissionTaxonomyManage,
+		PermissionCommentsModerate,
+		PermissionUsersManage,
+		PermissionAuditView,
+	},
+	RoleEditor: {
+		PermissionDashboardView,
+		PermissionPostsWrite,
+		PermissionTaxonomyManage,
+	},
+	RoleModerator: {
+		PermissionDashboardView,
+		PermissionCommentsModerate,
+	},
+	RoleMember: {},
+}
+
+// IsValid reports whether p is a known permission
+func (p Permission) IsValid() bool {
+	// Admins are granted every permission
+	return RoleAdmin.Has(p)
+}
+
+// Roles returns every role from most to least privileged
+func Roles() []Role {
+	return []Role{RoleAdmin, RoleEditor, RoleModerator, RoleMember}
+}
+
+// IsValid reports whether r is a known role
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Permissions returns the permissions granted by the role
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+// Has reports whether the role grants a permission
+func (r Role) Has(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
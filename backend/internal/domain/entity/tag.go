@@ -21,5 +21,16 @@ type Tag struct {
 	PostCount int `gorm:"default:0" json:"post_count"`
 
 	// Relationships
-	Posts []Post `gorm:"many2many:post_tags" json:"posts,omitempty"`
+	Posts        []Post           `gorm:"many2many:post_tags" json:"posts,omitempty"`
+	Translations []TagTranslation `gorm:"foreignKey:TagID" json:"translations,omitempty"`
+}
+
+// Localize replaces the tag name with the given locale's translation, if any
+func (t *Tag) Localize(locale string) {
+	for _, tr := range t.Translations {
+		if tr.Locale == locale {
+			t.Name = tr.Name
+			return
+		}
+	}
 }
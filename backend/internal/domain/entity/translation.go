@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"time"
+)
+
+// PostTranslation holds a localized version of a post's content.
+// The canonical post row is written in the site's default locale.
+type PostTranslation struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Canonical post
+	PostID uint   `gorm:"not null;uniqueIndex:idx_post_translation_locale" json:"post_id"`
+	Locale string `gorm:"type:varchar(10);not null;uniqueIndex:idx_post_translation_locale" json:"locale"`
+
+	// Content
+	Title   string `gorm:"type:varchar(255);not null" json:"title"`
+	Excerpt string `gorm:"type:text" json:"excerpt"`
+	Content string `gorm:"type:text;not null" json:"content"`
+
+	// SEO
+	MetaTitle       *string `gorm:"type:varchar(255)" json:"meta_title,omitempty"`
+	MetaDescription *string `gorm:"type:varchar(500)" json:"meta_description,omitempty"`
+	MetaKeywords    *string `gorm:"type:varchar(255)" json:"meta_keywords,omitempty"`
+}
+
+// CategoryTranslation holds a localized category name and description
+type CategoryTranslation struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	CategoryID  uint    `gorm:"not null;uniqueIndex:idx_category_translation_locale" json:"category_id"`
+	Locale      string  `gorm:"type:varchar(10);not null;uniqueIndex:idx_category_translation_locale" json:"locale"`
+	Name        string  `gorm:"type:varchar(100);not null" json:"name"`
+	Description *string `gorm:"type:text" json:"description,omitempty"`
+}
+
+// TagTranslation holds a localized tag name
+type TagTranslation struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	TagID  uint   `gorm:"not null;uniqueIndex:idx_tag_translation_locale" json:"tag_id"`
+	Locale string `gorm:"type:varchar(10);not null;uniqueIndex:idx_tag_translation_locale" json:"locale"`
+	Name   string `gorm:"type:varchar(50);not null" json:"name"`
+}
@@ -6,6 +6,24 @@ import (
 	"gorm.io/gorm"
 )
 
+// UserStatus is the moderation state of an account
+type UserStatus string
+
+const (
+	UserStatusActive    UserStatus = "active"
+	UserStatusSuspended UserStatus = "suspended"
+	UserStatusBanned    UserStatus = "banned"
+)
+
+// IsValid reports whether s is a known status
+func (s UserStatus) IsValid() bool {
+	switch s {
+	case UserStatusActive, UserStatusSuspended, UserStatusBanned:
+		return true
+	}
+	return false
+}
+
 // User represents a user account
 type User struct {
 	ID        uint           `gorm:"primarykey" json:"id"`
@@ -17,19 +35,90 @@ type User struct {
 	Email    string `gorm:"type:varchar(255);uniqueIndex;not null" json:"email"`
 	Password string `gorm:"type:varchar(255);not null" json:"-"` // Hashed password
 
+	// EmailVerifiedAt is set once the user confirms ownership of Email
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+
+	// TokenVersion is embedded in issued JWTs; bumping it revokes every session
+	TokenVersion uint `gorm:"not null;default:0" json:"-"`
+
+	// Two-factor authentication. TOTPSecret is set on enrollment and
+	// TOTPEnabledAt once the user has confirmed a code from it.
+	TOTPSecret    *string    `gorm:"type:varchar(64)" json:"-"`
+	TOTPEnabledAt *time.Time `json:"totp_enabled_at,omitempty"`
+	TOTPLastStep  int64      `gorm:"not null;default:0" json:"-"` // Last accepted time step, to reject replayed codes
+
 	// Profile
 	Nickname  string  `gorm:"type:varchar(100);uniqueIndex;not null" json:"nickname"`
 	AvatarURL *string `gorm:"type:varchar(500)" json:"avatar_url,omitempty"`
 	Bio       *string `gorm:"type:text" json:"bio,omitempty"`
 
-	// Role
+	// HideActivity keeps the user's comments off their public profile
+	HideActivity bool `gorm:"not null;default:false" json:"hide_activity"`
+
+	// Role. IsAdmin mirrors Role == RoleAdmin; change both through SetRole.
+	Role    Role `gorm:"type:varchar(20);not null;default:'member';index" json:"role"`
 	IsAdmin bool `gorm:"default:false" json:"is_admin"`
 
+	// Moderation. A suspension lifts itself at SuspendedUntil; a ban lasts
+	// until an admin reinstates the user.
+	SuspendedUntil   *time.Time `gorm:"index" json:"suspended_until,omitempty"`
+	SuspensionReason *string    `gorm:"type:varchar(500)" json:"suspension_reason,omitempty"`
+	BannedAt         *time.Time `gorm:"index" json:"banned_at,omitempty"`
+	BanReason        *string    `gorm:"type:varchar(500)" json:"ban_reason,omitempty"`
+
 	// Metadata
 	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
 
+	// DeletionScheduledAt is set when the user asked to delete the account.
+	// Signing in before then cancels the deletion; afterwards the account is
+	// anonymized.
+	DeletionScheduledAt *time.Time `gorm:"index" json:"deletion_scheduled_at,omitempty"`
+
 	// Relationships
 	Posts         []Post         `gorm:"foreignKey:AuthorID" json:"posts,omitempty"`
 	Comments      []Comment      `gorm:"foreignKey:UserID" json:"comments,omitempty"`
 	Notifications []Notification `gorm:"foreignKey:UserID" json:"notifications,omitempty"`
 }
+
+// IsEmailVerified reports whether the user has confirmed their email address
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// IsMFAEnabled reports whether login requires a second factor
+func (u *User) IsMFAEnabled() bool {
+	return u.TOTPEnabledAt != nil
+}
+
+// SetRole assigns a role and keeps IsAdmin in sync with it
+func (u *User) SetRole(role Role) {
+	u.Role = role
+	u.IsAdmin = role == RoleAdmin
+}
+
+// IsDeletionScheduled reports whether the user asked to delete the account
+func (u *User) IsDeletionScheduled() bool {
+	return u.DeletionScheduledAt != nil
+}
+
+// IsSuspended reports whether a suspension is in effect at the given time
+func (u *User) IsSuspended(now time.Time) bool {
+	return u.SuspendedUntil != nil && now.Before(*u.SuspendedUntil)
+}
+
+// IsBanned reports whether the user is banned
+func (u *User) IsBanned() bool {
+	return u.BannedAt != nil
+}
+
+// Status returns the moderation state at the given time. A ban outranks a suspension.
+func (u *User) Status(now time.Time) UserStatus {
+	switch {
+	case u.IsBanned():
+		return UserStatusBanned
+	case u.IsSuspended(now):
+		return UserStatusSuspended
+	default:
+		return UserStatusActive
+	}
+}
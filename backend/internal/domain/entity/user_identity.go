@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+)
+
+// UserIdentity links a user account to an external OAuth2/OIDC login.
+// A provider subject can belong to at most one user.
+type UserIdentity struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	UserID   uint   `gorm:"not null;index" json:"user_id"`
+	Provider string `gorm:"type:varchar(20);not null;uniqueIndex:idx_user_identity_provider_subject" json:"provider"`
+	Subject  string `gorm:"type:varchar(255);not null;uniqueIndex:idx_user_identity_provider_subject" json:"-"`
+	Email    string `gorm:"type:varchar(255)" json:"email"`
+
+	// Relationships
+	User User `gorm:"foreignKey:UserID" json:"-"`
+}
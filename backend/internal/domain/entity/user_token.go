@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+)
+
+// UserTokenPurpose identifies what a one-time user token may be used for
+type UserTokenPurpose string
+
+const (
+	UserTokenPurposeEmailVerification UserTokenPurpose = "email_verification"
+	UserTokenPurposePasswordReset     UserTokenPurpose = "password_reset"
+)
+
+// UserToken is a single-use, expiring token sent to a user by email.
+// Only the SHA-256 hash of the token is stored.
+type UserToken struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	UserID    uint             `gorm:"not null;index" json:"user_id"`
+	Purpose   UserTokenPurpose `gorm:"type:varchar(30);not null;index" json:"purpose"`
+	TokenHash string           `gorm:"type:char(64);uniqueIndex;not null" json:"-"`
+	ExpiresAt time.Time        `gorm:"not null;index" json:"expires_at"`
+	UsedAt    *time.Time       `json:"used_at,omitempty"`
+
+	// Relationships
+	User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// IsUsable reports whether the token is unused and unexpired at the given time
+func (t *UserToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
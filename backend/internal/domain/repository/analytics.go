@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// AnalyticsMetric is an activity counted per day on the dashboard
+type AnalyticsMetric string
+
+const (
+	MetricViews     AnalyticsMetric = "views"
+	MetricVisitors  AnalyticsMetric = "visitors" // Unique visitors per post, summed
+	MetricLikes     AnalyticsMetric = "likes"    // Post likes
+	MetricComments  AnalyticsMetric = "comments"
+	MetricBookmarks AnalyticsMetric = "bookmarks"
+	MetricSignups   AnalyticsMetric = "signups"
+)
+
+// DailyCount is the number of events on one local day
+type DailyCount struct {
+	Day   string // YYYY-MM-DD in the requested time zone
+	Count int64
+}
+
+// PostActivity is a post's activity over a period
+type PostActivity struct {
+	PostID   uint
+	Title    string
+	Slug     string
+	Views    int64
+	Likes    int64
+	Comments int64
+}
+
+// TagActivity is the activity on a tag's posts over a period
+type TagActivity struct {
+	TagID uint
+	Name  string
+	Slug  string
+	Views int64 // Views of the tag's posts
+	Posts int64 // Posts of the tag that were viewed
+}
+
+// ViewCount is the number of views sharing a value of a dimension
+type ViewCount struct {
+	Value string // "" when the view had no value, e.g. a direct visit
+	Views int64
+}
+
+// AnalyticsRepository aggregates site activity for the admin dashboard.
+// Views, visitors, likes and comments are read from the daily rollups, so
+// periods must start and end at midnight in the rollup time zone; bookmarks
+// and sign-ups are counted from their tables. Periods include from and
+// exclude to.
+type AnalyticsRepository interface {
+	// DailyCounts counts a metric per day in the given time zone. Days
+	// without events are left out.
+	DailyCounts(ctx context.Context, metric AnalyticsMetric, from, to time.Time, loc *time.Location) ([]DailyCount, error)
+
+	// TopPosts ranks published posts by views, then likes and comments, over
+	// the period. Posts without activity are left out.
+	TopPosts(ctx context.Context, from, to time.Time, loc *time.Location, limit int) ([]PostActivity, error)
+
+	// TopTags ranks tags by the views of their posts over the period
+	TopTags(ctx context.Context, from, to time.Time, loc *time.Location, limit int) ([]TagActivity, error)
+
+	// AggregatePostActivity counts each post's views, unique visitors, likes
+	// and approved comments over the period from the raw tables. Posts
+	// without activity are left out.
+	AggregatePostActivity(ctx context.Context, from, to time.Time) ([]entity.PostDailyStat, error)
+
+	// AggregateViewBreakdown counts each post's views over the period by the
+	// value of every dimension from the view logs
+	AggregateViewBreakdown(ctx context.Context, from, to time.Time) ([]entity.PostDailyBreakdown, error)
+
+	// ViewBreakdown ranks the values of a dimension by their rolled-up views
+	// over the period, of one post or of all posts when postID is nil. It
+	// returns the top values and the views of all values.
+	ViewBreakdown(ctx context.Context, dimension entity.ViewDimension, postID *uint, from, to time.Time, loc *time.Location, limit int) ([]ViewCount, int64, error)
+
+	// FindDailyStats retrieves the rolled-up stats of a day
+	FindDailyStats(ctx context.Context, day string) ([]entity.PostDailyStat, error)
+
+	// ReplaceDailyStats replaces the rolled-up stats of a day in one transaction
+	ReplaceDailyStats(ctx context.Context, day string, stats []entity.PostDailyStat) error
+
+	// ReplaceDailyBreakdown replaces the rolled-up view breakdown of a day in one transaction
+	ReplaceDailyBreakdown(ctx context.Context, day string, breakdown []entity.PostDailyBreakdown) error
+
+	// LatestRolledUpDay returns the last day with rolled-up stats, or "" when there is none
+	LatestRolledUpDay(ctx context.Context) (string, error)
+
+	// EarliestViewAt returns when the oldest view log was recorded, or nil when there is none
+	EarliestViewAt(ctx context.Context) (*time.Time, error)
+
+	// DeleteViewLogsBefore deletes view logs recorded before the given time
+	// and returns how many were deleted
+	DeleteViewLogsBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// AuditLogFilter narrows an audit log listing. Zero fields match every entry.
+type AuditLogFilter struct {
+	ActorID    uint
+	Action     string // Exact action, or a prefix ending in "." such as "user."
+	TargetType entity.AuditTargetType
+	TargetID   uint
+	RequestID  string
+	From       *time.Time // Inclusive
+	To         *time.Time // Exclusive
+}
+
+// AuditLogRepository defines the interface for audit log data access. The
+// log is append-only: entries can be created and read, and only deleted in
+// bulk once past retention.
+type AuditLogRepository interface {
+	// Create appends an entry
+	Create(ctx context.Context, log *entity.AuditLog) error
+
+	// FindAll retrieves entries matching the filter, newest first, with their actors
+	FindAll(ctx context.Context, filter AuditLogFilter, page, limit int) ([]entity.AuditLog, int64, error)
+
+	// DeleteBefore deletes entries created before the given time and returns how many were deleted
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// BanFilter narrows a ban listing. Zero fields match every ban.
+type BanFilter struct {
+	Type       entity.BanType
+	ActiveOnly bool
+	At         time.Time // Time ActiveOnly is evaluated at
+}
+
+// BanRepository defines the interface for ban data access
+type BanRepository interface {
+	// Create creates a new ban
+	Create(ctx context.Context, ban *entity.Ban) error
+
+	// FindByID finds a ban by ID
+	FindByID(ctx context.Context, id uint) (*entity.Ban, error)
+
+	// FindAll retrieves bans matching the filter, newest first, with pagination
+	FindAll(ctx context.Context, filter BanFilter, page, limit int) ([]entity.Ban, int64, error)
+
+	// FindActive retrieves the bans of a type in force at the given time.
+	// When values is non-empty only bans on those values are returned.
+	FindActive(ctx context.Context, banType entity.BanType, values []string, now time.Time) ([]entity.Ban, error)
+
+	// RecordHit counts a blocked request against a ban
+	RecordHit(ctx context.Context, id uint, at time.Time) error
+
+	// Delete deletes a ban
+	Delete(ctx context.Context, id uint) error
+
+	// DeleteExpired deletes bans that expired before the given time and returns how many were deleted
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
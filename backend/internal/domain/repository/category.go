@@ -14,10 +14,10 @@ type CategoryRepository interface {
 	// FindByID finds a category by ID
 	FindByID(ctx context.Context, id uint) (*entity.Category, error)
 
-	// FindBySlug finds a category by slug
+	// FindBySlug finds a category by slug with its translations
 	FindBySlug(ctx context.Context, slug string) (*entity.Category, error)
 
-	// FindAll retrieves all categories
+	// FindAll retrieves all categories with their translations
 	FindAll(ctx context.Context) ([]entity.Category, error)
 
 	// Update updates a category
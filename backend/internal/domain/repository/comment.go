@@ -2,10 +2,36 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/yourusername/viblog/internal/domain/entity"
 )
 
+// CommentFilter narrows an admin comment listing. Zero fields match every comment.
+type CommentFilter struct {
+	Status entity.CommentStatus
+	PostID uint
+	Search string // Case-insensitive substring of the content or anonymous author name
+}
+
+// CommentSort is the order of a post's comments
+type CommentSort string
+
+const (
+	CommentSortOldest CommentSort = "oldest"
+	CommentSortNewest CommentSort = "newest"
+	CommentSortTop    CommentSort = "top" // Most liked first
+)
+
+// IsValid checks if the sort is a known comment sort
+func (s CommentSort) IsValid() bool {
+	switch s {
+	case CommentSortOldest, CommentSortNewest, CommentSortTop:
+		return true
+	}
+	return false
+}
+
 // CommentRepository defines the interface for comment data access
 type CommentRepository interface {
 	// Create creates a new comment
@@ -14,18 +40,51 @@ type CommentRepository interface {
 	// FindByID finds a comment by ID
 	FindByID(ctx context.Context, id uint) (*entity.Comment, error)
 
-	// FindByPostID retrieves all comments for a specific post
-	FindByPostID(ctx context.Context, postID uint) ([]entity.Comment, error)
+	// FindByIDs finds the comments with the given IDs, with their posts, authors and mentions
+	FindByIDs(ctx context.Context, ids []uint) ([]entity.Comment, error)
 
-	// FindReplies retrieves all replies to a specific comment
+	// FindByPostID retrieves the approved top-level comments of a post, the
+	// pinned comment first and the rest in the given order
+	FindByPostID(ctx context.Context, postID uint, sort CommentSort) ([]entity.Comment, error)
+
+	// FindReplies retrieves the approved replies to a specific comment
 	FindReplies(ctx context.Context, parentID uint) ([]entity.Comment, error)
 
-	// FindAll retrieves all comments with pagination
-	FindAll(ctx context.Context, page, limit int) ([]entity.Comment, int64, error)
+	// CountReplies counts the approved replies to each of the given comments.
+	// Comments without replies are left out.
+	CountReplies(ctx context.Context, parentIDs []uint) (map[uint]int64, error)
+
+	// FindPublicByUserID retrieves a user's approved comments on published posts, newest first, with pagination
+	FindPublicByUserID(ctx context.Context, userID uint, page, limit int) ([]entity.Comment, int64, error)
+
+	// FindAll retrieves comments matching the filter with pagination
+	FindAll(ctx context.Context, filter CommentFilter, page, limit int) ([]entity.Comment, int64, error)
+
+	// CountRecentByContent counts comments with exactly this content created since the given time
+	CountRecentByContent(ctx context.Context, content string, since time.Time) (int64, error)
+
+	// CountByStatus counts comments per moderation status
+	CountByStatus(ctx context.Context) (map[entity.CommentStatus]int64, error)
 
 	// Update updates a comment
 	Update(ctx context.Context, comment *entity.Comment) error
 
+	// UpdateModeration saves only the comment's status, moderated_at and moderated_by
+	UpdateModeration(ctx context.Context, comment *entity.Comment) error
+
+	// UpdateContent saves an edit of the comment's content, status and spam
+	// score, replaces its mentions and records the revision
+	UpdateContent(ctx context.Context, comment *entity.Comment, revision *entity.CommentRevision) error
+
+	// FindRevisions retrieves the revisions of a comment, oldest first, with their editors
+	FindRevisions(ctx context.Context, commentID uint) ([]entity.CommentRevision, error)
+
+	// Pin pins a comment to the top of its post, unpinning any other comment of the post
+	Pin(ctx context.Context, comment *entity.Comment, at time.Time) error
+
+	// Unpin unpins a comment
+	Unpin(ctx context.Context, id uint) error
+
 	// Delete deletes a comment (soft delete)
 	Delete(ctx context.Context, id uint) error
 
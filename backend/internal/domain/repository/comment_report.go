@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// CommentReportFilter narrows a report listing. Zero fields match every report.
+type CommentReportFilter struct {
+	Status    entity.ReportStatus
+	Reason    entity.ReportReason
+	CommentID uint
+}
+
+// CommentReportRepository defines the interface for comment report data access
+type CommentReportRepository interface {
+	// CreateUnlessReported creates a new report unless its reporter already
+	// reported the comment, and returns whether it was created
+	CreateUnlessReported(ctx context.Context, report *entity.CommentReport) (bool, error)
+
+	// FindByID finds a report by ID
+	FindByID(ctx context.Context, id uint) (*entity.CommentReport, error)
+
+	// FindAll retrieves reports matching the filter, oldest first, with their
+	// comments, the comments' authors and posts, and the reporters
+	FindAll(ctx context.Context, filter CommentReportFilter, page, limit int) ([]entity.CommentReport, int64, error)
+
+	// CountOpenByComment counts the open reports of a comment
+	CountOpenByComment(ctx context.Context, commentID uint) (int64, error)
+
+	// CountByStatus counts reports per status
+	CountByStatus(ctx context.Context) (map[entity.ReportStatus]int64, error)
+
+	// ResolveOpenByComment closes every open report of a comment with the
+	// given status and returns how many were closed
+	ResolveOpenByComment(ctx context.Context, commentID uint, status entity.ReportStatus, resolvedBy uint, at time.Time) (int64, error)
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// CommentSubscriptionRepository defines the interface for reply notification subscription data access
+type CommentSubscriptionRepository interface {
+	// Create creates a new subscription
+	Create(ctx context.Context, subscription *entity.CommentSubscription) error
+
+	// Update saves a subscription
+	Update(ctx context.Context, subscription *entity.CommentSubscription) error
+
+	// FindByCommentAndEmail finds the subscription of an address to a comment
+	FindByCommentAndEmail(ctx context.Context, commentID uint, email string) (*entity.CommentSubscription, error)
+
+	// FindByConfirmTokenHash finds an unconfirmed subscription by the hash of its confirmation token
+	FindByConfirmTokenHash(ctx context.Context, tokenHash string) (*entity.CommentSubscription, error)
+
+	// FindByUnsubscribeToken finds a subscription by its unsubscribe token
+	FindByUnsubscribeToken(ctx context.Context, token string) (*entity.CommentSubscription, error)
+
+	// FindConfirmedByComment retrieves the confirmed subscriptions to a comment
+	FindConfirmedByComment(ctx context.Context, commentID uint) ([]entity.CommentSubscription, error)
+
+	// Delete deletes a subscription
+	Delete(ctx context.Context, id uint) error
+
+	// DeleteUnconfirmedBefore deletes subscriptions whose confirmation
+	// expired before the given time and returns how many were deleted
+	DeleteUnconfirmedBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// LoginThrottleRepository defines the interface for failed login tracking
+type LoginThrottleRepository interface {
+	// Find finds the throttle for a scope and identifier
+	Find(ctx context.Context, scope entity.LoginThrottleScope, identifier string) (*entity.LoginThrottle, error)
+
+	// FindByID finds a throttle by ID
+	FindByID(ctx context.Context, id uint) (*entity.LoginThrottle, error)
+
+	// RecordFailure atomically counts a failure and returns the updated
+	// throttle. Failures before windowStart are discarded first.
+	RecordFailure(ctx context.Context, scope entity.LoginThrottleScope, identifier string, now, windowStart time.Time) (*entity.LoginThrottle, error)
+
+	// Lock blocks logins for a throttle until the given time
+	Lock(ctx context.Context, id uint, until time.Time) error
+
+	// Reset forgets the failures of a scope and identifier
+	Reset(ctx context.Context, scope entity.LoginThrottleScope, identifier string) error
+
+	// Delete deletes a throttle by ID
+	Delete(ctx context.Context, id uint) error
+
+	// FindAll retrieves throttles with pagination, most recent failure first.
+	// With lockedOnly set only throttles locked at now are returned.
+	FindAll(ctx context.Context, lockedOnly bool, now time.Time, page, limit int) ([]entity.LoginThrottle, int64, error)
+}
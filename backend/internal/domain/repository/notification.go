@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// NotificationRepository defines the interface for notification data access
+type NotificationRepository interface {
+	// Create creates a new notification
+	Create(ctx context.Context, notification *entity.Notification) error
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// PersonalAccessTokenRepository defines the interface for personal access token storage
+type PersonalAccessTokenRepository interface {
+	// Create stores a new token
+	Create(ctx context.Context, token *entity.PersonalAccessToken) error
+
+	// FindByPrefix finds a token by its public prefix
+	FindByPrefix(ctx context.Context, prefix string) (*entity.PersonalAccessToken, error)
+
+	// FindByUserID lists a user's tokens, newest first
+	FindByUserID(ctx context.Context, userID uint) ([]entity.PersonalAccessToken, error)
+
+	// CountByUserID counts a user's tokens
+	CountByUserID(ctx context.Context, userID uint) (int64, error)
+
+	// Delete deletes a user's token. It reports false when the user has no token with that ID.
+	Delete(ctx context.Context, id, userID uint) (bool, error)
+
+	// DeleteByUserID deletes every token of a user
+	DeleteByUserID(ctx context.Context, userID uint) error
+
+	// UpdateLastUsed records when and from where a token was last used
+	UpdateLastUsed(ctx context.Context, id uint, at time.Time, ip string) error
+}
@@ -2,10 +2,45 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/yourusername/viblog/internal/domain/entity"
 )
 
+// PostFilter narrows an admin post listing. Zero fields match every post.
+type PostFilter struct {
+	Status     entity.PostStatus
+	CategoryID uint
+	TagID      uint
+	AuthorID   uint
+	Search     string     // Case-insensitive substring of the title
+	From       *time.Time // Created at or after
+	To         *time.Time // Created before
+	Sort       PostSort
+	Ascending  bool
+}
+
+// PostSort is the field an admin post listing is ordered by
+type PostSort string
+
+const (
+	PostSortCreated   PostSort = "created"
+	PostSortUpdated   PostSort = "updated"
+	PostSortPublished PostSort = "published" // Unpublished posts last
+	PostSortViews     PostSort = "views"
+	PostSortLikes     PostSort = "likes"
+	PostSortComments  PostSort = "comments"
+)
+
+// IsValid checks if the sort is a known post sort
+func (s PostSort) IsValid() bool {
+	switch s {
+	case PostSortCreated, PostSortUpdated, PostSortPublished, PostSortViews, PostSortLikes, PostSortComments:
+		return true
+	}
+	return false
+}
+
 // PostRepository defines methods for post persistence
 type PostRepository interface {
 	// Basic CRUD
@@ -28,7 +63,7 @@ type PostRepository interface {
 	// View tracking
 	IncrementViewCount(ctx context.Context, postID uint) error
 	HasViewedRecently(ctx context.Context, postID uint, ipAddress string) (bool, error)
-	RecordView(ctx context.Context, postID uint, ipAddress, userAgent string) error
+	RecordView(ctx context.Context, view *entity.ViewLog) error
 
 	// Like operations
 	AddLike(ctx context.Context, postID, userID uint) error
@@ -53,4 +88,6 @@ type PostRepository interface {
 	// Admin-specific methods
 	GetTotalCount(ctx context.Context) (int64, error)
 	GetPublishedCount(ctx context.Context) (int64, error)
+	FindAll(ctx context.Context, filter PostFilter, page, limit int) ([]*entity.Post, int64, error)
+	CountByStatus(ctx context.Context) (map[entity.PostStatus]int64, error)
 }
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// QueuedEmailRepository defines the interface for mail queue data access
+type QueuedEmailRepository interface {
+	// Create queues an email
+	Create(ctx context.Context, email *entity.QueuedEmail) error
+
+	// CountByAddressSince counts the emails queued to an address since the given time
+	CountByAddressSince(ctx context.Context, to string, since time.Time) (int64, error)
+
+	// FindPending retrieves unsent emails tried fewer than maxAttempts times, oldest first
+	FindPending(ctx context.Context, maxAttempts, limit int) ([]entity.QueuedEmail, error)
+
+	// MarkSent records that an email was delivered
+	MarkSent(ctx context.Context, id uint, at time.Time) error
+
+	// MarkFailed records a failed delivery attempt
+	MarkFailed(ctx context.Context, id uint, reason string) error
+
+	// DeleteSentBefore deletes emails delivered before the given time and
+	// returns how many were deleted
+	DeleteSentBefore(ctx context.Context, before time.Time) (int64, error)
+}
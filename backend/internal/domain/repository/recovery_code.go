@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// RecoveryCodeRepository defines the interface for two-factor recovery codes
+type RecoveryCodeRepository interface {
+	// Replace deletes every code of a user and stores the given hashes
+	Replace(ctx context.Context, userID uint, codeHashes []string) error
+
+	// Consume atomically marks an unused code as used and returns it.
+	// It returns nil when the hash is unknown or already used.
+	Consume(ctx context.Context, userID uint, codeHash string, now time.Time) (*entity.RecoveryCode, error)
+
+	// CountUnused counts the codes a user has left
+	CountUnused(ctx context.Context, userID uint) (int64, error)
+
+	// DeleteByUser deletes every code of a user
+	DeleteByUser(ctx context.Context, userID uint) error
+}
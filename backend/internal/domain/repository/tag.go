@@ -14,10 +14,10 @@ type TagRepository interface {
 	// FindByID finds a tag by ID
 	FindByID(ctx context.Context, id uint) (*entity.Tag, error)
 
-	// FindBySlug finds a tag by slug
+	// FindBySlug finds a tag by slug with its translations
 	FindBySlug(ctx context.Context, slug string) (*entity.Tag, error)
 
-	// FindAll retrieves all tags
+	// FindAll retrieves all tags with their translations
 	FindAll(ctx context.Context) ([]entity.Tag, error)
 
 	// Update updates a tag
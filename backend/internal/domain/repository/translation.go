@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// TranslationRepository defines the interface for localized content access
+type TranslationRepository interface {
+	// FindPostTranslations retrieves all translations of a post
+	FindPostTranslations(ctx context.Context, postID uint) ([]entity.PostTranslation, error)
+
+	// UpsertPostTranslation creates or replaces the translation of a post for its locale
+	UpsertPostTranslation(ctx context.Context, translation *entity.PostTranslation) error
+
+	// DeletePostTranslation deletes the translation of a post for a locale
+	DeletePostTranslation(ctx context.Context, postID uint, locale string) error
+
+	// UpsertCategoryTranslation creates or replaces the translation of a category for its locale
+	UpsertCategoryTranslation(ctx context.Context, translation *entity.CategoryTranslation) error
+
+	// DeleteCategoryTranslation deletes the translation of a category for a locale
+	DeleteCategoryTranslation(ctx context.Context, categoryID uint, locale string) error
+
+	// UpsertTagTranslation creates or replaces the translation of a tag for its locale
+	UpsertTagTranslation(ctx context.Context, translation *entity.TagTranslation) error
+
+	// DeleteTagTranslation deletes the translation of a tag for a locale
+	DeleteTagTranslation(ctx context.Context, tagID uint, locale string) error
+}
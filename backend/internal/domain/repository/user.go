@@ -2,10 +2,19 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/yourusername/viblog/internal/domain/entity"
 )
 
+// UserFilter narrows a user listing. Zero fields match every user.
+type UserFilter struct {
+	Search string // Case-insensitive substring of the email or nickname
+	Role   entity.Role
+	Status entity.UserStatus
+	At     time.Time // Time Status is evaluated at
+}
+
 // UserRepository defines the interface for user data access
 type UserRepository interface {
 	// Create creates a new user
@@ -35,9 +44,20 @@ type UserRepository interface {
 	// UpdateLastLoginAt updates the last login timestamp
 	UpdateLastLoginAt(ctx context.Context, id uint) error
 
-	// FindAll retrieves all users with pagination
-	FindAll(ctx context.Context, page, limit int) ([]entity.User, int64, error)
+	// AdvanceTOTPStep records step as the user's last accepted TOTP step in a
+	// single conditional update. It reports false when that step or a later
+	// one was already recorded, i.e. the code is being replayed.
+	AdvanceTOTPStep(ctx context.Context, id uint, step int64) (bool, error)
+
+	// FindAll retrieves users matching the filter with pagination
+	FindAll(ctx context.Context, filter UserFilter, page, limit int) ([]entity.User, int64, error)
 
 	// GetTotalCount gets total count of all users
 	GetTotalCount(ctx context.Context) (int64, error)
+
+	// CountByRole counts users that have the given role
+	CountByRole(ctx context.Context, role entity.Role) (int64, error)
+
+	// FindDueForDeletion finds up to limit users whose scheduled deletion is at or before the given time
+	FindDueForDeletion(ctx context.Context, before time.Time, limit int) ([]entity.User, error)
 }
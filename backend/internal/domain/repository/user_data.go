@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// UserDataRepository gathers and erases the personal data a user leaves across the site
+type UserDataRepository interface {
+	// FindComments lists the user's comments, oldest first
+	FindComments(ctx context.Context, userID uint) ([]entity.Comment, error)
+
+	// FindLikes lists the user's post and comment likes, oldest first
+	FindLikes(ctx context.Context, userID uint) ([]entity.Like, error)
+
+	// FindBookmarks lists the user's bookmarks with their posts, oldest first
+	FindBookmarks(ctx context.Context, userID uint) ([]entity.Bookmark, error)
+
+	// FindNotifications lists the notifications addressed to the user, oldest first
+	FindNotifications(ctx context.Context, userID uint) ([]entity.Notification, error)
+
+	// Anonymize removes the user's personal data in one transaction. Comments
+	// keep their content but lose their author and earlier revisions;
+	// reports, others' revisions, audit entries and notifications they
+	// triggered lose the user; likes, bookmarks, mentions, subscriptions,
+	// queued emails, notifications to them and credentials are deleted; the
+	// account itself is scrubbed and soft deleted.
+	Anonymize(ctx context.Context, user *entity.User) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// UserIdentityRepository defines the interface for linked external identities
+type UserIdentityRepository interface {
+	// Create links a new identity
+	Create(ctx context.Context, identity *entity.UserIdentity) error
+
+	// FindByProviderSubject finds the identity for a provider account
+	FindByProviderSubject(ctx context.Context, provider, subject string) (*entity.UserIdentity, error)
+
+	// FindByUserID lists every identity linked to a user
+	FindByUserID(ctx context.Context, userID uint) ([]entity.UserIdentity, error)
+
+	// Update updates an identity
+	Update(ctx context.Context, identity *entity.UserIdentity) error
+}
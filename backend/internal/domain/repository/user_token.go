@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+// UserTokenRepository defines the interface for one-time user token storage
+type UserTokenRepository interface {
+	// Create stores a new token
+	Create(ctx context.Context, token *entity.UserToken) error
+
+	// Consume atomically marks a usable token as used and returns it.
+	// It returns nil when the hash is unknown, expired or already used.
+	Consume(ctx context.Context, purpose entity.UserTokenPurpose, tokenHash string, now time.Time) (*entity.UserToken, error)
+
+	// DeleteByUser deletes every token of a user for a purpose
+	DeleteByUser(ctx context.Context, userID uint, purpose entity.UserTokenPurpose) error
+
+	// DeleteExpired deletes tokens that expired before the given time
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
@@ -1,103 +1,264 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"go.uber.org/zap"
 )
 
+// keyRotationCheckInterval is how often RunKeyRotation looks at the key directory
+const keyRotationCheckInterval = time.Minute
+
+// TokenType distinguishes tokens signed with the same key
+type TokenType string
+
 const (
-	// AccessTokenDuration is the duration for access tokens (15 minutes)
-	AccessTokenDuration = 15 * time.Minute
-	// RefreshTokenDuration is the duration for refresh tokens (7 days)
-	RefreshTokenDuration = 7 * 24 * time.Hour
+	TokenTypeAccess     TokenType = "access"
+	TokenTypeRefresh    TokenType = "refresh"
+	TokenTypeMFAPending TokenType = "mfa_pending"
 )
 
 // TokenClaims represents the JWT claims
 type TokenClaims struct {
-	UserID  uint   `json:"user_id"`
-	Email   string `json:"email"`
-	IsAdmin bool   `json:"is_admin"`
+	UserID       uint   `json:"user_id"`
+	Email        string `json:"email"`
+	IsAdmin      bool   `json:"is_admin"`
+	TokenVersion uint   `json:"token_version"`
+	// Role and Permissions are snapshots taken at issue time; changing a
+	// user's role bumps TokenVersion so stale snapshots stop validating
+	Role        entity.Role         `json:"role,omitempty"`
+	Permissions []entity.Permission `json:"permissions,omitempty"`
+	// MFA is set when the session was established with a second factor
+	MFA bool `json:"mfa,omitempty"`
+	// MFAPending marks a token that only proves the password step of a login
+	MFAPending bool `json:"mfa_pending,omitempty"`
+	// Type keeps a refresh token from being accepted as an access token when
+	// both are signed with the same asymmetric key. Tokens issued before it
+	// existed have no type and were told apart by their HS256 secret.
+	Type TokenType `json:"token_type,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // JWTService handles JWT token operations
 type JWTService struct {
-	secretKey       []byte
+	cfg              config.JWTConfig
+	secretKey        []byte
 	refreshSecretKey []byte
+	keys             *KeySet // nil with HS256
 }
 
-// NewJWTService creates a new JWT service
-func NewJWTService(secretKey, refreshSecretKey string) *JWTService {
-	return &JWTService{
-		secretKey:       []byte(secretKey),
-		refreshSecretKey: []byte(refreshSecretKey),
+// NewJWTService creates a new JWT service. With RS256 or EdDSA the signing
+// keys are loaded from cfg.KeysDir, and a first key is generated if needed.
+func NewJWTService(cfg config.JWTConfig) (*JWTService, error) {
+	s := &JWTService{cfg: cfg}
+	if !cfg.IsAsymmetric() {
+		s.secretKey = []byte(cfg.Secret)
+		s.refreshSecretKey = []byte(cfg.RefreshSecret)
+		return s, nil
 	}
+
+	keys, err := NewKeySet(cfg.KeysDir, cfg.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	s.keys = keys
+	return s, nil
 }
 
-// GenerateAccessToken generates an access token for a user
-func (s *JWTService) GenerateAccessToken(userID uint, email string, isAdmin bool) (string, error) {
-	now := time.Now()
-	claims := TokenClaims{
-		UserID:  userID,
-		Email:   email,
-		IsAdmin: isAdmin,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(AccessTokenDuration)),
-			IssuedAt:  jwt.NewNumericDate(now),
-			NotBefore: jwt.NewNumericDate(now),
-		},
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.secretKey)
+// GenerateAccessToken generates an access token for a user.
+// tokenVersion must match the user's current TokenVersion for the token to stay valid.
+func (s *JWTService) GenerateAccessToken(userID uint, email string, role entity.Role, tokenVersion uint, mfa bool) (string, error) {
+	return s.sign(TokenClaims{
+		UserID:       userID,
+		Email:        email,
+		IsAdmin:      role == entity.RoleAdmin,
+		Role:         role,
+		Permissions:  role.Permissions(),
+		TokenVersion: tokenVersion,
+		MFA:          mfa,
+		Type:         TokenTypeAccess,
+	}, s.cfg.AccessTokenExpires, s.secretKey)
 }
 
 // GenerateRefreshToken generates a refresh token for a user
-func (s *JWTService) GenerateRefreshToken(userID uint, email string) (string, error) {
-	now := time.Now()
-	claims := TokenClaims{
-		UserID: userID,
-		Email:  email,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(RefreshTokenDuration)),
-			IssuedAt:  jwt.NewNumericDate(now),
-			NotBefore: jwt.NewNumericDate(now),
-		},
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.refreshSecretKey)
+func (s *JWTService) GenerateRefreshToken(userID uint, email string, tokenVersion uint, mfa bool) (string, error) {
+	return s.sign(TokenClaims{
+		UserID:       userID,
+		Email:        email,
+		TokenVersion: tokenVersion,
+		MFA:          mfa,
+		Type:         TokenTypeRefresh,
+	}, s.cfg.RefreshTokenExpires, s.refreshSecretKey)
+}
+
+// GenerateMFAPendingToken generates a short-lived token for a user who passed
+// the password step and still has to present a second factor
+func (s *JWTService) GenerateMFAPendingToken(userID uint, email string, tokenVersion uint) (string, error) {
+	return s.sign(TokenClaims{
+		UserID:       userID,
+		Email:        email,
+		TokenVersion: tokenVersion,
+		MFAPending:   true,
+		Type:         TokenTypeMFAPending,
+	}, s.cfg.MFAPendingTokenExpires, s.secretKey)
 }
 
 // ValidateAccessToken validates an access token and returns the claims
 func (s *JWTService) ValidateAccessToken(tokenString string) (*TokenClaims, error) {
-	return s.validateToken(tokenString, s.secretKey)
+	claims, err := s.validateToken(tokenString, s.secretKey, TokenTypeAccess)
+	if err != nil {
+		return nil, err
+	}
+	if claims.MFAPending {
+		return nil, fmt.Errorf("token is pending a second factor")
+	}
+	return claims, nil
+}
+
+// ValidateMFAPendingToken validates an MFA pending token and returns the claims
+func (s *JWTService) ValidateMFAPendingToken(tokenString string) (*TokenClaims, error) {
+	claims, err := s.validateToken(tokenString, s.secretKey, TokenTypeMFAPending)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.MFAPending {
+		return nil, fmt.Errorf("not an MFA pending token")
+	}
+	return claims, nil
 }
 
 // ValidateRefreshToken validates a refresh token and returns the claims
 func (s *JWTService) ValidateRefreshToken(tokenString string) (*TokenClaims, error) {
-	return s.validateToken(tokenString, s.refreshSecretKey)
+	return s.validateToken(tokenString, s.refreshSecretKey, TokenTypeRefresh)
+}
+
+// JWKS returns the public keys that verify tokens. It is empty with HS256,
+// whose secrets must not leave the service.
+func (s *JWTService) JWKS() JWKS {
+	if s.keys == nil {
+		return JWKS{Keys: []JWK{}}
+	}
+	return s.keys.JWKS()
+}
+
+// RunKeyRotation periodically picks up keys rotated by other instances,
+// generates a new signing key once the current one is older than
+// cfg.KeyRotationInterval and prunes keys whose tokens have all expired.
+// It returns when ctx is done and does nothing with HS256.
+func (s *JWTService) RunKeyRotation(ctx context.Context, logger *zap.Logger) {
+	if s.keys == nil {
+		return
+	}
+
+	ticker := time.NewTicker(keyRotationCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		s.rotateKeys(time.Now(), logger)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// rotateKeys runs one round of RunKeyRotation
+func (s *JWTService) rotateKeys(now time.Time, logger *zap.Logger) {
+	if err := s.keys.Reload(); err != nil {
+		logger.Error("Failed to reload JWT signing keys", zap.Error(err))
+		return
+	}
+
+	key, err := s.keys.RotateIfDue(now, s.cfg.KeyRotationInterval)
+	if err != nil {
+		logger.Error("Failed to rotate JWT signing key", zap.Error(err))
+	} else if key != nil {
+		logger.Info("Rotated JWT signing key", zap.String("kid", key.ID), zap.String("alg", key.Algorithm))
+	}
+
+	pruned, err := s.keys.Prune(now, s.maxTokenLifetime())
+	if err != nil {
+		logger.Error("Failed to prune JWT signing keys", zap.Error(err))
+	}
+	for _, kid := range pruned {
+		logger.Info("Pruned JWT signing key", zap.String("kid", kid))
+	}
+}
+
+// maxTokenLifetime is how long a replaced key must keep verifying
+func (s *JWTService) maxTokenLifetime() time.Duration {
+	lifetime := s.cfg.AccessTokenExpires
+	if s.cfg.RefreshTokenExpires > lifetime {
+		lifetime = s.cfg.RefreshTokenExpires
+	}
+	if s.cfg.MFAPendingTokenExpires > lifetime {
+		lifetime = s.cfg.MFAPendingTokenExpires
+	}
+	return lifetime
+}
+
+// sign fills in the registered claims and signs with the current key, or
+// with secret under HS256
+func (s *JWTService) sign(claims TokenClaims, ttl time.Duration, secret []byte) (string, error) {
+	now := time.Now()
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+	}
+
+	if s.keys == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString(secret)
+	}
+
+	key := s.keys.Current()
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.private)
 }
 
 // validateToken validates a token and returns the claims
-func (s *JWTService) validateToken(tokenString string, secret []byte) (*TokenClaims, error) {
+func (s *JWTService) validateToken(tokenString string, secret []byte, expected TokenType) (*TokenClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// Verify signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		if s.keys == nil {
+			// Verify signing method
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return secret, nil
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		key := s.keys.Lookup(kid)
+		if key == nil {
+			return nil, fmt.Errorf("unknown signing key: %q", kid)
+		}
+		// The key decides the algorithm, never the token header
+		if token.Method.Alg() != key.method().Alg() {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return secret, nil
+		return key.Public(), nil
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*TokenClaims); ok && token.Valid {
-		return claims, nil
+	claims, ok := token.Claims.(*TokenClaims)
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
+	if claims.Type != "" && claims.Type != expected {
+		return nil, fmt.Errorf("unexpected token type: %s", claims.Type)
 	}
 
-	return nil, fmt.Errorf("invalid token")
+	return claims, nil
 }
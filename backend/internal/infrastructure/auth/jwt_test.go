@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+func testJWTConfig(t *testing.T, algorithm string) config.JWTConfig {
+	return config.JWTConfig{
+		Algorithm:              algorithm,
+		Secret:                 "access-secret",
+		RefreshSecret:          "refresh-secret",
+		KeysDir:                t.TempDir(),
+		KeyRotationInterval:    720 * time.Hour,
+		AccessTokenExpires:     15 * time.Minute,
+		RefreshTokenExpires:    168 * time.Hour,
+		MFAPendingTokenExpires: 5 * time.Minute,
+	}
+}
+
+func TestJWTService_SignAndValidate(t *testing.T) {
+	algorithms := []string{config.JWTAlgorithmHS256, config.JWTAlgorithmRS256, config.JWTAlgorithmEdDSA}
+	for _, algorithm := range algorithms {
+		t.Run(algorithm, func(t *testing.T) {
+			s, err := NewJWTService(testJWTConfig(t, algorithm))
+			if err != nil {
+				t.Fatalf("NewJWTService failed: %v", err)
+			}
+
+			access, err := s.GenerateAccessToken(7, "user@example.com", entity.RoleEditor, 3, true)
+			if err != nil {
+				t.Fatalf("GenerateAccessToken failed: %v", err)
+			}
+			claims, err := s.ValidateAccessToken(access)
+			if err != nil {
+				t.Fatalf("ValidateAccessToken failed: %v", err)
+			}
+			if claims.UserID != 7 || claims.TokenVersion != 3 || claims.Role != entity.RoleEditor || !claims.MFA {
+				t.Errorf("Unexpected claims: %+v", claims)
+			}
+			if got := claims.ExpiresAt.Sub(claims.IssuedAt.Time); got != 15*time.Minute {
+				t.Errorf("Expected access token lifetime from config, got %v", got)
+			}
+
+			refresh, err := s.GenerateRefreshToken(7, "user@example.com", 3, true)
+			if err != nil {
+				t.Fatalf("GenerateRefreshToken failed: %v", err)
+			}
+			if _, err := s.ValidateRefreshToken(refresh); err != nil {
+				t.Errorf("ValidateRefreshToken failed: %v", err)
+			}
+
+			// Each token type is only accepted where it belongs
+			if _, err := s.ValidateAccessToken(refresh); err == nil {
+				t.Error("Expected refresh token to be rejected as access token")
+			}
+			if _, err := s.ValidateRefreshToken(access); err == nil {
+				t.Error("Expected access token to be rejected as refresh token")
+			}
+			pending, err := s.GenerateMFAPendingToken(7, "user@example.com", 3)
+			if err != nil {
+				t.Fatalf("GenerateMFAPendingToken failed: %v", err)
+			}
+			if _, err := s.ValidateAccessToken(pending); err == nil {
+				t.Error("Expected MFA pending token to be rejected as access token")
+			}
+			if _, err := s.ValidateMFAPendingToken(pending); err != nil {
+				t.Errorf("ValidateMFAPendingToken failed: %v", err)
+			}
+
+			jwks := s.JWKS()
+			if algorithm == config.JWTAlgorithmHS256 {
+				if len(jwks.Keys) != 0 {
+					t.Errorf("Expected no published keys for HS256, got %d", len(jwks.Keys))
+				}
+				return
+			}
+
+			token, _, err := jwt.NewParser().ParseUnverified(access, &TokenClaims{})
+			if err != nil {
+				t.Fatalf("ParseUnverified failed: %v", err)
+			}
+			if len(jwks.Keys) != 1 || jwks.Keys[0].KeyID != token.Header["kid"] || jwks.Keys[0].Algorithm != algorithm {
+				t.Errorf("Expected JWKS to publish the signing key %v, got %+v", token.Header["kid"], jwks.Keys)
+			}
+		})
+	}
+}
+
+func TestJWTService_RejectsHS256WithPublicKey(t *testing.T) {
+	s, err := NewJWTService(testJWTConfig(t, config.JWTAlgorithmRS256))
+	if err != nil {
+		t.Fatalf("NewJWTService failed: %v", err)
+	}
+
+	// An HMAC token whose kid names a real key must not verify against it
+	key := s.keys.Current()
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, TokenClaims{UserID: 1, Role: entity.RoleAdmin})
+	forged.Header["kid"] = key.ID
+	signed, err := forged.SignedString([]byte(s.JWKS().Keys[0].N))
+	if err != nil {
+		t.Fatalf("SignedString failed: %v", err)
+	}
+	if _, err := s.ValidateAccessToken(signed); err == nil {
+		t.Error("Expected HS256 token to be rejected")
+	}
+}
+
+func TestKeySet_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	ks, err := NewKeySet(dir, config.JWTAlgorithmEdDSA)
+	if err != nil {
+		t.Fatalf("NewKeySet failed: %v", err)
+	}
+	first := ks.Current()
+	start := first.CreatedAt
+	retention := 24 * time.Hour
+
+	if key, err := ks.RotateIfDue(start.Add(time.Hour), 48*time.Hour); err != nil || key != nil {
+		t.Fatalf("Expected no rotation before the interval, got %v, %v", key, err)
+	}
+
+	second, err := ks.RotateIfDue(start.Add(48*time.Hour), 48*time.Hour)
+	if err != nil || second == nil {
+		t.Fatalf("Expected rotation after the interval, got %v, %v", second, err)
+	}
+
+	// The new key is published at once but only signs after the activation delay
+	if got := ks.current(second.CreatedAt); got != first {
+		t.Errorf("Expected first key to keep signing until the new key activates")
+	}
+	if got := ks.current(second.CreatedAt.Add(keyActivationDelay)); got != second {
+		t.Errorf("Expected new key to sign after the activation delay")
+	}
+	if len(ks.JWKS().Keys) != 2 {
+		t.Errorf("Expected both keys to be published, got %d", len(ks.JWKS().Keys))
+	}
+
+	// Another instance sharing the directory sees both keys
+	other, err := NewKeySet(dir, config.JWTAlgorithmEdDSA)
+	if err != nil {
+		t.Fatalf("NewKeySet failed: %v", err)
+	}
+	if other.Lookup(first.ID) == nil || other.Lookup(second.ID) == nil {
+		t.Error("Expected shared directory to provide both keys")
+	}
+
+	// The old key verifies until every token it signed has expired
+	activated := second.CreatedAt.Add(keyActivationDelay)
+	if pruned, err := ks.Prune(activated.Add(retention-time.Minute), retention); err != nil || len(pruned) != 0 {
+		t.Errorf("Expected no keys pruned within retention, got %v, %v", pruned, err)
+	}
+	pruned, err := ks.Prune(activated.Add(retention+time.Minute), retention)
+	if err != nil {
+		t.Fatalf("Prune failed: %v", err)
+	}
+	if len(pruned) != 1 || pruned[0] != first.ID {
+		t.Errorf("Expected first key to be pruned, got %v", pruned)
+	}
+	if ks.Lookup(first.ID) != nil {
+		t.Error("Expected pruned key to be gone")
+	}
+}
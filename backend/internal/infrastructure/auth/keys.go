@@ -0,0 +1,403 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/yourusername/viblog/internal/config"
+)
+
+const (
+	// rsaKeyBits is the modulus size of generated RS256 keys
+	rsaKeyBits = 2048
+	// keyReloadThrottle limits how often an unknown kid triggers a reload of
+	// the key directory, e.g. right after another instance rotated
+	keyReloadThrottle = 5 * time.Second
+	// keyActivationDelay is how long a new key is only published before it
+	// signs, so that other instances and clients caching the JWKS know it
+	// before they see tokens signed with it
+	keyActivationDelay = 10 * time.Minute
+	// pemCreatedHeader records when a key was generated
+	pemCreatedHeader = "Created"
+)
+
+// SigningKey is a private key used to sign tokens, identified by its kid
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	CreatedAt time.Time
+	private   crypto.Signer
+}
+
+// Public returns the key used to verify tokens signed with k
+func (k *SigningKey) Public() crypto.PublicKey {
+	return k.private.Public()
+}
+
+// method returns the JWT signing method for the key's algorithm
+func (k *SigningKey) method() jwt.SigningMethod {
+	if k.Algorithm == config.JWTAlgorithmEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// JWK is a public key in JSON Web Key format (RFC 7517)
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// KeySet holds the signing keys stored in a directory. The newest active key
+// of the configured algorithm signs; every key in the directory verifies, so
+// tokens signed before a rotation stay valid until the old key is pruned.
+type KeySet struct {
+	mu         sync.RWMutex
+	dir        string
+	algorithm  string
+	keys       []*SigningKey // Newest first
+	lastReload time.Time
+}
+
+// NewKeySet loads the keys in dir, generating a first key when there is none
+// for algorithm
+func NewKeySet(dir, algorithm string) (*KeySet, error) {
+	if algorithm != config.JWTAlgorithmRS256 && algorithm != config.JWTAlgorithmEdDSA {
+		return nil, fmt.Errorf("unsupported key algorithm: %s", algorithm)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create key directory: %w", err)
+	}
+
+	ks := &KeySet{dir: dir, algorithm: algorithm}
+	if err := ks.Reload(); err != nil {
+		return nil, err
+	}
+	if ks.latest() == nil {
+		if _, err := ks.Rotate(time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	return ks, nil
+}
+
+// Reload reads the key directory again, picking up keys rotated or pruned by
+// other instances
+func (ks *KeySet) Reload() error {
+	paths, err := filepath.Glob(filepath.Join(ks.dir, "*.pem"))
+	if err != nil {
+		return err
+	}
+
+	keys := make([]*SigningKey, 0, len(paths))
+	for _, path := range paths {
+		key, err := readKey(path)
+		if os.IsNotExist(err) {
+			continue // Pruned by another instance meanwhile
+		}
+		if err != nil {
+			return fmt.Errorf("failed to load key %s: %w", filepath.Base(path), err)
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.After(keys[j].CreatedAt)
+	})
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.lastReload = time.Now()
+	ks.mu.Unlock()
+	return nil
+}
+
+// Current returns the key that signs new tokens
+func (ks *KeySet) Current() *SigningKey {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	return ks.current(time.Now())
+}
+
+// current returns the newest key past its activation delay. Before the first
+// key of the algorithm activates, the oldest pending one signs.
+func (ks *KeySet) current(now time.Time) *SigningKey {
+	var pending *SigningKey
+	for _, key := range ks.keys {
+		if key.Algorithm != ks.algorithm {
+			continue
+		}
+		if now.Sub(key.CreatedAt) >= keyActivationDelay {
+			return key
+		}
+		pending = key
+	}
+	return pending
+}
+
+// latest returns the newest key of the algorithm, active or not
+func (ks *KeySet) latest() *SigningKey {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	for _, key := range ks.keys {
+		if key.Algorithm == ks.algorithm {
+			return key
+		}
+	}
+	return nil
+}
+
+// Lookup returns the key with the given kid, or nil when it is unknown
+func (ks *KeySet) Lookup(kid string) *SigningKey {
+	if key := ks.find(kid); key != nil {
+		return key
+	}
+
+	// Another instance may have rotated since the last reload
+	ks.mu.RLock()
+	stale := time.Since(ks.lastReload) > keyReloadThrottle
+	ks.mu.RUnlock()
+	if stale && ks.Reload() == nil {
+		return ks.find(kid)
+	}
+	return nil
+}
+
+func (ks *KeySet) find(kid string) *SigningKey {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	for _, key := range ks.keys {
+		if key.ID == kid {
+			return key
+		}
+	}
+	return nil
+}
+
+// Rotate generates a new signing key. It is published right away and signs
+// once keyActivationDelay has passed.
+func (ks *KeySet) Rotate(now time.Time) (*SigningKey, error) {
+	key, err := generateKey(ks.algorithm, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeKey(filepath.Join(ks.dir, key.ID+".pem"), key); err != nil {
+		return nil, err
+	}
+
+	ks.mu.Lock()
+	ks.keys = append([]*SigningKey{key}, ks.keys...)
+	ks.mu.Unlock()
+	return key, nil
+}
+
+// RotateIfDue rotates when the newest key is at least interval old.
+// It returns the new key, or nil when no rotation was needed.
+func (ks *KeySet) RotateIfDue(now time.Time, interval time.Duration) (*SigningKey, error) {
+	if interval <= 0 {
+		return nil, nil
+	}
+	if latest := ks.latest(); latest != nil && now.Sub(latest.CreatedAt) < interval {
+		return nil, nil
+	}
+	return ks.Rotate(now)
+}
+
+// Prune deletes keys that stopped signing more than retention ago, i.e. every
+// token they signed has expired. The current key is never pruned.
+// It returns the kids of the deleted keys.
+func (ks *KeySet) Prune(now time.Time, retention time.Duration) ([]string, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	current := ks.current(now)
+	kept := make([]*SigningKey, 0, len(ks.keys))
+	var pruned []string
+	for i, key := range ks.keys {
+		// A key signs until the next newer key activates
+		retired := i > 0 && now.Sub(ks.keys[i-1].CreatedAt) > keyActivationDelay+retention
+		if !retired || key == current {
+			kept = append(kept, key)
+			continue
+		}
+
+		err := os.Remove(filepath.Join(ks.dir, key.ID+".pem"))
+		if err != nil && !os.IsNotExist(err) {
+			ks.keys = append(kept, ks.keys[i:]...)
+			return pruned, err
+		}
+		pruned = append(pruned, key.ID)
+	}
+	ks.keys = kept
+
+	return pruned, nil
+}
+
+// JWKS returns the public keys of the set
+func (ks *KeySet) JWKS() JWKS {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	set := JWKS{Keys: make([]JWK, 0, len(ks.keys))}
+	for _, key := range ks.keys {
+		set.Keys = append(set.Keys, publicJWK(key))
+	}
+	return set
+}
+
+// publicJWK converts the public half of a key to a JWK
+func publicJWK(key *SigningKey) JWK {
+	jwk := JWK{KeyID: key.ID, Use: "sig", Algorithm: key.Algorithm}
+	switch pub := key.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return jwk
+}
+
+// thumbprint computes the RFC 7638 JWK thumbprint used as kid
+func thumbprint(signer crypto.Signer) string {
+	var canonical string
+	switch pub := signer.Public().(type) {
+	case *rsa.PublicKey:
+		canonical = fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`,
+			base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			base64.RawURLEncoding.EncodeToString(pub.N.Bytes()))
+	case ed25519.PublicKey:
+		canonical = fmt.Sprintf(`{"crv":"Ed25519","kty":"OKP","x":"%s"}`,
+			base64.RawURLEncoding.EncodeToString(pub))
+	}
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// generateKey creates a key pair for the algorithm
+func generateKey(algorithm string, now time.Time) (*SigningKey, error) {
+	var signer crypto.Signer
+	switch algorithm {
+	case config.JWTAlgorithmRS256:
+		key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate RSA key: %w", err)
+		}
+		signer = key
+	case config.JWTAlgorithmEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate Ed25519 key: %w", err)
+		}
+		signer = key
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm: %s", algorithm)
+	}
+
+	return &SigningKey{
+		ID:        thumbprint(signer),
+		Algorithm: algorithm,
+		CreatedAt: now.UTC().Truncate(time.Second),
+		private:   signer,
+	}, nil
+}
+
+// writeKey stores a key as a PKCS#8 PEM file. The file is written under a
+// temporary name and renamed so other instances never read a partial key.
+func writeKey(path string, key *SigningKey) error {
+	der, err := x509.MarshalPKCS8PrivateKey(key.private)
+	if err != nil {
+		return fmt.Errorf("failed to encode key: %w", err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{
+		Type:    "PRIVATE KEY",
+		Headers: map[string]string{pemCreatedHeader: key.CreatedAt.Format(time.RFC3339)},
+		Bytes:   der,
+	})
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write key: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to write key: %w", err)
+	}
+	return nil
+}
+
+// readKey loads a key written by writeKey. Keys without a Created header,
+// e.g. provisioned by hand, take the file's modification time.
+func readKey(path string) (*SigningKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("not a PKCS#8 PEM private key")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &SigningKey{}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Algorithm = config.JWTAlgorithmRS256
+		key.private = k
+	case ed25519.PrivateKey:
+		key.Algorithm = config.JWTAlgorithmEdDSA
+		key.private = k
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+	key.ID = thumbprint(key.private)
+
+	if created, ok := block.Headers[pemCreatedHeader]; ok {
+		if key.CreatedAt, err = time.Parse(time.RFC3339, strings.TrimSpace(created)); err != nil {
+			return nil, fmt.Errorf("invalid %s header: %w", pemCreatedHeader, err)
+		}
+	} else {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		key.CreatedAt = info.ModTime().UTC()
+	}
+
+	return key, nil
+}
@@ -7,14 +7,44 @@ import (
 
 // RunMigrations runs all database migrations
 func RunMigrations(db *gorm.DB) error {
-	return db.AutoMigrate(
+	if err := db.AutoMigrate(
 		&entity.User{},
 		&entity.Post{},
+		&entity.ViewLog{},
 		&entity.Comment{},
+		&entity.CommentMention{},
+		&entity.CommentReport{},
+		&entity.CommentRevision{},
+		&entity.CommentSubscription{},
 		&entity.Category{},
 		&entity.Tag{},
 		&entity.Like{},
 		&entity.Bookmark{},
 		&entity.Notification{},
-	)
+		&entity.PostTranslation{},
+		&entity.CategoryTranslation{},
+		&entity.TagTranslation{},
+		&entity.UserToken{},
+		&entity.UserIdentity{},
+		&entity.RecoveryCode{},
+		&entity.LoginThrottle{},
+		&entity.PersonalAccessToken{},
+		&entity.Ban{},
+		&entity.QueuedEmail{},
+		&entity.PostDailyStat{},
+		&entity.PostDailyBreakdown{},
+		&entity.AuditLog{},
+	); err != nil {
+		return err
+	}
+
+	return migrateAdminRoles(db)
+}
+
+// migrateAdminRoles gives accounts created before roles existed the admin
+// role when they have the IsAdmin flag
+func migrateAdminRoles(db *gorm.DB) error {
+	return db.Model(&entity.User{}).
+		Where("is_admin = ? AND role = ?", true, entity.RoleMember).
+		Update("role", entity.RoleAdmin).Error
 }
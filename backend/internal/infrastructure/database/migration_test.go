@@ -0,0 +1,36 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestRunMigrations(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to open test database: %v", err)
+	}
+
+	if err := RunMigrations(db); err != nil {
+		t.Fatalf("RunMigrations() error = %v", err)
+	}
+	// Migrations run on every start, so they must be repeatable
+	if err := RunMigrations(db); err != nil {
+		t.Fatalf("RunMigrations() second run error = %v", err)
+	}
+
+	// Tables read and written by view tracking and the analytics rollup
+	for _, model := range []interface{}{&entity.ViewLog{}, &entity.PostDailyStat{}, &entity.PostDailyBreakdown{}} {
+		if !db.Migrator().HasTable(model) {
+			t.Errorf("Expected a table for %T", model)
+		}
+	}
+	for _, column := range []string{"ReferrerHost", "UTMSource", "UTMMedium", "UTMCampaign", "Device", "Browser", "OS", "Country"} {
+		if !db.Migrator().HasColumn(&entity.ViewLog{}, column) {
+			t.Errorf("Expected view_logs to have a column for %s", column)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package geoip
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Resolver maps IP addresses to countries
+type Resolver interface {
+	// Country returns the ISO 3166-1 alpha-2 code of the country an IP
+	// address is located in, or "" when it is unknown
+	Country(ip string) string
+}
+
+// NewResolver opens the MaxMind-format country or city database at path,
+// such as GeoLite2-Country.mmdb. An empty path disables lookups.
+func NewResolver(path string) (Resolver, error) {
+	if path == "" {
+		return noopResolver{}, nil
+	}
+
+	db, err := openMMDB(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GeoIP database %s: %w", path, err)
+	}
+	return &Database{db: db}, nil
+}
+
+// Database resolves countries from a MaxMind DB file loaded into memory
+type Database struct {
+	db *mmdb
+}
+
+// Country looks ip up, falling back to the country the network is
+// registered in when the database has no location for it
+func (d *Database) Country(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ""
+	}
+
+	record, err := d.db.lookup(parsed)
+	if err != nil {
+		return ""
+	}
+	fields, ok := record.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	for _, key := range []string{"country", "registered_country"} {
+		country, ok := fields[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if code, ok := country["iso_code"].(string); ok && len(code) == 2 {
+			return strings.ToUpper(code)
+		}
+	}
+	return ""
+}
+
+// noopResolver is used when no database is configured
+type noopResolver struct{}
+
+// Country always returns ""
+func (noopResolver) Country(string) string {
+	return ""
+}
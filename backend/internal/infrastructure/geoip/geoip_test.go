@@ -0,0 +1,190 @@
+package geoip
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// trieNode is a search tree node while building a test database
+type trieNode struct {
+	next [2]*trieNode
+	data [2]int // Data section offset, or -1
+}
+
+func newTrieNode() *trieNode {
+	return &trieNode{data: [2]int{-1, -1}}
+}
+
+// buildDatabase writes a MaxMind DB pointing each IPv4 network at the data
+// section offset of the same index. Networks must not overlap. IPv6
+// databases hold IPv4 networks under ::/96.
+func buildDatabase(t *testing.T, ipVersion, recordSize int, data []byte, networks []string, offsets []int) []byte {
+	t.Helper()
+
+	root := newTrieNode()
+	for i, n := range networks {
+		_, ipNet, err := net.ParseCIDR(n)
+		if err != nil {
+			t.Fatalf("Invalid CIDR %s: %v", n, err)
+		}
+		ones, _ := ipNet.Mask.Size()
+		addr := []byte(ipNet.IP.To4())
+		if ipVersion == 6 {
+			addr = append(make([]byte, 12), addr...)
+			ones += 96
+		}
+
+		node := root
+		for b := 0; b < ones; b++ {
+			bit := (addr[b/8] >> (7 - uint(b%8))) & 1
+			if b == ones-1 {
+				node.data[bit] = offsets[i]
+				break
+			}
+			if node.next[bit] == nil {
+				node.next[bit] = newTrieNode()
+			}
+			node = node.next[bit]
+		}
+	}
+
+	var nodes []*trieNode
+	index := map[*trieNode]int{}
+	for queue := []*trieNode{root}; len(queue) > 0; queue = queue[1:] {
+		n := queue[0]
+		index[n] = len(nodes)
+		nodes = append(nodes, n)
+		for _, child := range n.next {
+			if child != nil {
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	count := uint32(len(nodes))
+	value := func(n *trieNode, bit int) uint32 {
+		switch {
+		case n.next[bit] != nil:
+			return uint32(index[n.next[bit]])
+		case n.data[bit] >= 0:
+			return count + dataSectionSeparator + uint32(n.data[bit])
+		default:
+			return count
+		}
+	}
+
+	var buf []byte
+	for _, n := range nodes {
+		left, right := value(n, 0), value(n, 1)
+		switch recordSize {
+		case 24:
+			buf = append(buf, byte(left>>16), byte(left>>8), byte(left), byte(right>>16), byte(right>>8), byte(right))
+		case 28:
+			buf = append(buf, byte(left>>16), byte(left>>8), byte(left),
+				byte(left>>24)<<4|byte(right>>24)&0x0F,
+				byte(right>>16), byte(right>>8), byte(right))
+		}
+	}
+	buf = append(buf, make([]byte, dataSectionSeparator)...)
+	buf = append(buf, data...)
+	buf = append(buf, metadataMarker...)
+	buf = append(buf, encodeMap(
+		"binary_format_major_version", encodeUint(typeUint16, 2),
+		"database_type", encodeString("Test-Country"),
+		"ip_version", encodeUint(typeUint16, uint64(ipVersion)),
+		"node_count", encodeUint(typeUint32, uint64(count)),
+		"record_size", encodeUint(typeUint16, uint64(recordSize)),
+	)...)
+	return buf
+}
+
+func encodeString(s string) []byte {
+	return append([]byte{typeString<<5 | byte(len(s))}, s...)
+}
+
+func encodeUint(typ int, v uint64) []byte {
+	var payload []byte
+	for ; v > 0; v >>= 8 {
+		payload = append([]byte{byte(v)}, payload...)
+	}
+	return append([]byte{byte(typ)<<5 | byte(len(payload))}, payload...)
+}
+
+func encodePointer(offset int) []byte {
+	return []byte{typePointer<<5 | byte(offset>>8)&0x07, byte(offset)}
+}
+
+// encodeMap encodes alternating string keys and encoded values
+func encodeMap(pairs ...interface{}) []byte {
+	buf := []byte{typeMap<<5 | byte(len(pairs)/2)}
+	for i := 0; i < len(pairs); i += 2 {
+		buf = append(buf, encodeString(pairs[i].(string))...)
+		buf = append(buf, pairs[i+1].([]byte)...)
+	}
+	return buf
+}
+
+func TestDatabase_Country(t *testing.T) {
+	korea := encodeMap("iso_code", encodeString("KR"))
+	first := encodeMap("country", korea)
+	// The second record shares the first one's country map through a pointer
+	// and the third only knows where its network is registered
+	second := encodeMap("country", encodePointer(1+len(encodeString("country"))), "continent", encodeMap("code", encodeString("AS")))
+	third := encodeMap("registered_country", encodeMap("iso_code", encodeString("us")))
+
+	var data []byte
+	var offsets []int
+	for _, record := range [][]byte{first, second, third} {
+		offsets = append(offsets, len(data))
+		data = append(data, record...)
+	}
+	networks := []string{"1.0.0.0/24", "1.0.1.0/24", "8.8.8.0/24"}
+
+	for _, tc := range []struct {
+		ipVersion  int
+		recordSize int
+	}{{4, 24}, {6, 24}, {6, 28}} {
+		path := filepath.Join(t.TempDir(), "test.mmdb")
+		if err := os.WriteFile(path, buildDatabase(t, tc.ipVersion, tc.recordSize, data, networks, offsets), 0o600); err != nil {
+			t.Fatalf("Failed to write database: %v", err)
+		}
+
+		resolver, err := NewResolver(path)
+		if err != nil {
+			t.Fatalf("IPv%d/%d: NewResolver failed: %v", tc.ipVersion, tc.recordSize, err)
+		}
+
+		for ip, want := range map[string]string{
+			"1.0.0.7":     "KR",
+			"1.0.1.255":   "KR",
+			"8.8.8.8":     "US",
+			"9.9.9.9":     "",
+			"2001:db8::1": "",
+			"not an ip":   "",
+		} {
+			if got := resolver.Country(ip); got != want {
+				t.Errorf("IPv%d/%d: Country(%q) = %q, want %q", tc.ipVersion, tc.recordSize, ip, got, want)
+			}
+		}
+	}
+}
+
+func TestNewResolver(t *testing.T) {
+	resolver, err := NewResolver("")
+	if err != nil {
+		t.Fatalf("NewResolver without a path failed: %v", err)
+	}
+	if got := resolver.Country("8.8.8.8"); got != "" {
+		t.Errorf("Expected no country without a database, got %q", got)
+	}
+
+	path := filepath.Join(t.TempDir(), "broken.mmdb")
+	if err := os.WriteFile(path, []byte("not a database"), 0o600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if _, err := NewResolver(path); err == nil {
+		t.Error("Expected an error for a file that is not a MaxMind DB")
+	}
+}
@@ -0,0 +1,339 @@
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"os"
+)
+
+// metadataMarker precedes the metadata map at the end of a MaxMind DB file
+var metadataMarker = []byte("\xAB\xCD\xEFMaxMind.com")
+
+// dataSectionSeparator is the zero padding between the search tree and the data section
+const dataSectionSeparator = 16
+
+// maxDecodeDepth bounds nesting so a corrupt file cannot recurse forever
+const maxDecodeDepth = 32
+
+// Data field types of the MaxMind DB format
+const (
+	typeExtended = iota
+	typePointer
+	typeString
+	typeDouble
+	typeBytes
+	typeUint16
+	typeUint32
+	typeMap
+	typeInt32
+	typeUint64
+	typeUint128
+	typeArray
+	typeContainer
+	typeEndMarker
+	typeBool
+	typeFloat
+)
+
+// mmdb reads a MaxMind DB (.mmdb) file held in memory. See
+// https://maxmind.github.io/MaxMind-DB/ for the format.
+type mmdb struct {
+	buf        []byte
+	tree       []byte
+	data       []byte
+	nodeCount  uint
+	recordSize uint
+	ipVersion  uint
+	ipv4Start  uint // Node of ::/96 in IPv6 trees, where IPv4 addresses start
+	dbType     string
+}
+
+// openMMDB reads and validates a MaxMind DB file
+func openMMDB(path string) (*mmdb, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseMMDB(buf)
+}
+
+// parseMMDB validates a MaxMind DB file's metadata and splits it into sections
+func parseMMDB(buf []byte) (*mmdb, error) {
+	start := bytes.LastIndex(buf, metadataMarker)
+	if start < 0 {
+		return nil, errors.New("geoip: metadata not found, not a MaxMind DB file")
+	}
+	metaBuf := buf[start+len(metadataMarker):]
+
+	raw, _, err := (&decoder{buf: metaBuf}).decode(0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("geoip: metadata: %w", err)
+	}
+	meta, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("geoip: metadata is not a map")
+	}
+
+	db := &mmdb{buf: buf}
+	db.nodeCount, _ = toUint(meta["node_count"])
+	db.recordSize, _ = toUint(meta["record_size"])
+	db.ipVersion, _ = toUint(meta["ip_version"])
+	db.dbType, _ = meta["database_type"].(string)
+
+	switch db.recordSize {
+	case 24, 28, 32:
+	default:
+		return nil, fmt.Errorf("geoip: unsupported record size %d", db.recordSize)
+	}
+	if db.ipVersion != 4 && db.ipVersion != 6 {
+		return nil, fmt.Errorf("geoip: unsupported IP version %d", db.ipVersion)
+	}
+
+	treeSize := db.nodeCount * db.recordSize / 4
+	if treeSize+dataSectionSeparator > uint(start) {
+		return nil, errors.New("geoip: search tree larger than file")
+	}
+	db.tree = buf[:treeSize]
+	db.data = buf[treeSize+dataSectionSeparator : start]
+
+	if db.ipVersion == 6 {
+		node := uint(0)
+		for i := 0; i < 96 && node < db.nodeCount; i++ {
+			node = db.record(node, 0)
+		}
+		db.ipv4Start = node
+	}
+	return db, nil
+}
+
+// lookup decodes the record of the network containing ip, or returns nil
+// when the database has none
+func (db *mmdb) lookup(ip net.IP) (interface{}, error) {
+	node := uint(0)
+	bits := 128
+	addr := ip.To16()
+	if ip4 := ip.To4(); ip4 != nil {
+		addr, bits = ip4, 32
+		if db.ipVersion == 6 {
+			node = db.ipv4Start
+		}
+	} else if db.ipVersion == 4 {
+		return nil, nil
+	}
+	if addr == nil {
+		return nil, fmt.Errorf("geoip: invalid IP address %v", ip)
+	}
+
+	for i := 0; i < bits && node < db.nodeCount; i++ {
+		bit := uint(addr[i/8]>>(7-uint(i%8))) & 1
+		node = db.record(node, bit)
+	}
+
+	switch {
+	case node == db.nodeCount:
+		return nil, nil
+	case node < db.nodeCount:
+		return nil, errors.New("geoip: search tree deeper than the address")
+	}
+
+	offset := node - db.nodeCount - dataSectionSeparator
+	if offset >= uint(len(db.data)) {
+		return nil, errors.New("geoip: record points outside the data section")
+	}
+	value, _, err := (&decoder{buf: db.data}).decode(offset, 0)
+	return value, err
+}
+
+// record returns the left (bit 0) or right (bit 1) record of a node
+func (db *mmdb) record(node, bit uint) uint {
+	switch db.recordSize {
+	case 24:
+		b := db.tree[node*6+bit*3:]
+		return uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+	case 28:
+		b := db.tree[node*7:]
+		if bit == 0 {
+			return uint(b[3]&0xF0)<<20 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+		}
+		return uint(b[3]&0x0F)<<24 | uint(b[4])<<16 | uint(b[5])<<8 | uint(b[6])
+	default:
+		return uint(binary.BigEndian.Uint32(db.tree[node*8+bit*4:]))
+	}
+}
+
+// decoder decodes fields of a data section
+type decoder struct {
+	buf []byte
+}
+
+// decode decodes the field at offset and returns it with the offset after it
+func (d *decoder) decode(offset uint, depth int) (interface{}, uint, error) {
+	if depth > maxDecodeDepth {
+		return nil, 0, errors.New("data nested too deeply")
+	}
+
+	typ, size, offset, err := d.control(offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if typ == typePointer {
+		target, next, err := d.pointer(size, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		value, _, err := d.decode(target, depth+1)
+		return value, next, err
+	}
+
+	switch typ {
+	case typeMap:
+		m := make(map[string]interface{}, size)
+		for i := uint(0); i < size; i++ {
+			key, next, err := d.decode(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			k, ok := key.(string)
+			if !ok {
+				return nil, 0, errors.New("map key is not a string")
+			}
+			value, next, err := d.decode(next, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			m[k] = value
+			offset = next
+		}
+		return m, offset, nil
+	case typeArray:
+		a := make([]interface{}, 0, size)
+		for i := uint(0); i < size; i++ {
+			value, next, err := d.decode(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			a = append(a, value)
+			offset = next
+		}
+		return a, offset, nil
+	case typeBool:
+		return size != 0, offset, nil
+	}
+
+	if offset+size > uint(len(d.buf)) {
+		return nil, 0, errors.New("field runs past the end of the data")
+	}
+	b := d.buf[offset : offset+size]
+	next := offset + size
+
+	switch typ {
+	case typeString:
+		return string(b), next, nil
+	case typeBytes:
+		return append([]byte(nil), b...), next, nil
+	case typeDouble:
+		if size != 8 {
+			return nil, 0, errors.New("invalid double size")
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), next, nil
+	case typeFloat:
+		if size != 4 {
+			return nil, 0, errors.New("invalid float size")
+		}
+		return math.Float32frombits(binary.BigEndian.Uint32(b)), next, nil
+	case typeUint16, typeUint32, typeUint64:
+		var v uint64
+		for _, c := range b {
+			v = v<<8 | uint64(c)
+		}
+		return v, next, nil
+	case typeInt32:
+		var v uint32
+		for _, c := range b {
+			v = v<<8 | uint32(c)
+		}
+		return int64(int32(v)), next, nil
+	case typeUint128:
+		return append([]byte(nil), b...), next, nil
+	default:
+		return nil, 0, fmt.Errorf("unsupported data type %d", typ)
+	}
+}
+
+// control reads a field's control byte and returns its type, its size or
+// pointer bits, and the offset of its payload
+func (d *decoder) control(offset uint) (int, uint, uint, error) {
+	if offset >= uint(len(d.buf)) {
+		return 0, 0, 0, errors.New("offset past the end of the data")
+	}
+	ctrl := d.buf[offset]
+	offset++
+
+	typ := int(ctrl >> 5)
+	if typ == typeExtended {
+		if offset >= uint(len(d.buf)) {
+			return 0, 0, 0, errors.New("truncated extended type")
+		}
+		typ = 7 + int(d.buf[offset])
+		offset++
+	}
+	if typ == typePointer {
+		return typ, uint(ctrl & 0x1F), offset, nil
+	}
+
+	size := uint(ctrl & 0x1F)
+	if size >= 29 {
+		n := size - 28
+		if offset+n > uint(len(d.buf)) {
+			return 0, 0, 0, errors.New("truncated size")
+		}
+		var extra uint
+		for _, c := range d.buf[offset : offset+n] {
+			extra = extra<<8 | uint(c)
+		}
+		offset += n
+		switch size {
+		case 29:
+			size = 29 + extra
+		case 30:
+			size = 285 + extra
+		default:
+			size = 65821 + extra
+		}
+	}
+	return typ, size, offset, nil
+}
+
+// pointer resolves a pointer field from its control bits and returns the
+// offset it points to and the offset after it
+func (d *decoder) pointer(bits, offset uint) (uint, uint, error) {
+	n := (bits>>3)&0x3 + 1
+	if offset+n > uint(len(d.buf)) {
+		return 0, 0, errors.New("truncated pointer")
+	}
+	var v uint
+	if n < 4 {
+		v = bits & 0x7
+	}
+	for _, c := range d.buf[offset : offset+n] {
+		v = v<<8 | uint(c)
+	}
+	switch n {
+	case 2:
+		v += 2048
+	case 3:
+		v += 526336
+	}
+	return v, offset + n, nil
+}
+
+// toUint converts a decoded unsigned integer
+func toUint(v interface{}) (uint, bool) {
+	n, ok := v.(uint64)
+	return uint(n), ok
+}
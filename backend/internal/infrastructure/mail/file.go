@@ -0,0 +1,42 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+// FileMailer writes each message to an .eml file for development and tests
+type FileMailer struct {
+	dir  string
+	from string
+	seq  atomic.Uint64
+}
+
+// NewFileMailer creates a new FileMailer, creating dir if needed
+func NewFileMailer(dir, from string) (*FileMailer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mail directory: %w", err)
+	}
+	return &FileMailer{dir: dir, from: from}, nil
+}
+
+// Send writes the message to <dir>/<timestamp>-<seq>.eml
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	name := fmt.Sprintf("%s-%04d.eml", now.UTC().Format("20060102T150405.000000000"), m.seq.Add(1))
+
+	return os.WriteFile(filepath.Join(m.dir, name), render(m.from, msg, now), 0o600)
+}
+
+// Dir returns the directory messages are written to
+func (m *FileMailer) Dir() string {
+	return m.dir
+}
@@ -0,0 +1,28 @@
+package mail
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// LogMailer writes messages to the application log instead of delivering them
+type LogMailer struct {
+	logger *zap.Logger
+}
+
+// NewLogMailer creates a new LogMailer
+func NewLogMailer(logger *zap.Logger) *LogMailer {
+	return &LogMailer{logger: logger}
+}
+
+// Send logs the message
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	m.logger.Info("Mail sent",
+		zap.String("to", msg.To),
+		zap.String("subject", msg.Subject),
+		zap.String("body", msg.Body),
+		zap.String("unsubscribe", msg.Unsubscribe),
+	)
+	return nil
+}
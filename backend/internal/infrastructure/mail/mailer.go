@@ -0,0 +1,64 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"go.uber.org/zap"
+)
+
+// Message represents a plain-text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+
+	// Unsubscribe is a link that stops notifications like this one, sent
+	// as the List-Unsubscribe header; empty for account emails
+	Unsubscribe string
+}
+
+// Mailer delivers email messages
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewMailer creates the Mailer selected by MAIL_DRIVER
+func NewMailer(cfg config.MailConfig, logger *zap.Logger) (Mailer, error) {
+	switch cfg.Driver {
+	case "smtp":
+		return NewSMTPMailer(cfg), nil
+	case "file":
+		return NewFileMailer(cfg.FileDir, cfg.From)
+	case "log":
+		return NewLogMailer(logger), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver: %s", cfg.Driver)
+	}
+}
+
+// render formats a message as an RFC 5322 document
+func render(from string, msg Message, now time.Time) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + headerValue(from) + "\r\n")
+	b.WriteString("To: " + headerValue(msg.To) + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", headerValue(msg.Subject)) + "\r\n")
+	b.WriteString("Date: " + now.Format(time.RFC1123Z) + "\r\n")
+	if msg.Unsubscribe != "" {
+		b.WriteString("List-Unsubscribe: <" + headerValue(msg.Unsubscribe) + ">\r\n")
+	}
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+// headerValue strips line breaks so user data cannot inject extra headers
+func headerValue(v string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(v)
+}
@@ -0,0 +1,100 @@
+package mail
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+)
+
+const (
+	// queueBatchSize is the number of emails delivered per run
+	queueBatchSize = 50
+	// queueMaxAttempts is the number of deliveries tried before an email is given up on
+	queueMaxAttempts = 5
+	// queueSentRetention is how long delivered emails are kept
+	queueSentRetention = 7 * 24 * time.Hour
+)
+
+// Queue stores notification emails and delivers them in the background, so
+// requests do not wait on the mail server. Each address receives at most
+// AddressLimit queued emails per AddressWindow.
+type Queue struct {
+	repo   repository.QueuedEmailRepository
+	mailer Mailer
+	limit  int
+	window time.Duration
+}
+
+// NewQueue creates a new Queue delivering through mailer
+func NewQueue(repo repository.QueuedEmailRepository, mailer Mailer, cfg config.MailConfig) *Queue {
+	return &Queue{
+		repo:   repo,
+		mailer: mailer,
+		limit:  cfg.AddressLimit,
+		window: cfg.AddressWindow,
+	}
+}
+
+// Enqueue queues a message and reports whether it was accepted. Messages to
+// an address that reached its limit are dropped.
+func (q *Queue) Enqueue(ctx context.Context, msg Message, now time.Time) (bool, error) {
+	to := strings.ToLower(strings.TrimSpace(msg.To))
+
+	if q.limit > 0 {
+		count, err := q.repo.CountByAddressSince(ctx, to, now.Add(-q.window))
+		if err != nil {
+			return false, err
+		}
+		if count >= int64(q.limit) {
+			return false, nil
+		}
+	}
+
+	err := q.repo.Create(ctx, &entity.QueuedEmail{
+		To:          to,
+		Subject:     msg.Subject,
+		Body:        msg.Body,
+		Unsubscribe: msg.Unsubscribe,
+	})
+	return err == nil, err
+}
+
+// Deliver sends the pending emails, oldest first, and returns how many were
+// sent. Failed emails are retried on later runs up to a limit. Delivered
+// emails older than a week are removed.
+func (q *Queue) Deliver(ctx context.Context, now time.Time) (int, error) {
+	if _, err := q.repo.DeleteSentBefore(ctx, now.Add(-queueSentRetention)); err != nil {
+		return 0, err
+	}
+
+	emails, err := q.repo.FindPending(ctx, queueMaxAttempts, queueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range emails {
+		email := &emails[i]
+		msg := Message{
+			To:          email.To,
+			Subject:     email.Subject,
+			Body:        email.Body,
+			Unsubscribe: email.Unsubscribe,
+		}
+		if err := q.mailer.Send(ctx, msg); err != nil {
+			if err := q.repo.MarkFailed(ctx, email.ID, err.Error()); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		if err := q.repo.MarkSent(ctx, email.ID, now); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
@@ -0,0 +1,50 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+)
+
+// SMTPMailer delivers messages through an SMTP relay
+type SMTPMailer struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+}
+
+// NewSMTPMailer creates a new SMTPMailer
+func NewSMTPMailer(cfg config.MailConfig) *SMTPMailer {
+	return &SMTPMailer{
+		addr:     fmt.Sprintf("%s:%d", cfg.SMTPHost, cfg.SMTPPort),
+		host:     cfg.SMTPHost,
+		username: cfg.SMTPUsername,
+		password: cfg.SMTPPassword,
+		from:     cfg.From,
+	}
+}
+
+// Send delivers a message, upgrading to STARTTLS when the server offers it
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	sender, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	var auth smtp.Auth
+	if m.username != "" {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+
+	return smtp.SendMail(m.addr, auth, sender.Address, []string{msg.To}, render(m.from, msg, time.Now()))
+}
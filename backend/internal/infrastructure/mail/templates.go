@@ -0,0 +1,147 @@
+package mail
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type mailTemplate struct {
+	subject string
+	body    string
+}
+
+var verificationTemplates = map[string]mailTemplate{
+	"en": {
+		subject: "Verify your Viblog email address",
+		body: "Hi %s,\n\n" +
+			"Confirm your email address by opening the link below:\n\n%s\n\n" +
+			"The link expires in %s. If you did not create a Viblog account, you can ignore this email.\n",
+	},
+	"ko": {
+		subject: "Viblog 이메일 주소를 인증해 주세요",
+		body: "%s님, 안녕하세요.\n\n" +
+			"아래 링크를 열어 이메일 주소를 인증해 주세요.\n\n%s\n\n" +
+			"링크는 %s 후 만료됩니다. Viblog 계정을 만든 적이 없다면 이 메일을 무시하셔도 됩니다.\n",
+	},
+}
+
+var passwordResetTemplates = map[string]mailTemplate{
+	"en": {
+		subject: "Reset your Viblog password",
+		body: "Hi %s,\n\n" +
+			"We received a request to reset your password. Open the link below to choose a new one:\n\n%s\n\n" +
+			"The link expires in %s and can be used once. If you did not request a reset, you can ignore this email.\n",
+	},
+	"ko": {
+		subject: "Viblog 비밀번호 재설정",
+		body: "%s님, 안녕하세요.\n\n" +
+			"비밀번호 재설정 요청을 받았습니다. 아래 링크를 열어 새 비밀번호를 설정해 주세요.\n\n%s\n\n" +
+			"링크는 %s 후 만료되며 한 번만 사용할 수 있습니다. 요청한 적이 없다면 이 메일을 무시하셔도 됩니다.\n",
+	},
+}
+
+var accountDeletionTemplates = map[string]mailTemplate{
+	"en": {
+		subject: "Your Viblog account is scheduled for deletion",
+		body: "Hi %s,\n\n" +
+			"We received a request to delete your account. If you change your mind, sign in before the deletion date:\n\n%s\n\n" +
+			"Otherwise your account will be deleted in %s. Your comments will stay on the site without your name; everything else is removed.\n",
+	},
+	"ko": {
+		subject: "Viblog 계정 삭제가 예약되었습니다",
+		body: "%s님, 안녕하세요.\n\n" +
+			"계정 삭제 요청을 받았습니다. 마음이 바뀌었다면 삭제일 전에 로그인해 주세요.\n\n%s\n\n" +
+			"그렇지 않으면 %s 후 계정이 삭제됩니다. 작성한 댓글은 이름 없이 남고, 나머지 정보는 모두 삭제됩니다.\n",
+	},
+}
+
+var commentSubscriptionTemplates = map[string]mailTemplate{
+	"en": {
+		subject: "Confirm reply notifications from Viblog",
+		body: "Hi %s,\n\n" +
+			"You asked to be emailed when someone replies to your comment on \"%s\". Confirm by opening the link below:\n\n%s\n\n" +
+			"The link expires in %s. If you did not ask for this, ignore this email and nothing more will be sent.\n",
+	},
+	"ko": {
+		subject: "Viblog 답글 알림 신청을 확인해 주세요",
+		body: "%s님, 안녕하세요.\n\n" +
+			"\"%s\"에 남긴 댓글에 답글이 달리면 이메일로 알려 달라고 요청하셨습니다. 아래 링크를 열어 확인해 주세요.\n\n%s\n\n" +
+			"링크는 %s 후 만료됩니다. 요청한 적이 없다면 이 메일을 무시하세요. 더 이상 메일이 발송되지 않습니다.\n",
+	},
+}
+
+var commentReplyTemplates = map[string]mailTemplate{
+	"en": {
+		subject: "New reply to your comment on Viblog",
+		body: "Hi %s,\n\n" +
+			"%s replied to your comment on \"%s\":\n\n%s\n\n" +
+			"Read the conversation:\n%s\n\n" +
+			"To stop emails about replies to this comment, open this link:\n%s\n",
+	},
+	"ko": {
+		subject: "Viblog 댓글에 새 답글이 달렸습니다",
+		body: "%s님, 안녕하세요.\n\n" +
+			"%s님이 \"%s\"에 남긴 댓글에 답글을 달았습니다.\n\n%s\n\n" +
+			"대화 보기:\n%s\n\n" +
+			"이 댓글의 답글 알림을 그만 받으려면 아래 링크를 여세요.\n%s\n",
+	},
+}
+
+// VerificationEmail builds the email verification message in the given locale
+func VerificationEmail(locale, to, nickname, link string, ttl time.Duration) Message {
+	return build(verificationTemplates, locale, to, nickname, link, ttl)
+}
+
+// PasswordResetEmail builds the password reset message in the given locale
+func PasswordResetEmail(locale, to, nickname, link string, ttl time.Duration) Message {
+	return build(passwordResetTemplates, locale, to, nickname, link, ttl)
+}
+
+// AccountDeletionEmail builds the message confirming a scheduled account deletion in the given locale
+func AccountDeletionEmail(locale, to, nickname, link string, grace time.Duration) Message {
+	return build(accountDeletionTemplates, locale, to, nickname, link, grace)
+}
+
+// CommentSubscriptionEmail builds the message asking an anonymous commenter
+// to confirm reply notifications in the given locale
+func CommentSubscriptionEmail(locale, to, name, postTitle, link string, ttl time.Duration) Message {
+	tmpl := pick(commentSubscriptionTemplates, locale)
+
+	return Message{
+		To:      to,
+		Subject: tmpl.subject,
+		Body:    fmt.Sprintf(tmpl.body, name, postTitle, link, ttl),
+	}
+}
+
+// CommentReplyEmail builds the reply notification for a subscribed commenter
+// in the given locale
+func CommentReplyEmail(locale, to, name, replier, postTitle, excerpt, link, unsubscribeLink string) Message {
+	tmpl := pick(commentReplyTemplates, locale)
+
+	return Message{
+		To:          to,
+		Subject:     tmpl.subject,
+		Body:        fmt.Sprintf(tmpl.body, name, replier, postTitle, excerpt, link, unsubscribeLink),
+		Unsubscribe: unsubscribeLink,
+	}
+}
+
+func build(templates map[string]mailTemplate, locale, to, nickname, link string, ttl time.Duration) Message {
+	tmpl := pick(templates, locale)
+
+	return Message{
+		To:      to,
+		Subject: tmpl.subject,
+		Body:    fmt.Sprintf(tmpl.body, nickname, link, ttl),
+	}
+}
+
+// pick returns the template for the locale, falling back to English
+func pick(templates map[string]mailTemplate, locale string) mailTemplate {
+	if tmpl, ok := templates[strings.ToLower(locale)]; ok {
+		return tmpl
+	}
+	return templates["en"]
+}
@@ -0,0 +1,106 @@
+package oauth
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/yourusername/viblog/internal/config"
+	"golang.org/x/oauth2"
+)
+
+// GitHubProvider implements Provider for GitHub OAuth apps
+type GitHubProvider struct {
+	oauth  *oauth2.Config
+	apiURL string
+	client *http.Client
+}
+
+// NewGitHubProvider creates a new GitHubProvider
+func NewGitHubProvider(cfg config.OAuthProviderConfig, client *http.Client) *GitHubProvider {
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"read:user", "user:email"}
+	}
+
+	return &GitHubProvider{
+		oauth: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       scopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  cfg.AuthURL,
+				TokenURL: cfg.TokenURL,
+			},
+		},
+		apiURL: strings.TrimRight(cfg.APIURL, "/"),
+		client: client,
+	}
+}
+
+// Name returns the provider identifier
+func (p *GitHubProvider) Name() string {
+	return ProviderGitHub
+}
+
+// AuthCodeURL returns the GitHub consent URL with an S256 PKCE challenge
+func (p *GitHubProvider) AuthCodeURL(ctx context.Context, state, verifier string) (string, error) {
+	return p.oauth.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)), nil
+}
+
+type githubUser struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+type githubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+// Exchange trades the code for a token and loads the GitHub user and emails
+func (p *GitHubProvider) Exchange(ctx context.Context, code, verifier string) (*Identity, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+
+	token, err := p.oauth.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, err
+	}
+
+	var user githubUser
+	if err := getJSON(ctx, p.client, p.apiURL+"/user", token.AccessToken, &user); err != nil {
+		return nil, err
+	}
+
+	var emails []githubEmail
+	if err := getJSON(ctx, p.client, p.apiURL+"/user/emails", token.AccessToken, &emails); err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Provider:  ProviderGitHub,
+		Subject:   strconv.FormatInt(user.ID, 10),
+		Name:      user.Name,
+		Login:     user.Login,
+		AvatarURL: user.AvatarURL,
+	}
+
+	// Prefer the primary verified address, then any verified one
+	for _, e := range emails {
+		if e.Verified && (e.Primary || !identity.EmailVerified) {
+			identity.Email = e.Email
+			identity.EmailVerified = true
+		}
+	}
+	if identity.Email == "" {
+		identity.Email = user.Email
+	}
+
+	return identity, nil
+}
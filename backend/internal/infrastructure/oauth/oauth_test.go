@@ -0,0 +1,166 @@
+package oauth_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/infrastructure/oauth"
+	"github.com/yourusername/viblog/internal/infrastructure/oauth/oauthtest"
+	"golang.org/x/oauth2"
+)
+
+func TestProviders_AuthorizationCodeFlow(t *testing.T) {
+	server := oauthtest.NewServer(oauthtest.User{
+		Subject:       "4242",
+		Login:         "octocat",
+		Name:          "The Octocat",
+		Email:         "octocat@example.com",
+		EmailVerified: true,
+	})
+	defer server.Close()
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	providers := []oauth.Provider{
+		oauth.NewGitHubProvider(server.GitHubConfig(), client),
+		oauth.NewOIDCProvider(oauth.ProviderGoogle, server.OIDCConfig(), client),
+	}
+
+	for _, provider := range providers {
+		t.Run(provider.Name(), func(t *testing.T) {
+			ctx := context.Background()
+			verifier := oauth2.GenerateVerifier()
+
+			authURL, err := provider.AuthCodeURL(ctx, "state-123", verifier)
+			if err != nil {
+				t.Fatalf("AuthCodeURL failed: %v", err)
+			}
+
+			code, state, err := server.Consent(authURL)
+			if err != nil {
+				t.Fatalf("Consent failed: %v", err)
+			}
+			if state != "state-123" {
+				t.Errorf("expected state to round-trip, got %q", state)
+			}
+
+			identity, err := provider.Exchange(ctx, code, verifier)
+			if err != nil {
+				t.Fatalf("Exchange failed: %v", err)
+			}
+
+			if identity.Provider != provider.Name() {
+				t.Errorf("expected provider %s, got %s", provider.Name(), identity.Provider)
+			}
+			if identity.Subject != "4242" {
+				t.Errorf("expected subject 4242, got %s", identity.Subject)
+			}
+			if identity.Email != "octocat@example.com" || !identity.EmailVerified {
+				t.Errorf("expected verified octocat@example.com, got %s (verified=%v)", identity.Email, identity.EmailVerified)
+			}
+			if identity.Login != "octocat" {
+				t.Errorf("expected login octocat, got %s", identity.Login)
+			}
+		})
+	}
+}
+
+func TestProviders_RejectWrongVerifier(t *testing.T) {
+	server := oauthtest.NewServer(oauthtest.User{Subject: "1", Email: "a@example.com", EmailVerified: true})
+	defer server.Close()
+
+	provider := oauth.NewGitHubProvider(server.GitHubConfig(), http.DefaultClient)
+	ctx := context.Background()
+
+	authURL, err := provider.AuthCodeURL(ctx, "state", oauth2.GenerateVerifier())
+	if err != nil {
+		t.Fatalf("AuthCodeURL failed: %v", err)
+	}
+	code, _, err := server.Consent(authURL)
+	if err != nil {
+		t.Fatalf("Consent failed: %v", err)
+	}
+
+	if _, err := provider.Exchange(ctx, code, oauth2.GenerateVerifier()); err == nil {
+		t.Error("expected exchange with a different verifier to fail")
+	}
+}
+
+func TestMemoryStateStore(t *testing.T) {
+	store := oauth.NewMemoryStateStore(10)
+
+	store.Save("valid", oauth.PendingAuthorization{Provider: "github", Verifier: "v", ExpiresAt: time.Now().Add(time.Minute)})
+	store.Save("expired", oauth.PendingAuthorization{Provider: "github", Verifier: "v", ExpiresAt: time.Now().Add(-time.Second)})
+
+	pending, ok := store.Consume("valid")
+	if !ok || pending.Verifier != "v" {
+		t.Fatal("expected valid state to be consumed")
+	}
+	if _, ok := store.Consume("valid"); ok {
+		t.Error("expected state to be single-use")
+	}
+	if _, ok := store.Consume("expired"); ok {
+		t.Error("expected expired state to be rejected")
+	}
+	if _, ok := store.Consume("unknown"); ok {
+		t.Error("expected unknown state to be rejected")
+	}
+}
+
+func TestMemoryStateStore_EvictsWhenFull(t *testing.T) {
+	store := oauth.NewMemoryStateStore(2)
+	now := time.Now()
+
+	store.Save("first", oauth.PendingAuthorization{Provider: "github", ExpiresAt: now.Add(time.Minute)})
+	store.Save("second", oauth.PendingAuthorization{Provider: "github", ExpiresAt: now.Add(2 * time.Minute)})
+	store.Save("third", oauth.PendingAuthorization{Provider: "github", ExpiresAt: now.Add(3 * time.Minute)})
+
+	if _, ok := store.Consume("first"); ok {
+		t.Error("expected the state closest to expiry to be evicted")
+	}
+	for _, state := range []string{"second", "third"} {
+		if _, ok := store.Consume(state); !ok {
+			t.Errorf("expected %s state to be kept", state)
+		}
+	}
+}
+
+func TestMemoryStateStore_PrefersDroppingExpired(t *testing.T) {
+	store := oauth.NewMemoryStateStore(2)
+	now := time.Now()
+
+	store.Save("expired", oauth.PendingAuthorization{Provider: "github", ExpiresAt: now.Add(-time.Second)})
+	store.Save("valid", oauth.PendingAuthorization{Provider: "github", ExpiresAt: now.Add(time.Minute)})
+	store.Save("new", oauth.PendingAuthorization{Provider: "github", ExpiresAt: now.Add(2 * time.Minute)})
+
+	for _, state := range []string{"valid", "new"} {
+		if _, ok := store.Consume(state); !ok {
+			t.Errorf("expected %s state to be kept", state)
+		}
+	}
+}
+
+func TestMemoryStateStore_RunSweeperStops(t *testing.T) {
+	store := oauth.NewMemoryStateStore(2)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		store.RunSweeper(ctx, time.Millisecond)
+		close(done)
+	}()
+
+	store.Save("valid", oauth.PendingAuthorization{Provider: "github", ExpiresAt: time.Now().Add(time.Minute)})
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the sweeper to stop when its context is cancelled")
+	}
+	if _, ok := store.Consume("valid"); !ok {
+		t.Error("expected the sweeper to keep states that have not expired")
+	}
+}
@@ -0,0 +1,252 @@
+// Package oauthtest provides a local fake OAuth2/OIDC authorization server
+// for exercising providers without reaching GitHub or Google.
+package oauthtest
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/yourusername/viblog/internal/config"
+)
+
+const (
+	ClientID     = "fake-client"
+	ClientSecret = "fake-secret"
+	RedirectURL  = "http://localhost/oauth/callback"
+)
+
+// User is the account the fake server authenticates
+type User struct {
+	Subject       string
+	Login         string
+	Name          string
+	Email         string
+	EmailVerified bool
+}
+
+type grant struct {
+	challenge string
+}
+
+// Server is a fake authorization server speaking both the GitHub API
+// dialect and OpenID Connect discovery/userinfo
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	user   User
+	seq    int
+	grants map[string]grant
+	tokens map[string]bool
+}
+
+// NewServer starts a fake server that authenticates user
+func NewServer(user User) *Server {
+	s := &Server{
+		user:   user,
+		grants: make(map[string]grant),
+		tokens: make(map[string]bool),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", s.discovery)
+	mux.HandleFunc("/authorize", s.authorize)
+	mux.HandleFunc("/token", s.token)
+	mux.HandleFunc("/userinfo", s.userinfo)
+	mux.HandleFunc("/user", s.githubUser)
+	mux.HandleFunc("/user/emails", s.githubEmails)
+
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// SetUser changes the account returned by later requests
+func (s *Server) SetUser(user User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.user = user
+}
+
+// GitHubConfig returns provider settings that point a GitHubProvider at the server
+func (s *Server) GitHubConfig() config.OAuthProviderConfig {
+	return config.OAuthProviderConfig{
+		ClientID:     ClientID,
+		ClientSecret: ClientSecret,
+		RedirectURL:  RedirectURL,
+		AuthURL:      s.URL + "/authorize",
+		TokenURL:     s.URL + "/token",
+		APIURL:       s.URL,
+	}
+}
+
+// OIDCConfig returns provider settings that make an OIDCProvider discover the server
+func (s *Server) OIDCConfig() config.OAuthProviderConfig {
+	return config.OAuthProviderConfig{
+		ClientID:     ClientID,
+		ClientSecret: ClientSecret,
+		RedirectURL:  RedirectURL,
+		IssuerURL:    s.URL,
+	}
+}
+
+// Consent simulates the user approving authCodeURL and returns the code and
+// state that would be delivered to the redirect URL
+func (s *Server) Consent(authCodeURL string) (code, state string, err error) {
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Get(authCodeURL)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusFound {
+		return "", "", fmt.Errorf("unexpected authorize status %d", resp.StatusCode)
+	}
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		return "", "", err
+	}
+	return location.Query().Get("code"), location.Query().Get("state"), nil
+}
+
+func (s *Server) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{
+		"issuer":                 s.URL,
+		"authorization_endpoint": s.URL + "/authorize",
+		"token_endpoint":         s.URL + "/token",
+		"userinfo_endpoint":      s.URL + "/userinfo",
+	})
+}
+
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != ClientID || q.Get("redirect_uri") != RedirectURL {
+		http.Error(w, "invalid client", http.StatusBadRequest)
+		return
+	}
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "PKCE required", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.seq++
+	code := "code-" + strconv.Itoa(s.seq)
+	s.grants[code] = grant{challenge: q.Get("code_challenge")}
+	s.mu.Unlock()
+
+	redirect := RedirectURL + "?code=" + url.QueryEscape(code) + "&state=" + url.QueryEscape(q.Get("state"))
+	http.Redirect(w, r, redirect, http.StatusFound)
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != ClientID || clientSecret != ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	code := r.PostForm.Get("code")
+	g, ok := s.grants[code]
+	delete(s.grants, code)
+	if !ok || challengeOf(r.PostForm.Get("code_verifier")) != g.challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	s.seq++
+	accessToken := "token-" + strconv.Itoa(s.seq)
+	s.tokens[accessToken] = true
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+// authorized checks the bearer token and returns the current user
+func (s *Server) authorized(w http.ResponseWriter, r *http.Request) (User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !s.tokens[token] {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return User{}, false
+	}
+	return s.user, true
+}
+
+func (s *Server) userinfo(w http.ResponseWriter, r *http.Request) {
+	user, ok := s.authorized(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"sub":                user.Subject,
+		"email":              user.Email,
+		"email_verified":     user.EmailVerified,
+		"name":               user.Name,
+		"preferred_username": user.Login,
+	})
+}
+
+func (s *Server) githubUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := s.authorized(w, r)
+	if !ok {
+		return
+	}
+	id, _ := strconv.ParseInt(user.Subject, 10, 64)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":    id,
+		"login": user.Login,
+		"name":  user.Name,
+	})
+}
+
+func (s *Server) githubEmails(w http.ResponseWriter, r *http.Request) {
+	user, ok := s.authorized(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, []map[string]interface{}{
+		{"email": user.Email, "primary": true, "verified": user.EmailVerified},
+	})
+}
+
+func challengeOf(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,157 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/yourusername/viblog/internal/config"
+	"golang.org/x/oauth2"
+)
+
+// OIDCProvider implements Provider for generic OpenID Connect issuers such as Google.
+// Endpoints not set in config are read from the issuer's discovery document.
+type OIDCProvider struct {
+	name   string
+	cfg    config.OAuthProviderConfig
+	client *http.Client
+
+	mu          sync.Mutex
+	oauth       *oauth2.Config
+	userInfoURL string
+}
+
+// NewOIDCProvider creates a new OIDCProvider
+func NewOIDCProvider(name string, cfg config.OAuthProviderConfig, client *http.Client) *OIDCProvider {
+	return &OIDCProvider{
+		name:   name,
+		cfg:    cfg,
+		client: client,
+	}
+}
+
+// Name returns the provider identifier
+func (p *OIDCProvider) Name() string {
+	return p.name
+}
+
+type discoveryDocument struct {
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// config resolves the OAuth2 configuration, running discovery on first use.
+// Failed discovery is retried on the next call.
+func (p *OIDCProvider) config(ctx context.Context) (*oauth2.Config, string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.oauth != nil {
+		return p.oauth, p.userInfoURL, nil
+	}
+
+	authURL, tokenURL, userInfoURL := p.cfg.AuthURL, p.cfg.TokenURL, p.cfg.UserInfoURL
+	if authURL == "" || tokenURL == "" || userInfoURL == "" {
+		var doc discoveryDocument
+		discoveryURL := strings.TrimRight(p.cfg.IssuerURL, "/") + "/.well-known/openid-configuration"
+		if err := getJSON(ctx, p.client, discoveryURL, "", &doc); err != nil {
+			return nil, "", fmt.Errorf("oidc discovery failed: %w", err)
+		}
+		if authURL == "" {
+			authURL = doc.AuthorizationEndpoint
+		}
+		if tokenURL == "" {
+			tokenURL = doc.TokenEndpoint
+		}
+		if userInfoURL == "" {
+			userInfoURL = doc.UserInfoEndpoint
+		}
+	}
+
+	scopes := p.cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	p.oauth = &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  p.cfg.RedirectURL,
+		Scopes:       scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  authURL,
+			TokenURL: tokenURL,
+		},
+	}
+	p.userInfoURL = userInfoURL
+
+	return p.oauth, p.userInfoURL, nil
+}
+
+// AuthCodeURL returns the consent URL with an S256 PKCE challenge
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state, verifier string) (string, error) {
+	cfg, _, err := p.config(ctx)
+	if err != nil {
+		return "", err
+	}
+	return cfg.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)), nil
+}
+
+type oidcUserInfo struct {
+	Subject           string          `json:"sub"`
+	Email             string          `json:"email"`
+	EmailVerified     json.RawMessage `json:"email_verified"`
+	Name              string          `json:"name"`
+	PreferredUsername string          `json:"preferred_username"`
+	Picture           string          `json:"picture"`
+}
+
+// Exchange trades the code for a token and loads the userinfo claims
+func (p *OIDCProvider) Exchange(ctx context.Context, code, verifier string) (*Identity, error) {
+	cfg, userInfoURL, err := p.config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+
+	token, err := cfg.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, err
+	}
+
+	var info oidcUserInfo
+	if err := getJSON(ctx, p.client, userInfoURL, token.AccessToken, &info); err != nil {
+		return nil, err
+	}
+	if info.Subject == "" {
+		return nil, fmt.Errorf("userinfo response has no subject")
+	}
+
+	return &Identity{
+		Provider:      p.name,
+		Subject:       info.Subject,
+		Email:         info.Email,
+		EmailVerified: parseBoolClaim(info.EmailVerified),
+		Name:          info.Name,
+		Login:         info.PreferredUsername,
+		AvatarURL:     info.Picture,
+	}, nil
+}
+
+// parseBoolClaim accepts both JSON booleans and the "true" strings some issuers send
+func parseBoolClaim(raw json.RawMessage) bool {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return strings.EqualFold(s, "true")
+	}
+	return false
+}
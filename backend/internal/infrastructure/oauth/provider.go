@@ -0,0 +1,108 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+)
+
+// Provider names
+const (
+	ProviderGitHub = "github"
+	ProviderGoogle = "google"
+)
+
+// Identity is the account information returned by a provider
+type Identity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Login         string
+	AvatarURL     string
+}
+
+// Provider performs the OAuth2 authorization code flow with PKCE
+type Provider interface {
+	// Name returns the provider identifier used in URLs and the identities table
+	Name() string
+
+	// AuthCodeURL returns the URL the user is sent to for consent
+	AuthCodeURL(ctx context.Context, state, verifier string) (string, error)
+
+	// Exchange trades an authorization code for the user's identity
+	Exchange(ctx context.Context, code, verifier string) (*Identity, error)
+}
+
+// Registry holds the configured providers
+type Registry struct {
+	providers map[string]Provider
+}
+
+// NewRegistry creates a Registry with every provider that has a client ID configured
+func NewRegistry(cfg config.OAuthConfig) *Registry {
+	client := &http.Client{Timeout: 10 * time.Second}
+	r := &Registry{providers: make(map[string]Provider)}
+
+	if cfg.GitHub.Enabled() {
+		r.Register(NewGitHubProvider(cfg.GitHub, client))
+	}
+	if cfg.Google.Enabled() {
+		r.Register(NewOIDCProvider(ProviderGoogle, cfg.Google, client))
+	}
+
+	return r
+}
+
+// Register adds or replaces a provider
+func (r *Registry) Register(p Provider) {
+	r.providers[p.Name()] = p
+}
+
+// Get returns the provider with the given name
+func (r *Registry) Get(name string) (Provider, bool) {
+	p, ok := r.providers[name]
+	return p, ok
+}
+
+// Names returns the names of the configured providers in sorted order
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// getJSON performs an authenticated GET request and decodes the JSON response
+func getJSON(ctx context.Context, client *http.Client, url, accessToken string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("GET %s: unexpected status %d: %s", url, resp.StatusCode, body)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
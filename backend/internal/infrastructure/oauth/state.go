@@ -0,0 +1,117 @@
+package oauth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// PendingAuthorization is the server-side half of an authorization request
+type PendingAuthorization struct {
+	Provider  string
+	Verifier  string
+	ExpiresAt time.Time
+}
+
+// StateStore keeps pending authorizations keyed by their state parameter
+type StateStore interface {
+	// Save stores a pending authorization under state
+	Save(state string, pending PendingAuthorization)
+
+	// Consume removes and returns the pending authorization for state.
+	// Each state can be consumed once and only before it expires.
+	Consume(state string) (PendingAuthorization, bool)
+}
+
+// MemoryStateStore is an in-process StateStore. It holds at most maxPending
+// authorizations, evicting the one closest to expiry when full, and drops
+// expired ones in RunSweeper so abandoned logins do not pile up.
+//
+// States are not shared between processes: with several instances behind a
+// load balancer, the provider callback must reach the instance that started
+// the login, e.g. through sticky sessions, or the login fails.
+type MemoryStateStore struct {
+	mu         sync.Mutex
+	pending    map[string]PendingAuthorization
+	maxPending int
+}
+
+// NewMemoryStateStore creates a new MemoryStateStore
+func NewMemoryStateStore(maxPending int) *MemoryStateStore {
+	return &MemoryStateStore{
+		pending:    make(map[string]PendingAuthorization),
+		maxPending: maxPending,
+	}
+}
+
+// Save stores a pending authorization, making room when the store is full
+func (s *MemoryStateStore) Save(state string, pending PendingAuthorization) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.pending) >= s.maxPending {
+		s.sweep(time.Now())
+	}
+	if len(s.pending) >= s.maxPending {
+		s.evictOldest()
+	}
+
+	s.pending[state] = pending
+}
+
+// Consume removes and returns the pending authorization for state
+func (s *MemoryStateStore) Consume(state string) (PendingAuthorization, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pending, ok := s.pending[state]
+	if !ok {
+		return PendingAuthorization{}, false
+	}
+	delete(s.pending, state)
+
+	if time.Now().After(pending.ExpiresAt) {
+		return PendingAuthorization{}, false
+	}
+	return pending, true
+}
+
+// RunSweeper drops expired authorizations every interval. It returns when
+// ctx is done.
+func (s *MemoryStateStore) RunSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.mu.Lock()
+			s.sweep(now)
+			s.mu.Unlock()
+		}
+	}
+}
+
+// sweep drops expired authorizations. The caller must hold s.mu.
+func (s *MemoryStateStore) sweep(now time.Time) {
+	for key, p := range s.pending {
+		if now.After(p.ExpiresAt) {
+			delete(s.pending, key)
+		}
+	}
+}
+
+// evictOldest drops the authorization closest to expiry. The caller must
+// hold s.mu.
+func (s *MemoryStateStore) evictOldest() {
+	var oldest string
+	var oldestAt time.Time
+	for key, p := range s.pending {
+		if oldest == "" || p.ExpiresAt.Before(oldestAt) {
+			oldest, oldestAt = key, p.ExpiresAt
+		}
+	}
+	delete(s.pending, oldest)
+}
@@ -0,0 +1,345 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// analyticsRepository implements the AnalyticsRepository interface
+type analyticsRepository struct {
+	db *gorm.DB
+}
+
+// NewAnalyticsRepository creates a new analytics repository
+func NewAnalyticsRepository(db *gorm.DB) repository.AnalyticsRepository {
+	return &analyticsRepository{db: db}
+}
+
+// rollupColumns maps the rolled-up metrics to their post_daily_stats column
+var rollupColumns = map[repository.AnalyticsMetric]string{
+	repository.MetricViews:    "views",
+	repository.MetricVisitors: "unique_visitors",
+	repository.MetricLikes:    "likes",
+	repository.MetricComments: "comments",
+}
+
+// breakdownColumns maps the view dimensions to their view_logs column
+var breakdownColumns = map[entity.ViewDimension]string{
+	entity.ViewDimensionReferrer:    "referrer_host",
+	entity.ViewDimensionUTMSource:   "utm_source",
+	entity.ViewDimensionUTMMedium:   "utm_medium",
+	entity.ViewDimensionUTMCampaign: "utm_campaign",
+	entity.ViewDimensionDevice:      "device",
+	entity.ViewDimensionBrowser:     "browser",
+	entity.ViewDimensionOS:          "os",
+	entity.ViewDimensionCountry:     "country",
+}
+
+// DailyCounts counts a metric per day in the given time zone
+func (r *analyticsRepository) DailyCounts(ctx context.Context, metric repository.AnalyticsMetric, from, to time.Time, loc *time.Location) ([]repository.DailyCount, error) {
+	var counts []repository.DailyCount
+
+	if column, ok := rollupColumns[metric]; ok {
+		fromDay, toDay := dayRange(from, to, loc)
+		err := r.db.WithContext(ctx).
+			Model(&entity.PostDailyStat{}).
+			Select("day, SUM("+column+") AS count").
+			Where("day >= ? AND day < ?", fromDay, toDay).
+			Group("day").
+			Having("SUM(" + column + ") > 0").
+			Order("day ASC").
+			Scan(&counts).Error
+		return counts, err
+	}
+
+	var query *gorm.DB
+	switch metric {
+	case repository.MetricBookmarks:
+		query = r.db.WithContext(ctx).Model(&entity.Bookmark{})
+	case repository.MetricSignups:
+		query = r.db.WithContext(ctx).Model(&entity.User{})
+	default:
+		return nil, fmt.Errorf("unknown analytics metric %q", metric)
+	}
+
+	day, arg := localDay(r.db, "created_at", loc, from)
+	err := query.
+		Select(day+" AS day, COUNT(*) AS count", arg).
+		Where("created_at >= ? AND created_at < ?", from, to).
+		Group("day").
+		Order("day ASC").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// TopPosts ranks published posts by views, then likes and comments, over the period
+func (r *analyticsRepository) TopPosts(ctx context.Context, from, to time.Time, loc *time.Location, limit int) ([]repository.PostActivity, error) {
+	fromDay, toDay := dayRange(from, to, loc)
+
+	var posts []repository.PostActivity
+	err := r.db.WithContext(ctx).
+		Table("post_daily_stats").
+		Select("posts.id AS post_id, posts.title, posts.slug, SUM(post_daily_stats.views) AS views, SUM(post_daily_stats.likes) AS likes, SUM(post_daily_stats.comments) AS comments").
+		Joins("JOIN posts ON posts.id = post_daily_stats.post_id").
+		Where("post_daily_stats.day >= ? AND post_daily_stats.day < ?", fromDay, toDay).
+		Where("posts.deleted_at IS NULL AND posts.status = ?", "published").
+		Group("posts.id, posts.title, posts.slug").
+		Order("views DESC, likes DESC, comments DESC, posts.id ASC").
+		Limit(limit).
+		Scan(&posts).Error
+	return posts, err
+}
+
+// TopTags ranks tags by the views of their posts over the period
+func (r *analyticsRepository) TopTags(ctx context.Context, from, to time.Time, loc *time.Location, limit int) ([]repository.TagActivity, error) {
+	fromDay, toDay := dayRange(from, to, loc)
+
+	var tags []repository.TagActivity
+	err := r.db.WithContext(ctx).
+		Table("tags").
+		Select("tags.id AS tag_id, tags.name, tags.slug, SUM(post_daily_stats.views) AS views, COUNT(DISTINCT post_daily_stats.post_id) AS posts").
+		Joins("JOIN post_tags ON post_tags.tag_id = tags.id").
+		Joins("JOIN post_daily_stats ON post_daily_stats.post_id = post_tags.post_id AND post_daily_stats.day >= ? AND post_daily_stats.day < ? AND post_daily_stats.views > 0", fromDay, toDay).
+		Where("tags.deleted_at IS NULL").
+		Group("tags.id, tags.name, tags.slug").
+		Order("views DESC, tags.id ASC").
+		Limit(limit).
+		Scan(&tags).Error
+	return tags, err
+}
+
+// AggregatePostActivity counts each post's activity over the period from the raw tables
+func (r *analyticsRepository) AggregatePostActivity(ctx context.Context, from, to time.Time) ([]entity.PostDailyStat, error) {
+	byPost := make(map[uint]*entity.PostDailyStat)
+	stat := func(postID uint) *entity.PostDailyStat {
+		s, ok := byPost[postID]
+		if !ok {
+			s = &entity.PostDailyStat{PostID: postID}
+			byPost[postID] = s
+		}
+		return s
+	}
+
+	var views []struct {
+		PostID         uint
+		Views          int64
+		UniqueVisitors int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entity.ViewLog{}).
+		Select("post_id, COUNT(*) AS views, COUNT(DISTINCT ip_address) AS unique_visitors").
+		Where("created_at >= ? AND created_at < ?", from, to).
+		Group("post_id").
+		Scan(&views).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range views {
+		s := stat(v.PostID)
+		s.Views = v.Views
+		s.UniqueVisitors = v.UniqueVisitors
+	}
+
+	var likes []struct {
+		PostID uint
+		Count  int64
+	}
+	err = r.db.WithContext(ctx).
+		Model(&entity.Like{}).
+		Select("post_id, COUNT(*) AS count").
+		Where("post_id IS NOT NULL AND created_at >= ? AND created_at < ?", from, to).
+		Group("post_id").
+		Scan(&likes).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range likes {
+		stat(l.PostID).Likes = l.Count
+	}
+
+	var comments []struct {
+		PostID uint
+		Count  int64
+	}
+	err = r.db.WithContext(ctx).
+		Model(&entity.Comment{}).
+		Select("post_id, COUNT(*) AS count").
+		Where("status = ? AND created_at >= ? AND created_at < ?", entity.CommentStatusApproved, from, to).
+		Group("post_id").
+		Scan(&comments).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range comments {
+		stat(c.PostID).Comments = c.Count
+	}
+
+	stats := make([]entity.PostDailyStat, 0, len(byPost))
+	for _, s := range byPost {
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].PostID < stats[j].PostID })
+	return stats, nil
+}
+
+// AggregateViewBreakdown counts each post's views over the period by every dimension
+func (r *analyticsRepository) AggregateViewBreakdown(ctx context.Context, from, to time.Time) ([]entity.PostDailyBreakdown, error) {
+	var breakdown []entity.PostDailyBreakdown
+	for _, dimension := range entity.ViewDimensions {
+		column := breakdownColumns[dimension]
+
+		var rows []struct {
+			PostID uint
+			Value  string
+			Views  int64
+		}
+		err := r.db.WithContext(ctx).
+			Model(&entity.ViewLog{}).
+			Select("post_id, COALESCE("+column+", '') AS value, COUNT(*) AS views").
+			Where("created_at >= ? AND created_at < ?", from, to).
+			Group("post_id, COALESCE(" + column + ", '')").
+			Order("post_id ASC, value ASC").
+			Scan(&rows).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			breakdown = append(breakdown, entity.PostDailyBreakdown{
+				PostID:    row.PostID,
+				Dimension: dimension,
+				Value:     row.Value,
+				Views:     row.Views,
+			})
+		}
+	}
+	return breakdown, nil
+}
+
+// ViewBreakdown ranks the values of a dimension by rolled-up views over the period
+func (r *analyticsRepository) ViewBreakdown(ctx context.Context, dimension entity.ViewDimension, postID *uint, from, to time.Time, loc *time.Location, limit int) ([]repository.ViewCount, int64, error) {
+	fromDay, toDay := dayRange(from, to, loc)
+	query := func() *gorm.DB {
+		q := r.db.WithContext(ctx).
+			Model(&entity.PostDailyBreakdown{}).
+			Where("dimension = ? AND day >= ? AND day < ?", dimension, fromDay, toDay)
+		if postID != nil {
+			q = q.Where("post_id = ?", *postID)
+		}
+		return q
+	}
+
+	var total int64
+	if err := query().Select("COALESCE(SUM(views), 0)").Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var counts []repository.ViewCount
+	err := query().
+		Select("value, SUM(views) AS views").
+		Group("value").
+		Order("views DESC, value ASC").
+		Limit(limit).
+		Scan(&counts).Error
+	return counts, total, err
+}
+
+// FindDailyStats retrieves the rolled-up stats of a day
+func (r *analyticsRepository) FindDailyStats(ctx context.Context, day string) ([]entity.PostDailyStat, error) {
+	var stats []entity.PostDailyStat
+	err := r.db.WithContext(ctx).
+		Where("day = ?", day).
+		Order("post_id ASC").
+		Find(&stats).Error
+	return stats, err
+}
+
+// ReplaceDailyStats replaces the rolled-up stats of a day in one transaction
+func (r *analyticsRepository) ReplaceDailyStats(ctx context.Context, day string, stats []entity.PostDailyStat) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("day = ?", day).Delete(&entity.PostDailyStat{}).Error; err != nil {
+			return err
+		}
+		if len(stats) == 0 {
+			return nil
+		}
+		for i := range stats {
+			stats[i].ID = 0
+			stats[i].Day = day
+		}
+		return tx.Create(&stats).Error
+	})
+}
+
+// ReplaceDailyBreakdown replaces the rolled-up view breakdown of a day in one transaction
+func (r *analyticsRepository) ReplaceDailyBreakdown(ctx context.Context, day string, breakdown []entity.PostDailyBreakdown) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("day = ?", day).Delete(&entity.PostDailyBreakdown{}).Error; err != nil {
+			return err
+		}
+		if len(breakdown) == 0 {
+			return nil
+		}
+		for i := range breakdown {
+			breakdown[i].ID = 0
+			breakdown[i].Day = day
+		}
+		return tx.CreateInBatches(&breakdown, 500).Error
+	})
+}
+
+// LatestRolledUpDay returns the last day with rolled-up stats
+func (r *analyticsRepository) LatestRolledUpDay(ctx context.Context) (string, error) {
+	var day *string
+	err := r.db.WithContext(ctx).
+		Model(&entity.PostDailyStat{}).
+		Select("MAX(day)").
+		Scan(&day).Error
+	if err != nil || day == nil {
+		return "", err
+	}
+	return *day, nil
+}
+
+// EarliestViewAt returns when the oldest view log was recorded
+func (r *analyticsRepository) EarliestViewAt(ctx context.Context) (*time.Time, error) {
+	var view entity.ViewLog
+	result := r.db.WithContext(ctx).
+		Order("created_at ASC").
+		Limit(1).
+		Find(&view)
+	if result.Error != nil || result.RowsAffected == 0 {
+		return nil, result.Error
+	}
+	return &view.CreatedAt, nil
+}
+
+// DeleteViewLogsBefore deletes view logs recorded before the given time
+func (r *analyticsRepository) DeleteViewLogsBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("created_at < ?", before).
+		Delete(&entity.ViewLog{})
+	return result.RowsAffected, result.Error
+}
+
+// dayRange converts a period to the YYYY-MM-DD days it starts on and ends
+// before in loc
+func dayRange(from, to time.Time, loc *time.Location) (string, string) {
+	return from.In(loc).Format("2006-01-02"), to.In(loc).Format("2006-01-02")
+}
+
+// localDay returns an SQL expression formatting a timestamp column as the
+// YYYY-MM-DD day in loc, and its argument. SQLite has no time zone database,
+// so there the offset in effect at the given time is used.
+func localDay(db *gorm.DB, column string, loc *time.Location, at time.Time) (string, interface{}) {
+	if db.Dialector.Name() == "sqlite" {
+		_, offset := at.In(loc).Zone()
+		return "DATE(" + column + ", ?)", fmt.Sprintf("%+d seconds", offset)
+	}
+	return "TO_CHAR(" + column + " AT TIME ZONE ?, 'YYYY-MM-DD')", loc.String()
+}
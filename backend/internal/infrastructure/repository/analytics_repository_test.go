@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+)
+
+func TestAnalyticsRepository_DailyCountsAndRankings(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewAnalyticsRepository(db)
+	ctx := context.Background()
+
+	loc := time.FixedZone("KST", 9*60*60)
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, loc)
+
+	user := &entity.User{Email: "author@example.com", Password: "hashedpassword", Nickname: "author"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	tag := &entity.Tag{Name: "Go", Slug: "go"}
+	if err := db.Create(tag).Error; err != nil {
+		t.Fatalf("Failed to create test tag: %v", err)
+	}
+	popular := &entity.Post{Title: "Popular", Slug: "popular", Content: "Content", Status: "published", AuthorID: user.ID, Tags: []entity.Tag{*tag}}
+	quiet := &entity.Post{Title: "Quiet", Slug: "quiet", Content: "Content", Status: "published", AuthorID: user.ID}
+	draft := &entity.Post{Title: "Draft", Slug: "draft", Content: "Content", Status: "draft", AuthorID: user.ID}
+	for _, post := range []*entity.Post{popular, quiet, draft} {
+		if err := db.Create(post).Error; err != nil {
+			t.Fatalf("Failed to create test post: %v", err)
+		}
+	}
+
+	// 23:30 local on the 10th is still the 10th, though it is the 10th 14:30 UTC;
+	// 08:00 local on the 11th is the 10th 23:00 UTC
+	views := []struct {
+		postID uint
+		at     time.Time
+	}{
+		{popular.ID, day.Add(1 * time.Hour)},
+		{popular.ID, day.Add(23*time.Hour + 30*time.Minute)},
+		{popular.ID, day.Add(32 * time.Hour)},
+		{quiet.ID, day.Add(32 * time.Hour)},
+		{draft.ID, day.Add(2 * time.Hour)},
+	}
+	for _, v := range views {
+		if err := db.Create(&entity.ViewLog{PostID: v.postID, IPAddress: "203.0.113.1", CreatedAt: v.at}).Error; err != nil {
+			t.Fatalf("Failed to create view: %v", err)
+		}
+	}
+	postID := quiet.ID
+	if err := db.Create(&entity.Like{UserID: user.ID, PostID: &postID, CreatedAt: day.Add(time.Hour)}).Error; err != nil {
+		t.Fatalf("Failed to create like: %v", err)
+	}
+
+	// Roll up both local days; rolling up a day again replaces its rows
+	for i := 0; i < 2; i++ {
+		for _, start := range []time.Time{day, day.AddDate(0, 0, 1)} {
+			stats, err := repo.AggregatePostActivity(ctx, start, start.AddDate(0, 0, 1))
+			if err != nil {
+				t.Fatalf("AggregatePostActivity failed: %v", err)
+			}
+			if err := repo.ReplaceDailyStats(ctx, start.Format("2006-01-02"), stats); err != nil {
+				t.Fatalf("ReplaceDailyStats failed: %v", err)
+			}
+		}
+	}
+
+	stats, err := repo.FindDailyStats(ctx, "2026-03-10")
+	if err != nil {
+		t.Fatalf("FindDailyStats failed: %v", err)
+	}
+	if len(stats) != 3 {
+		t.Fatalf("Expected stats of 3 posts on the 10th, got %+v", stats)
+	}
+	if stats[0].PostID != popular.ID || stats[0].Views != 2 || stats[0].UniqueVisitors != 1 {
+		t.Errorf("Expected 2 views by 1 visitor of the popular post, got %+v", stats[0])
+	}
+
+	latest, err := repo.LatestRolledUpDay(ctx)
+	if err != nil {
+		t.Fatalf("LatestRolledUpDay failed: %v", err)
+	}
+	if latest != "2026-03-11" {
+		t.Errorf("Expected 2026-03-11 to be the latest rolled-up day, got %q", latest)
+	}
+
+	from, to := day, day.AddDate(0, 0, 2)
+	counts, err := repo.DailyCounts(ctx, repository.MetricViews, from, to, loc)
+	if err != nil {
+		t.Fatalf("DailyCounts failed: %v", err)
+	}
+	want := []repository.DailyCount{{Day: "2026-03-10", Count: 3}, {Day: "2026-03-11", Count: 2}}
+	if len(counts) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, counts)
+	}
+	for i := range want {
+		if counts[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want[i], counts[i])
+		}
+	}
+
+	if _, err := repo.DailyCounts(ctx, repository.MetricSignups, from, to, loc); err != nil {
+		t.Fatalf("DailyCounts of sign-ups failed: %v", err)
+	}
+
+	posts, err := repo.TopPosts(ctx, from, to, loc, 5)
+	if err != nil {
+		t.Fatalf("TopPosts failed: %v", err)
+	}
+	if len(posts) != 2 {
+		t.Fatalf("Expected the 2 published posts with activity, got %+v", posts)
+	}
+	if posts[0].PostID != popular.ID || posts[0].Views != 3 {
+		t.Errorf("Expected the popular post first with 3 views, got %+v", posts[0])
+	}
+	if posts[1].PostID != quiet.ID || posts[1].Views != 1 || posts[1].Likes != 1 {
+		t.Errorf("Expected the quiet post with 1 view and 1 like, got %+v", posts[1])
+	}
+
+	tags, err := repo.TopTags(ctx, from, to, loc, 5)
+	if err != nil {
+		t.Fatalf("TopTags failed: %v", err)
+	}
+	if len(tags) != 1 || tags[0].TagID != tag.ID || tags[0].Views != 3 || tags[0].Posts != 1 {
+		t.Errorf("Expected the tag with 3 views of 1 post, got %+v", tags)
+	}
+
+	earliest, err := repo.EarliestViewAt(ctx)
+	if err != nil {
+		t.Fatalf("EarliestViewAt failed: %v", err)
+	}
+	if earliest == nil || !earliest.Equal(day.Add(time.Hour)) {
+		t.Errorf("Expected the first view at %v, got %v", day.Add(time.Hour), earliest)
+	}
+
+	deleted, err := repo.DeleteViewLogsBefore(ctx, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("DeleteViewLogsBefore failed: %v", err)
+	}
+	if deleted != 3 {
+		t.Errorf("Expected 3 deleted view logs, got %d", deleted)
+	}
+}
+
+func TestAnalyticsRepository_ViewBreakdown(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewAnalyticsRepository(db)
+	ctx := context.Background()
+
+	loc := time.FixedZone("KST", 9*60*60)
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, loc)
+
+	user := &entity.User{Email: "author@example.com", Password: "hashedpassword", Nickname: "author"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	first := &entity.Post{Title: "First", Slug: "first", Content: "Content", Status: "published", AuthorID: user.ID}
+	second := &entity.Post{Title: "Second", Slug: "second", Content: "Content", Status: "published", AuthorID: user.ID}
+	for _, post := range []*entity.Post{first, second} {
+		if err := db.Create(post).Error; err != nil {
+			t.Fatalf("Failed to create test post: %v", err)
+		}
+	}
+
+	views := []entity.ViewLog{
+		{PostID: first.ID, IPAddress: "203.0.113.1", ReferrerHost: "google.com", Device: "desktop", Country: "KR"},
+		{PostID: first.ID, IPAddress: "203.0.113.2", ReferrerHost: "google.com", Device: "mobile", Country: "KR"},
+		{PostID: first.ID, IPAddress: "203.0.113.3", Device: "mobile", Country: "US", UTMSource: "newsletter"},
+		{PostID: second.ID, IPAddress: "203.0.113.1", ReferrerHost: "news.ycombinator.com", Device: "desktop"},
+	}
+	for i := range views {
+		views[i].CreatedAt = day.Add(time.Duration(i+1) * time.Hour)
+		if err := db.Create(&views[i]).Error; err != nil {
+			t.Fatalf("Failed to create view: %v", err)
+		}
+	}
+
+	breakdown, err := repo.AggregateViewBreakdown(ctx, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("AggregateViewBreakdown failed: %v", err)
+	}
+	// Every view counts once in every dimension
+	for _, dimension := range entity.ViewDimensions {
+		var total int64
+		for _, b := range breakdown {
+			if b.Dimension == dimension {
+				total += b.Views
+			}
+		}
+		if total != int64(len(views)) {
+			t.Errorf("Expected %d views by %s, got %d", len(views), dimension, total)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if err := repo.ReplaceDailyBreakdown(ctx, "2026-03-10", breakdown); err != nil {
+			t.Fatalf("ReplaceDailyBreakdown failed: %v", err)
+		}
+	}
+
+	from, to := day, day.AddDate(0, 0, 1)
+	referrers, total, err := repo.ViewBreakdown(ctx, entity.ViewDimensionReferrer, nil, from, to, loc, 2)
+	if err != nil {
+		t.Fatalf("ViewBreakdown failed: %v", err)
+	}
+	if total != 4 {
+		t.Errorf("Expected 4 views in total, got %d", total)
+	}
+	want := []repository.ViewCount{{Value: "google.com", Views: 2}, {Value: "", Views: 1}}
+	if len(referrers) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, referrers)
+	}
+	for i := range want {
+		if referrers[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want[i], referrers[i])
+		}
+	}
+
+	countries, total, err := repo.ViewBreakdown(ctx, entity.ViewDimensionCountry, &first.ID, from, to, loc, 10)
+	if err != nil {
+		t.Fatalf("ViewBreakdown of a post failed: %v", err)
+	}
+	if total != 3 || len(countries) != 2 || countries[0] != (repository.ViewCount{Value: "KR", Views: 2}) {
+		t.Errorf("Expected 2 views from KR of 3, got %v of %d", countries, total)
+	}
+
+	_, total, err = repo.ViewBreakdown(ctx, entity.ViewDimensionDevice, nil, to, to.AddDate(0, 0, 1), loc, 10)
+	if err != nil {
+		t.Fatalf("ViewBreakdown of an empty period failed: %v", err)
+	}
+	if total != 0 {
+		t.Errorf("Expected no views the next day, got %d", total)
+	}
+}
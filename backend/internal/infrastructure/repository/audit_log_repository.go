@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// auditLogRepository implements the AuditLogRepository interface
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository creates a new audit log repository
+func NewAuditLogRepository(db *gorm.DB) repository.AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+// Create appends an entry
+func (r *auditLogRepository) Create(ctx context.Context, log *entity.AuditLog) error {
+	return r.db.WithContext(ctx).Create(log).Error
+}
+
+// FindAll retrieves entries matching the filter, newest first, with pagination.
+// Deleted actors are still loaded so past actions keep their author.
+func (r *auditLogRepository) FindAll(ctx context.Context, filter repository.AuditLogFilter, page, limit int) ([]entity.AuditLog, int64, error) {
+	var logs []entity.AuditLog
+	var total int64
+
+	offset := (page - 1) * limit
+
+	query := r.db.WithContext(ctx).Model(&entity.AuditLog{})
+	if filter.ActorID != 0 {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.Action != "" {
+		if strings.HasSuffix(filter.Action, ".") {
+			query = query.Where("action LIKE ?", filter.Action+"%")
+		} else {
+			query = query.Where("action = ?", filter.Action)
+		}
+	}
+	if filter.TargetType != "" {
+		query = query.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.TargetID != 0 {
+		query = query.Where("target_id = ?", filter.TargetID)
+	}
+	if filter.RequestID != "" {
+		query = query.Where("request_id = ?", filter.RequestID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get entries with pagination
+	err := query.
+		Preload("Actor", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		}).
+		Order("created_at DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&logs).Error
+
+	return logs, total, err
+}
+
+// DeleteBefore deletes entries created before the given time
+func (r *auditLogRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("created_at < ?", before).
+		Delete(&entity.AuditLog{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+)
+
+func TestAuditLogRepository_FindAllAndDeleteBefore(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewAuditLogRepository(db)
+	ctx := context.Background()
+
+	admin := &entity.User{Email: "admin@example.com", Password: "hashedpassword", Nickname: "admin", Role: entity.RoleAdmin}
+	if err := db.Create(admin).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	now := time.Now()
+	commentID, userID := uint(7), uint(9)
+	logs := []*entity.AuditLog{
+		{ActorID: admin.ID, Action: "comment.delete", TargetType: entity.AuditTargetComment, TargetID: &commentID, Before: `{"id":7}`, CreatedAt: now.Add(-400 * 24 * time.Hour)},
+		{ActorID: admin.ID, Action: "user.suspend", TargetType: entity.AuditTargetUser, TargetID: &userID, RequestID: "req-1", CreatedAt: now.Add(-time.Hour)},
+		{ActorID: admin.ID, Action: "user.role.assign", TargetType: entity.AuditTargetUser, TargetID: &userID, CreatedAt: now},
+	}
+	for _, log := range logs {
+		log.Method, log.Path, log.Status = "POST", "/api/v1/admin", 200
+		if err := repo.Create(ctx, log); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	found, total, err := repo.FindAll(ctx, repository.AuditLogFilter{Action: "user."}, 1, 10)
+	if err != nil {
+		t.Fatalf("FindAll failed: %v", err)
+	}
+	if total != 2 || len(found) != 2 {
+		t.Fatalf("Expected 2 user actions, got %d", total)
+	}
+	if found[0].Action != "user.role.assign" || found[0].Actor == nil || found[0].Actor.ID != admin.ID {
+		t.Errorf("Expected the newest entry first with its actor, got %+v", found[0])
+	}
+
+	_, total, err = repo.FindAll(ctx, repository.AuditLogFilter{TargetType: entity.AuditTargetUser, TargetID: userID, RequestID: "req-1"}, 1, 10)
+	if err != nil {
+		t.Fatalf("FindAll by request failed: %v", err)
+	}
+	if total != 1 {
+		t.Errorf("Expected 1 entry of the request, got %d", total)
+	}
+
+	from := now.Add(-2 * time.Hour)
+	_, total, err = repo.FindAll(ctx, repository.AuditLogFilter{Action: "comment.delete", From: &from}, 1, 10)
+	if err != nil {
+		t.Fatalf("FindAll by period failed: %v", err)
+	}
+	if total != 0 {
+		t.Errorf("Expected no comment deletions in the period, got %d", total)
+	}
+
+	deleted, err := repo.DeleteBefore(ctx, now.Add(-365*24*time.Hour))
+	if err != nil {
+		t.Fatalf("DeleteBefore failed: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("Expected 1 deleted entry, got %d", deleted)
+	}
+}
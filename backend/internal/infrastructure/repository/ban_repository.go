@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// banRepository implements the BanRepository interface
+type banRepository struct {
+	db *gorm.DB
+}
+
+// NewBanRepository creates a new ban repository
+func NewBanRepository(db *gorm.DB) repository.BanRepository {
+	return &banRepository{db: db}
+}
+
+// Create creates a new ban
+func (r *banRepository) Create(ctx context.Context, ban *entity.Ban) error {
+	return r.db.WithContext(ctx).Create(ban).Error
+}
+
+// FindByID finds a ban by ID
+func (r *banRepository) FindByID(ctx context.Context, id uint) (*entity.Ban, error) {
+	var ban entity.Ban
+	err := r.db.WithContext(ctx).First(&ban, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ban, nil
+}
+
+// FindAll retrieves bans matching the filter, newest first, with pagination
+func (r *banRepository) FindAll(ctx context.Context, filter repository.BanFilter, page, limit int) ([]entity.Ban, int64, error) {
+	var bans []entity.Ban
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entity.Ban{})
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.ActiveOnly {
+		query = query.Where("expires_at IS NULL OR expires_at > ?", filter.At)
+	}
+
+	// Get total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated bans
+	offset := (page - 1) * limit
+	err := query.
+		Order("created_at DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&bans).Error
+
+	return bans, total, err
+}
+
+// FindActive retrieves the bans of a type in force at the given time
+func (r *banRepository) FindActive(ctx context.Context, banType entity.BanType, values []string, now time.Time) ([]entity.Ban, error) {
+	var bans []entity.Ban
+	query := r.db.WithContext(ctx).
+		Where("type = ? AND (expires_at IS NULL OR expires_at > ?)", banType, now)
+	if len(values) > 0 {
+		query = query.Where("value IN ?", values)
+	}
+	err := query.Order("id ASC").Find(&bans).Error
+	return bans, err
+}
+
+// RecordHit counts a blocked request against a ban
+func (r *banRepository) RecordHit(ctx context.Context, id uint, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entity.Ban{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"hit_count":   gorm.Expr("hit_count + ?", 1),
+			"last_hit_at": at,
+		}).Error
+}
+
+// Delete deletes a ban
+func (r *banRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&entity.Ban{}, id).Error
+}
+
+// DeleteExpired deletes bans that expired before the given time and returns how many were deleted
+func (r *banRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("expires_at IS NOT NULL AND expires_at <= ?", before).
+		Delete(&entity.Ban{})
+	return result.RowsAffected, result.Error
+}
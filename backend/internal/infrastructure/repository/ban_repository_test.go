@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+)
+
+func TestBanRepository_FindActive(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewBanRepository(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	expired := now.Add(-time.Hour)
+	later := now.Add(time.Hour)
+
+	bans := []entity.Ban{
+		{Type: entity.BanTypeIP, Value: "203.0.113.0/24", Reason: "spam"},
+		{Type: entity.BanTypeIP, Value: "198.51.100.7/32", Reason: "old", ExpiresAt: &expired},
+		{Type: entity.BanTypeEmail, Value: "hash-a", Reason: "abuse", ExpiresAt: &later},
+		{Type: entity.BanTypeUser, Value: "7", Reason: "abuse"},
+	}
+	for i := range bans {
+		if err := repo.Create(ctx, &bans[i]); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	ipBans, err := repo.FindActive(ctx, entity.BanTypeIP, nil, now)
+	if err != nil {
+		t.Fatalf("FindActive failed: %v", err)
+	}
+	if len(ipBans) != 1 || ipBans[0].Value != "203.0.113.0/24" {
+		t.Errorf("Expected only the unexpired IP ban, got %+v", ipBans)
+	}
+
+	emailBans, err := repo.FindActive(ctx, entity.BanTypeEmail, []string{"hash-a", "hash-b"}, now)
+	if err != nil {
+		t.Fatalf("FindActive failed: %v", err)
+	}
+	if len(emailBans) != 1 {
+		t.Errorf("Expected 1 email ban, got %d", len(emailBans))
+	}
+
+	// Temporary bans stop matching once they expire
+	emailBans, err = repo.FindActive(ctx, entity.BanTypeEmail, []string{"hash-a"}, later.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("FindActive failed: %v", err)
+	}
+	if len(emailBans) != 0 {
+		t.Errorf("Expected expired email ban to be ignored, got %+v", emailBans)
+	}
+
+	if err := repo.RecordHit(ctx, bans[3].ID, now); err != nil {
+		t.Fatalf("RecordHit failed: %v", err)
+	}
+	hit, err := repo.FindByID(ctx, bans[3].ID)
+	if err != nil {
+		t.Fatalf("FindByID failed: %v", err)
+	}
+	if hit.HitCount != 1 || hit.LastHitAt == nil {
+		t.Errorf("Expected hit to be recorded, got %+v", hit)
+	}
+
+	active, total, err := repo.FindAll(ctx, repository.BanFilter{ActiveOnly: true, At: now}, 1, 10)
+	if err != nil {
+		t.Fatalf("FindAll failed: %v", err)
+	}
+	if total != 3 || len(active) != 3 {
+		t.Errorf("Expected 3 active bans, got %d (%d listed)", total, len(active))
+	}
+}
+
+func TestBanRepository_DeleteExpired(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewBanRepository(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	expired := now.Add(-time.Minute)
+	later := now.Add(time.Hour)
+
+	for _, ban := range []entity.Ban{
+		{Type: entity.BanTypeIP, Value: "203.0.113.0/24", Reason: "permanent"},
+		{Type: entity.BanTypeIP, Value: "198.51.100.0/24", Reason: "expired", ExpiresAt: &expired},
+		{Type: entity.BanTypeIP, Value: "192.0.2.0/24", Reason: "temporary", ExpiresAt: &later},
+	} {
+		if err := repo.Create(ctx, &ban); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	deleted, err := repo.DeleteExpired(ctx, now)
+	if err != nil {
+		t.Fatalf("DeleteExpired failed: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("Expected 1 deleted ban, got %d", deleted)
+	}
+
+	remaining, total, err := repo.FindAll(ctx, repository.BanFilter{}, 1, 10)
+	if err != nil {
+		t.Fatalf("FindAll failed: %v", err)
+	}
+	if total != 2 {
+		t.Errorf("Expected 2 remaining bans, got %d", total)
+	}
+	for _, ban := range remaining {
+		if ban.Reason == "expired" {
+			t.Errorf("Expected expired ban to be deleted")
+		}
+	}
+}
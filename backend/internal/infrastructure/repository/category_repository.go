@@ -37,10 +37,10 @@ func (r *categoryRepository) FindByID(ctx context.Context, id uint) (*entity.Cat
 	return &category, nil
 }
 
-// FindBySlug finds a category by slug
+// FindBySlug finds a category by slug with its translations
 func (r *categoryRepository) FindBySlug(ctx context.Context, slug string) (*entity.Category, error) {
 	var category entity.Category
-	err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&category).Error
+	err := r.db.WithContext(ctx).Preload("Translations").Where("slug = ?", slug).First(&category).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -50,10 +50,10 @@ func (r *categoryRepository) FindBySlug(ctx context.Context, slug string) (*enti
 	return &category, nil
 }
 
-// FindAll retrieves all categories
+// FindAll retrieves all categories with their translations
 func (r *categoryRepository) FindAll(ctx context.Context) ([]entity.Category, error) {
 	var categories []entity.Category
-	err := r.db.WithContext(ctx).Order("name ASC").Find(&categories).Error
+	err := r.db.WithContext(ctx).Preload("Translations").Order("name ASC").Find(&categories).Error
 	return categories, err
 }
 
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// commentReportRepository implements the CommentReportRepository interface
+type commentReportRepository struct {
+	db *gorm.DB
+}
+
+// NewCommentReportRepository creates a new comment report repository
+func NewCommentReportRepository(db *gorm.DB) repository.CommentReportRepository {
+	return &commentReportRepository{db: db}
+}
+
+// CreateUnlessReported creates a new report unless its reporter already
+// reported the comment. The unique index decides, so concurrent reports by
+// the same reporter create one report.
+func (r *commentReportRepository) CreateUnlessReported(ctx context.Context, report *entity.CommentReport) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "comment_id"}, {Name: "reporter_key"}},
+		DoNothing: true,
+	}).Create(report)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// FindByID finds a report by ID
+func (r *commentReportRepository) FindByID(ctx context.Context, id uint) (*entity.CommentReport, error) {
+	var report entity.CommentReport
+	err := r.db.WithContext(ctx).First(&report, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &report, nil
+}
+
+// FindAll retrieves reports matching the filter, oldest first, with pagination.
+// Deleted comments are still loaded so resolved reports show what was removed.
+func (r *commentReportRepository) FindAll(ctx context.Context, filter repository.CommentReportFilter, page, limit int) ([]entity.CommentReport, int64, error) {
+	var reports []entity.CommentReport
+	var total int64
+
+	offset := (page - 1) * limit
+
+	query := r.db.WithContext(ctx).Model(&entity.CommentReport{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Reason != "" {
+		query = query.Where("reason = ?", filter.Reason)
+	}
+	if filter.CommentID != 0 {
+		query = query.Where("comment_id = ?", filter.CommentID)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get reports with pagination
+	err := query.
+		Preload("Comment", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		}).
+		Preload("Comment.User").
+		Preload("Comment.Post").
+		Preload("Reporter").
+		Order("created_at ASC, id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&reports).Error
+
+	return reports, total, err
+}
+
+// CountOpenByComment counts the open reports of a comment
+func (r *commentReportRepository) CountOpenByComment(ctx context.Context, commentID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entity.CommentReport{}).
+		Where("comment_id = ? AND status = ?", commentID, entity.ReportStatusOpen).
+		Count(&count).Error
+	return count, err
+}
+
+// CountByStatus counts reports per status
+func (r *commentReportRepository) CountByStatus(ctx context.Context) (map[entity.ReportStatus]int64, error) {
+	var rows []struct {
+		Status entity.ReportStatus
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entity.CommentReport{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[entity.ReportStatus]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// ResolveOpenByComment closes every open report of a comment and returns how many were closed
+func (r *commentReportRepository) ResolveOpenByComment(ctx context.Context, commentID uint, status entity.ReportStatus, resolvedBy uint, at time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.CommentReport{}).
+		Where("comment_id = ? AND status = ?", commentID, entity.ReportStatusOpen).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"resolved_at": at,
+			"resolved_by": resolvedBy,
+		})
+	return result.RowsAffected, result.Error
+}
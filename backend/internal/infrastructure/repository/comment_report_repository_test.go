@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+)
+
+func TestCommentReportRepository_Lifecycle(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewCommentReportRepository(db)
+	ctx := context.Background()
+
+	user := &entity.User{Email: "author@example.com", Password: "hashedpassword", Nickname: "author"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	post := &entity.Post{Title: "Post", Slug: "post", Content: "Content", Status: "published", AuthorID: user.ID}
+	if err := db.Create(post).Error; err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+	comment := &entity.Comment{PostID: post.ID, Content: "Rude comment"}
+	if err := db.Create(comment).Error; err != nil {
+		t.Fatalf("Failed to create test comment: %v", err)
+	}
+
+	for _, key := range []string{"user:1", "iphash"} {
+		report := &entity.CommentReport{CommentID: comment.ID, Reason: entity.ReportReasonHarassment, ReporterKey: key, Status: entity.ReportStatusOpen}
+		created, err := repo.CreateUnlessReported(ctx, report)
+		if err != nil {
+			t.Fatalf("CreateUnlessReported failed: %v", err)
+		}
+		if !created {
+			t.Errorf("Expected the report by %s to be created", key)
+		}
+	}
+
+	// The same reporter cannot report a comment twice
+	duplicate := &entity.CommentReport{CommentID: comment.ID, Reason: entity.ReportReasonSpam, ReporterKey: "iphash", Status: entity.ReportStatusOpen}
+	created, err := repo.CreateUnlessReported(ctx, duplicate)
+	if err != nil {
+		t.Fatalf("CreateUnlessReported failed: %v", err)
+	}
+	if created {
+		t.Error("Expected a duplicate report to be skipped")
+	}
+
+	open, err := repo.CountOpenByComment(ctx, comment.ID)
+	if err != nil {
+		t.Fatalf("CountOpenByComment failed: %v", err)
+	}
+	if open != 2 {
+		t.Errorf("Expected 2 open reports, got %d", open)
+	}
+
+	// Deleting the comment closes every open report, and the inbox still shows it
+	if err := db.Delete(comment).Error; err != nil {
+		t.Fatalf("Failed to delete comment: %v", err)
+	}
+	closed, err := repo.ResolveOpenByComment(ctx, comment.ID, entity.ReportStatusResolved, user.ID, time.Now())
+	if err != nil {
+		t.Fatalf("ResolveOpenByComment failed: %v", err)
+	}
+	if closed != 2 {
+		t.Errorf("Expected 2 closed reports, got %d", closed)
+	}
+
+	reports, total, err := repo.FindAll(ctx, repository.CommentReportFilter{Status: entity.ReportStatusResolved}, 1, 10)
+	if err != nil {
+		t.Fatalf("FindAll failed: %v", err)
+	}
+	if total != 2 {
+		t.Errorf("Expected 2 resolved reports, got %d", total)
+	}
+	if len(reports) > 0 && (reports[0].Comment == nil || reports[0].ResolvedBy == nil) {
+		t.Errorf("Expected the deleted comment and resolver to be loaded, got %+v", reports[0])
+	}
+
+	counts, err := repo.CountByStatus(ctx)
+	if err != nil {
+		t.Fatalf("CountByStatus failed: %v", err)
+	}
+	if counts[entity.ReportStatusOpen] != 0 || counts[entity.ReportStatusResolved] != 2 {
+		t.Errorf("Unexpected status counts: %v", counts)
+	}
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/domain/repository"
@@ -40,42 +42,136 @@ func (r *commentRepository) FindByID(ctx context.Context, id uint) (*entity.Comm
 	return &comment, nil
 }
 
-// FindByPostID retrieves all comments for a specific post
-func (r *commentRepository) FindByPostID(ctx context.Context, postID uint) ([]entity.Comment, error) {
+// FindByIDs finds the comments with the given IDs, with their posts, authors and mentions
+func (r *commentRepository) FindByIDs(ctx context.Context, ids []uint) ([]entity.Comment, error) {
 	var comments []entity.Comment
+	if len(ids) == 0 {
+		return comments, nil
+	}
 	err := r.db.WithContext(ctx).
-		Where("post_id = ?", postID).
+		Where("id IN ?", ids).
+		Preload("Post").
 		Preload("User").
-		Order("created_at ASC").
+		Preload("Mentions").
+		Order("id ASC").
 		Find(&comments).Error
 	return comments, err
 }
 
-// FindReplies retrieves all replies to a specific comment
+// FindByPostID retrieves the approved top-level comments of a post, pinned first
+func (r *commentRepository) FindByPostID(ctx context.Context, postID uint, sort repository.CommentSort) ([]entity.Comment, error) {
+	var comments []entity.Comment
+
+	query := r.db.WithContext(ctx).
+		Where("post_id = ? AND parent_id IS NULL AND status = ?", postID, entity.CommentStatusApproved).
+		Preload("User").
+		Order("CASE WHEN pinned_at IS NULL THEN 1 ELSE 0 END")
+
+	switch sort {
+	case repository.CommentSortNewest:
+		query = query.Order("created_at DESC, id DESC")
+	case repository.CommentSortTop:
+		query = query.Order("like_count DESC, created_at ASC, id ASC")
+	default:
+		query = query.Order("created_at ASC, id ASC")
+	}
+
+	err := query.Find(&comments).Error
+	return comments, err
+}
+
+// FindReplies retrieves the approved replies to a specific comment
 func (r *commentRepository) FindReplies(ctx context.Context, parentID uint) ([]entity.Comment, error) {
 	var comments []entity.Comment
 	err := r.db.WithContext(ctx).
-		Where("parent_id = ?", parentID).
+		Where("parent_id = ? AND status = ?", parentID, entity.CommentStatusApproved).
 		Preload("User").
 		Order("created_at ASC").
 		Find(&comments).Error
 	return comments, err
 }
 
-// FindAll retrieves all comments with pagination
-func (r *commentRepository) FindAll(ctx context.Context, page, limit int) ([]entity.Comment, int64, error) {
+// CountReplies counts the approved replies to each of the given comments
+func (r *commentRepository) CountReplies(ctx context.Context, parentIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64)
+	if len(parentIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		ParentID uint
+		Count    int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entity.Comment{}).
+		Select("parent_id, COUNT(*) AS count").
+		Where("parent_id IN ? AND status = ?", parentIDs, entity.CommentStatusApproved).
+		Group("parent_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.ParentID] = row.Count
+	}
+	return counts, nil
+}
+
+// FindPublicByUserID retrieves a user's approved comments on published posts, newest first, with pagination
+func (r *commentRepository) FindPublicByUserID(ctx context.Context, userID uint, page, limit int) ([]entity.Comment, int64, error) {
 	var comments []entity.Comment
 	var total int64
 
 	offset := (page - 1) * limit
 
+	query := r.db.WithContext(ctx).
+		Model(&entity.Comment{}).
+		Joins("JOIN posts ON posts.id = comments.post_id AND posts.deleted_at IS NULL").
+		Where("comments.user_id = ? AND comments.status = ? AND posts.status = ?", userID, entity.CommentStatusApproved, "published")
+
 	// Count total
-	if err := r.db.WithContext(ctx).Model(&entity.Comment{}).Count(&total).Error; err != nil {
+	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
 	// Get comments with pagination
-	err := r.db.WithContext(ctx).
+	err := query.
+		Preload("Post").
+		Order("comments.created_at DESC, comments.id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&comments).Error
+
+	return comments, total, err
+}
+
+// FindAll retrieves comments matching the filter with pagination
+func (r *commentRepository) FindAll(ctx context.Context, filter repository.CommentFilter, page, limit int) ([]entity.Comment, int64, error) {
+	var comments []entity.Comment
+	var total int64
+
+	offset := (page - 1) * limit
+
+	query := r.db.WithContext(ctx).Model(&entity.Comment{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.PostID != 0 {
+		query = query.Where("post_id = ?", filter.PostID)
+	}
+	if filter.Search != "" {
+		pattern := "%" + strings.ToLower(filter.Search) + "%"
+		query = query.Where("LOWER(content) LIKE ? OR LOWER(author_name) LIKE ?", pattern, pattern)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get comments with pagination
+	err := query.
 		Preload("User").
 		Preload("Post").
 		Order("created_at DESC").
@@ -86,11 +182,114 @@ func (r *commentRepository) FindAll(ctx context.Context, page, limit int) ([]ent
 	return comments, total, err
 }
 
+// CountRecentByContent counts comments with exactly this content created since the given time
+func (r *commentRepository) CountRecentByContent(ctx context.Context, content string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entity.Comment{}).
+		Where("content = ? AND created_at >= ?", content, since).
+		Count(&count).Error
+	return count, err
+}
+
+// CountByStatus counts comments per moderation status
+func (r *commentRepository) CountByStatus(ctx context.Context) (map[entity.CommentStatus]int64, error) {
+	var rows []struct {
+		Status entity.CommentStatus
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entity.Comment{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[entity.CommentStatus]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
 // Update updates a comment
 func (r *commentRepository) Update(ctx context.Context, comment *entity.Comment) error {
 	return r.db.WithContext(ctx).Save(comment).Error
 }
 
+// UpdateModeration saves only the comment's status, moderated_at and moderated_by
+func (r *commentRepository) UpdateModeration(ctx context.Context, comment *entity.Comment) error {
+	return r.db.WithContext(ctx).
+		Model(comment).
+		Select("status", "moderated_at", "moderated_by").
+		Updates(comment).Error
+}
+
+// UpdateContent saves an edit of the comment's content, status and spam
+// score, replaces its mentions and records the revision
+func (r *commentRepository) UpdateContent(ctx context.Context, comment *entity.Comment, revision *entity.CommentRevision) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(comment).
+			Select("content", "content_html", "is_edited", "status", "spam_score", "spam_reasons").
+			Updates(comment).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("comment_id = ?", comment.ID).Delete(&entity.CommentMention{}).Error; err != nil {
+			return err
+		}
+		for i := range comment.Mentions {
+			comment.Mentions[i].ID = 0
+			comment.Mentions[i].CommentID = comment.ID
+		}
+		if len(comment.Mentions) > 0 {
+			if err := tx.Create(&comment.Mentions).Error; err != nil {
+				return err
+			}
+		}
+
+		revision.CommentID = comment.ID
+		return tx.Create(revision).Error
+	})
+}
+
+// FindRevisions retrieves the revisions of a comment, oldest first, with their editors
+func (r *commentRepository) FindRevisions(ctx context.Context, commentID uint) ([]entity.CommentRevision, error) {
+	var revisions []entity.CommentRevision
+	err := r.db.WithContext(ctx).
+		Where("comment_id = ?", commentID).
+		Preload("Editor").
+		Order("created_at ASC, id ASC").
+		Find(&revisions).Error
+	return revisions, err
+}
+
+// Pin pins a comment to the top of its post, unpinning any other comment of the post
+func (r *commentRepository) Pin(ctx context.Context, comment *entity.Comment, at time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Comment{}).
+			Where("post_id = ? AND id <> ? AND pinned_at IS NOT NULL", comment.PostID, comment.ID).
+			UpdateColumn("pinned_at", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(comment).UpdateColumn("pinned_at", at).Error; err != nil {
+			return err
+		}
+		comment.PinnedAt = &at
+		return nil
+	})
+}
+
+// Unpin unpins a comment
+func (r *commentRepository) Unpin(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).
+		Model(&entity.Comment{}).
+		Where("id = ?", id).
+		UpdateColumn("pinned_at", nil).Error
+}
+
 // Delete deletes a comment (soft delete)
 func (r *commentRepository) Delete(ctx context.Context, id uint) error {
 	return r.db.WithContext(ctx).Delete(&entity.Comment{}, id).Error
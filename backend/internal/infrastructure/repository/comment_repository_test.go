@@ -0,0 +1,421 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/usecase/user"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+func TestCommentRepository_FindPublicByUserID(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewCommentRepository(db)
+	ctx := context.Background()
+
+	user := &entity.User{Email: "commenter@example.com", Password: "hashedpassword", Nickname: "commenter"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	now := time.Now()
+	published := &entity.Post{Title: "Published", Slug: "published", Content: "Content", Status: "published", PublishedAt: &now, AuthorID: user.ID}
+	draft := &entity.Post{Title: "Draft", Slug: "draft", Content: "Content", Status: "draft", AuthorID: user.ID}
+	for _, p := range []*entity.Post{published, draft} {
+		if err := db.Create(p).Error; err != nil {
+			t.Fatalf("Failed to create test post: %v", err)
+		}
+	}
+
+	for i, postID := range []uint{published.ID, published.ID, published.ID, draft.ID} {
+		comment := &entity.Comment{
+			PostID:    postID,
+			UserID:    &user.ID,
+			Content:   "Comment",
+			CreatedAt: now.Add(time.Duration(i) * time.Minute),
+		}
+		if err := db.Create(comment).Error; err != nil {
+			t.Fatalf("Failed to create test comment: %v", err)
+		}
+	}
+
+	comments, total, err := repo.FindPublicByUserID(ctx, user.ID, 1, 2)
+	if err != nil {
+		t.Fatalf("FindPublicByUserID failed: %v", err)
+	}
+	if total != 3 {
+		t.Errorf("Expected 3 comments on published posts, got %d", total)
+	}
+	if len(comments) != 2 {
+		t.Fatalf("Expected a page of 2 comments, got %d", len(comments))
+	}
+	if !comments[0].CreatedAt.After(comments[1].CreatedAt) {
+		t.Error("Expected newest comments first")
+	}
+	if comments[0].Post == nil || comments[0].Post.Slug != "published" {
+		t.Errorf("Expected post to be preloaded, got %+v", comments[0].Post)
+	}
+}
+
+func TestCommentRepository_PublicProfileOfHiddenAccounts(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	getProfile := user.NewGetPublicProfileUseCase(NewUserRepository(db), NewCommentRepository(db))
+
+	now := time.Now()
+	accounts := []*entity.User{
+		{Email: "active@example.com", Password: "hashedpassword", Nickname: "active"},
+		{Email: "banned@example.com", Password: "hashedpassword", Nickname: "banned", BannedAt: &now},
+		{Email: "leaving@example.com", Password: "hashedpassword", Nickname: "leaving", DeletionScheduledAt: &now},
+	}
+	for _, u := range accounts {
+		if err := db.Create(u).Error; err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+	}
+
+	if profile, err := getProfile.Execute(ctx, user.GetPublicProfileInput{Nickname: "active"}); err != nil || profile.User.Nickname != "active" {
+		t.Fatalf("Expected active user's profile, got %+v, %v", profile, err)
+	}
+	for _, nickname := range []string{"banned", "leaving", "missing"} {
+		if _, err := getProfile.Execute(ctx, user.GetPublicProfileInput{Nickname: nickname}); err != errors.ErrUserNotFound {
+			t.Errorf("Expected %s to have no profile, got %v", nickname, err)
+		}
+	}
+}
+
+func TestCommentRepository_ModerationQueue(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewCommentRepository(db)
+	ctx := context.Background()
+
+	user := &entity.User{Email: "author@example.com", Password: "hashedpassword", Nickname: "author"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	post := &entity.Post{Title: "Post", Slug: "post", Content: "Content", Status: "published", AuthorID: user.ID}
+	if err := db.Create(post).Error; err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+
+	statuses := []entity.CommentStatus{
+		entity.CommentStatusApproved,
+		entity.CommentStatusPending,
+		entity.CommentStatusPending,
+		entity.CommentStatusSpam,
+	}
+	comments := make([]*entity.Comment, len(statuses))
+	for i, status := range statuses {
+		comments[i] = &entity.Comment{PostID: post.ID, UserID: &user.ID, Content: "Comment", Status: status}
+		if err := db.Create(comments[i]).Error; err != nil {
+			t.Fatalf("Failed to create test comment: %v", err)
+		}
+	}
+
+	public, err := repo.FindByPostID(ctx, post.ID, repository.CommentSortOldest)
+	if err != nil {
+		t.Fatalf("FindByPostID failed: %v", err)
+	}
+	if len(public) != 1 || public[0].ID != comments[0].ID {
+		t.Errorf("Expected only the approved comment to be public, got %d comments", len(public))
+	}
+
+	pending, total, err := repo.FindAll(ctx, repository.CommentFilter{Status: entity.CommentStatusPending}, 1, 20)
+	if err != nil {
+		t.Fatalf("FindAll failed: %v", err)
+	}
+	if total != 2 || len(pending) != 2 {
+		t.Errorf("Expected 2 pending comments, got %d", total)
+	}
+
+	counts, err := repo.CountByStatus(ctx)
+	if err != nil {
+		t.Fatalf("CountByStatus failed: %v", err)
+	}
+	if counts[entity.CommentStatusPending] != 2 || counts[entity.CommentStatusApproved] != 1 || counts[entity.CommentStatusSpam] != 1 {
+		t.Errorf("Unexpected counts: %v", counts)
+	}
+
+	now := time.Now()
+	comment := comments[1]
+	comment.Status = entity.CommentStatusApproved
+	comment.ModeratedAt = &now
+	comment.ModeratedBy = &user.ID
+	comment.Content = "Not saved"
+	if err := repo.UpdateModeration(ctx, comment); err != nil {
+		t.Fatalf("UpdateModeration failed: %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, comment.ID)
+	if err != nil {
+		t.Fatalf("FindByID failed: %v", err)
+	}
+	if found.Status != entity.CommentStatusApproved || found.ModeratedBy == nil || *found.ModeratedBy != user.ID {
+		t.Errorf("Expected the comment to be approved by %d, got %+v", user.ID, found)
+	}
+	if found.Content != "Comment" {
+		t.Errorf("Expected only moderation fields to be saved, content is %q", found.Content)
+	}
+}
+
+func TestCommentRepository_CountRecentByContent(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewCommentRepository(db)
+	ctx := context.Background()
+
+	user := &entity.User{Email: "author@example.com", Password: "hashedpassword", Nickname: "author"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	post := &entity.Post{Title: "Post", Slug: "post", Content: "Content", Status: "published", AuthorID: user.ID}
+	if err := db.Create(post).Error; err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+
+	now := time.Now()
+	for _, c := range []struct {
+		content string
+		age     time.Duration
+	}{
+		{"Buy cheap watches", time.Hour},
+		{"Buy cheap watches", 48 * time.Hour},
+		{"Great post", time.Hour},
+	} {
+		comment := &entity.Comment{PostID: post.ID, Content: c.content, CreatedAt: now.Add(-c.age)}
+		if err := db.Create(comment).Error; err != nil {
+			t.Fatalf("Failed to create test comment: %v", err)
+		}
+	}
+
+	count, err := repo.CountRecentByContent(ctx, "Buy cheap watches", now.Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("CountRecentByContent failed: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 recent duplicate, got %d", count)
+	}
+}
+
+func TestCommentRepository_Mentions(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewCommentRepository(db)
+	ctx := context.Background()
+
+	author := &entity.User{Email: "author@example.com", Password: "hashedpassword", Nickname: "author"}
+	mentioned := &entity.User{Email: "friend@example.com", Password: "hashedpassword", Nickname: "friend"}
+	for _, u := range []*entity.User{author, mentioned} {
+		if err := db.Create(u).Error; err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+	}
+	post := &entity.Post{Title: "Post", Slug: "post", Content: "Content", Status: "published", AuthorID: author.ID}
+	if err := db.Create(post).Error; err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+
+	comment := &entity.Comment{
+		PostID:   post.ID,
+		UserID:   &author.ID,
+		Content:  "Thanks @friend",
+		Mentions: []entity.CommentMention{{UserID: mentioned.ID}},
+	}
+	if err := repo.Create(ctx, comment); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	found, err := repo.FindByIDs(ctx, []uint{comment.ID})
+	if err != nil {
+		t.Fatalf("FindByIDs failed: %v", err)
+	}
+	if len(found) != 1 || len(found[0].Mentions) != 1 || found[0].Mentions[0].UserID != mentioned.ID {
+		t.Fatalf("Expected the mention to be saved with the comment, got %+v", found)
+	}
+	if found[0].User == nil || found[0].User.ID != author.ID {
+		t.Errorf("Expected the author to be loaded, got %+v", found[0].User)
+	}
+}
+
+func TestCommentRepository_SortPinAndReplies(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewCommentRepository(db)
+	ctx := context.Background()
+
+	user := &entity.User{Email: "author@example.com", Password: "hashedpassword", Nickname: "author"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	post := &entity.Post{Title: "Post", Slug: "post", Content: "Content", Status: "published", AuthorID: user.ID}
+	if err := db.Create(post).Error; err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+
+	now := time.Now()
+	likes := []int{1, 5, 3}
+	comments := make([]*entity.Comment, len(likes))
+	for i, likeCount := range likes {
+		comments[i] = &entity.Comment{
+			PostID:    post.ID,
+			UserID:    &user.ID,
+			Content:   "Comment",
+			Status:    entity.CommentStatusApproved,
+			LikeCount: likeCount,
+			CreatedAt: now.Add(time.Duration(i) * time.Minute),
+		}
+		if err := db.Create(comments[i]).Error; err != nil {
+			t.Fatalf("Failed to create test comment: %v", err)
+		}
+	}
+	for _, status := range []entity.CommentStatus{entity.CommentStatusApproved, entity.CommentStatusApproved, entity.CommentStatusPending} {
+		reply := &entity.Comment{PostID: post.ID, ParentID: &comments[0].ID, UserID: &user.ID, Content: "Reply", Status: status}
+		if err := db.Create(reply).Error; err != nil {
+			t.Fatalf("Failed to create test reply: %v", err)
+		}
+	}
+
+	ids := func(sort repository.CommentSort) []uint {
+		t.Helper()
+		found, err := repo.FindByPostID(ctx, post.ID, sort)
+		if err != nil {
+			t.Fatalf("FindByPostID failed: %v", err)
+		}
+		result := make([]uint, len(found))
+		for i := range found {
+			result[i] = found[i].ID
+		}
+		return result
+	}
+	expectOrder := func(sort repository.CommentSort, expected ...*entity.Comment) {
+		t.Helper()
+		got := ids(sort)
+		if len(got) != len(expected) {
+			t.Fatalf("%s: expected %d top-level comments, got %v", sort, len(expected), got)
+		}
+		for i := range expected {
+			if got[i] != expected[i].ID {
+				t.Errorf("%s: expected order %d at %d, got %v", sort, expected[i].ID, i, got)
+			}
+		}
+	}
+
+	expectOrder(repository.CommentSortOldest, comments[0], comments[1], comments[2])
+	expectOrder(repository.CommentSortNewest, comments[2], comments[1], comments[0])
+	expectOrder(repository.CommentSortTop, comments[1], comments[2], comments[0])
+
+	// Pinning moves a comment first and replaces the previous pin
+	if err := repo.Pin(ctx, comments[1], now); err != nil {
+		t.Fatalf("Pin failed: %v", err)
+	}
+	if err := repo.Pin(ctx, comments[2], now); err != nil {
+		t.Fatalf("Pin failed: %v", err)
+	}
+	expectOrder(repository.CommentSortOldest, comments[2], comments[0], comments[1])
+
+	var pinned int64
+	db.Model(&entity.Comment{}).Where("post_id = ? AND pinned_at IS NOT NULL", post.ID).Count(&pinned)
+	if pinned != 1 {
+		t.Errorf("Expected one pinned comment per post, got %d", pinned)
+	}
+
+	if err := repo.Unpin(ctx, comments[2].ID); err != nil {
+		t.Fatalf("Unpin failed: %v", err)
+	}
+	expectOrder(repository.CommentSortOldest, comments[0], comments[1], comments[2])
+
+	counts, err := repo.CountReplies(ctx, []uint{comments[0].ID, comments[1].ID})
+	if err != nil {
+		t.Fatalf("CountReplies failed: %v", err)
+	}
+	if counts[comments[0].ID] != 2 || counts[comments[1].ID] != 0 {
+		t.Errorf("Expected 2 approved replies to the first comment only, got %v", counts)
+	}
+}
+
+func TestCommentRepository_UpdateContent(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewCommentRepository(db)
+	ctx := context.Background()
+
+	author := &entity.User{Email: "author@example.com", Password: "hashedpassword", Nickname: "author"}
+	alice := &entity.User{Email: "alice@example.com", Password: "hashedpassword", Nickname: "alice"}
+	bob := &entity.User{Email: "bob@example.com", Password: "hashedpassword", Nickname: "bob"}
+	for _, u := range []*entity.User{author, alice, bob} {
+		if err := db.Create(u).Error; err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+	}
+	post := &entity.Post{Title: "Post", Slug: "post", Content: "Content", Status: "published", AuthorID: author.ID}
+	if err := db.Create(post).Error; err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+
+	comment := &entity.Comment{
+		PostID:   post.ID,
+		UserID:   &author.ID,
+		Content:  "hi @alice",
+		Status:   entity.CommentStatusApproved,
+		Mentions: []entity.CommentMention{{UserID: alice.ID}},
+	}
+	if err := repo.Create(ctx, comment); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	edits := []struct {
+		content string
+		editor  *uint
+		ipHash  string
+		status  entity.CommentStatus
+	}{
+		{"hi @bob", &author.ID, "", entity.CommentStatusApproved},
+		{"hello @bob", nil, "203.0.113.0", entity.CommentStatusPending},
+	}
+	for _, edit := range edits {
+		revision := &entity.CommentRevision{
+			PreviousContent: comment.Content,
+			Content:         edit.content,
+			EditorID:        edit.editor,
+			EditorIPHash:    edit.ipHash,
+		}
+		comment.Content = edit.content
+		comment.ContentHTML = "<p>" + edit.content + "</p>"
+		comment.IsEdited = true
+		comment.Status = edit.status
+		comment.Mentions = []entity.CommentMention{{UserID: bob.ID}}
+		if err := repo.UpdateContent(ctx, comment, revision); err != nil {
+			t.Fatalf("UpdateContent failed: %v", err)
+		}
+	}
+
+	found, err := repo.FindByIDs(ctx, []uint{comment.ID})
+	if err != nil || len(found) != 1 {
+		t.Fatalf("FindByIDs failed: %v", err)
+	}
+	if found[0].Content != "hello @bob" || !found[0].IsEdited {
+		t.Errorf("Expected the edited content to be saved, got %q", found[0].Content)
+	}
+	if found[0].Status != entity.CommentStatusPending {
+		t.Errorf("Expected the held edit to leave the comment pending, got %q", found[0].Status)
+	}
+	if len(found[0].Mentions) != 1 || found[0].Mentions[0].UserID != bob.ID {
+		t.Errorf("Expected the mentions to be replaced by bob, got %+v", found[0].Mentions)
+	}
+
+	revisions, err := repo.FindRevisions(ctx, comment.ID)
+	if err != nil {
+		t.Fatalf("FindRevisions failed: %v", err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("Expected 2 revisions, got %d", len(revisions))
+	}
+	if revisions[0].PreviousContent != "hi @alice" || revisions[0].Content != "hi @bob" {
+		t.Errorf("Unexpected first revision: %+v", revisions[0])
+	}
+	if revisions[0].Editor == nil || revisions[0].Editor.ID != author.ID {
+		t.Errorf("Expected the editor to be preloaded, got %+v", revisions[0].Editor)
+	}
+	if !revisions[1].IsAnonymous() || revisions[1].EditorIPHash != "203.0.113.0" {
+		t.Errorf("Expected an anonymous second revision, got %+v", revisions[1])
+	}
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// commentSubscriptionRepository implements the CommentSubscriptionRepository interface
+type commentSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+// NewCommentSubscriptionRepository creates a new comment subscription repository
+func NewCommentSubscriptionRepository(db *gorm.DB) repository.CommentSubscriptionRepository {
+	return &commentSubscriptionRepository{db: db}
+}
+
+// Create creates a new subscription
+func (r *commentSubscriptionRepository) Create(ctx context.Context, subscription *entity.CommentSubscription) error {
+	return r.db.WithContext(ctx).Create(subscription).Error
+}
+
+// Update saves a subscription
+func (r *commentSubscriptionRepository) Update(ctx context.Context, subscription *entity.CommentSubscription) error {
+	return r.db.WithContext(ctx).Save(subscription).Error
+}
+
+// FindByCommentAndEmail finds the subscription of an address to a comment
+func (r *commentSubscriptionRepository) FindByCommentAndEmail(ctx context.Context, commentID uint, email string) (*entity.CommentSubscription, error) {
+	return r.findOne(r.db.WithContext(ctx).Where("comment_id = ? AND email = ?", commentID, email))
+}
+
+// FindByConfirmTokenHash finds an unconfirmed subscription by the hash of its confirmation token
+func (r *commentSubscriptionRepository) FindByConfirmTokenHash(ctx context.Context, tokenHash string) (*entity.CommentSubscription, error) {
+	return r.findOne(r.db.WithContext(ctx).Where("confirm_token_hash = ? AND confirmed_at IS NULL", tokenHash))
+}
+
+// FindByUnsubscribeToken finds a subscription by its unsubscribe token
+func (r *commentSubscriptionRepository) FindByUnsubscribeToken(ctx context.Context, token string) (*entity.CommentSubscription, error) {
+	return r.findOne(r.db.WithContext(ctx).Where("unsubscribe_token = ?", token))
+}
+
+// FindConfirmedByComment retrieves the confirmed subscriptions to a comment
+func (r *commentSubscriptionRepository) FindConfirmedByComment(ctx context.Context, commentID uint) ([]entity.CommentSubscription, error) {
+	var subscriptions []entity.CommentSubscription
+	err := r.db.WithContext(ctx).
+		Where("comment_id = ? AND confirmed_at IS NOT NULL", commentID).
+		Order("id ASC").
+		Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+// Delete deletes a subscription
+func (r *commentSubscriptionRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&entity.CommentSubscription{}, id).Error
+}
+
+// DeleteUnconfirmedBefore deletes subscriptions whose confirmation expired before the given time
+func (r *commentSubscriptionRepository) DeleteUnconfirmedBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("confirmed_at IS NULL AND confirm_expires_at < ?", before).
+		Delete(&entity.CommentSubscription{})
+	return result.RowsAffected, result.Error
+}
+
+// findOne returns the first subscription matching the query, or nil
+func (r *commentSubscriptionRepository) findOne(query *gorm.DB) (*entity.CommentSubscription, error) {
+	var subscription entity.CommentSubscription
+	err := query.First(&subscription).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &subscription, nil
+}
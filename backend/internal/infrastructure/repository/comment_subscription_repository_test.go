@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+func TestCommentSubscriptionRepository_Lifecycle(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewCommentSubscriptionRepository(db)
+	ctx := context.Background()
+
+	user := &entity.User{Email: "author@example.com", Password: "hashedpassword", Nickname: "author"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	post := &entity.Post{Title: "Post", Slug: "post", Content: "Content", Status: "published", AuthorID: user.ID}
+	if err := db.Create(post).Error; err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+	comment := &entity.Comment{PostID: post.ID, Content: "Anonymous comment"}
+	if err := db.Create(comment).Error; err != nil {
+		t.Fatalf("Failed to create test comment: %v", err)
+	}
+
+	now := time.Now()
+	confirmHash := "confirm-hash"
+	pending := &entity.CommentSubscription{
+		CommentID:        comment.ID,
+		Email:            "reader@example.com",
+		ConfirmTokenHash: &confirmHash,
+		ConfirmExpiresAt: now.Add(time.Hour),
+		UnsubscribeToken: "unsubscribe-token",
+	}
+	if err := repo.Create(ctx, pending); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	expiredHash := "expired-hash"
+	expired := &entity.CommentSubscription{
+		CommentID:        comment.ID,
+		Email:            "stale@example.com",
+		ConfirmTokenHash: &expiredHash,
+		ConfirmExpiresAt: now.Add(-time.Hour),
+		UnsubscribeToken: "stale-token",
+	}
+	if err := repo.Create(ctx, expired); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	// An address subscribes to a comment once
+	duplicate := &entity.CommentSubscription{CommentID: comment.ID, Email: "reader@example.com", UnsubscribeToken: "other-token"}
+	if err := repo.Create(ctx, duplicate); err == nil {
+		t.Error("Expected a duplicate subscription to be rejected")
+	}
+
+	// Unconfirmed subscriptions get no emails
+	confirmed, err := repo.FindConfirmedByComment(ctx, comment.ID)
+	if err != nil {
+		t.Fatalf("FindConfirmedByComment failed: %v", err)
+	}
+	if len(confirmed) != 0 {
+		t.Errorf("Expected no confirmed subscriptions, got %d", len(confirmed))
+	}
+
+	found, err := repo.FindByConfirmTokenHash(ctx, confirmHash)
+	if err != nil {
+		t.Fatalf("FindByConfirmTokenHash failed: %v", err)
+	}
+	if found == nil || found.ID != pending.ID {
+		t.Fatalf("Expected to find the pending subscription, got %+v", found)
+	}
+	found.ConfirmedAt = &now
+	found.ConfirmTokenHash = nil
+	if err := repo.Update(ctx, found); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	// A used confirmation token is not found again
+	found, err = repo.FindByConfirmTokenHash(ctx, confirmHash)
+	if err != nil {
+		t.Fatalf("FindByConfirmTokenHash failed: %v", err)
+	}
+	if found != nil {
+		t.Error("Expected the confirmation token to be consumed")
+	}
+
+	confirmed, err = repo.FindConfirmedByComment(ctx, comment.ID)
+	if err != nil {
+		t.Fatalf("FindConfirmedByComment failed: %v", err)
+	}
+	if len(confirmed) != 1 || confirmed[0].Email != "reader@example.com" {
+		t.Errorf("Expected the confirmed subscription, got %+v", confirmed)
+	}
+
+	// Only the expired unconfirmed subscription is pruned
+	pruned, err := repo.DeleteUnconfirmedBefore(ctx, now)
+	if err != nil {
+		t.Fatalf("DeleteUnconfirmedBefore failed: %v", err)
+	}
+	if pruned != 1 {
+		t.Errorf("Expected 1 pruned subscription, got %d", pruned)
+	}
+
+	found, err = repo.FindByUnsubscribeToken(ctx, "unsubscribe-token")
+	if err != nil {
+		t.Fatalf("FindByUnsubscribeToken failed: %v", err)
+	}
+	if found == nil {
+		t.Fatal("Expected to find the subscription by its unsubscribe token")
+	}
+	if err := repo.Delete(ctx, found.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	found, err = repo.FindByCommentAndEmail(ctx, comment.ID, "reader@example.com")
+	if err != nil {
+		t.Fatalf("FindByCommentAndEmail failed: %v", err)
+	}
+	if found != nil {
+		t.Error("Expected the subscription to be deleted")
+	}
+}
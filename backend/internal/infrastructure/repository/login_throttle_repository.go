@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// loginThrottleRepository implements the LoginThrottleRepository interface
+type loginThrottleRepository struct {
+	db *gorm.DB
+}
+
+// NewLoginThrottleRepository creates a new login throttle repository
+func NewLoginThrottleRepository(db *gorm.DB) repository.LoginThrottleRepository {
+	return &loginThrottleRepository{db: db}
+}
+
+// Find finds the throttle for a scope and identifier
+func (r *loginThrottleRepository) Find(ctx context.Context, scope entity.LoginThrottleScope, identifier string) (*entity.LoginThrottle, error) {
+	var throttle entity.LoginThrottle
+	err := r.db.WithContext(ctx).
+		Where("scope = ? AND identifier = ?", scope, identifier).
+		First(&throttle).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &throttle, nil
+}
+
+// FindByID finds a throttle by ID
+func (r *loginThrottleRepository) FindByID(ctx context.Context, id uint) (*entity.LoginThrottle, error) {
+	var throttle entity.LoginThrottle
+	err := r.db.WithContext(ctx).First(&throttle, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &throttle, nil
+}
+
+// RecordFailure atomically counts a failure and returns the updated throttle
+func (r *loginThrottleRepository) RecordFailure(ctx context.Context, scope entity.LoginThrottleScope, identifier string, now, windowStart time.Time) (*entity.LoginThrottle, error) {
+	throttle := entity.LoginThrottle{
+		Scope:         scope,
+		Identifier:    identifier,
+		Failures:      1,
+		LastFailureAt: now,
+	}
+
+	// Upsert so concurrent failures are all counted
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "scope"}, {Name: "identifier"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"failures":        gorm.Expr("CASE WHEN login_throttles.last_failure_at < ? THEN 1 ELSE login_throttles.failures + 1 END", windowStart),
+			"last_failure_at": now,
+			"updated_at":      now,
+		}),
+	}).Create(&throttle).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Find(ctx, scope, identifier)
+}
+
+// Lock blocks logins for a throttle until the given time
+func (r *loginThrottleRepository) Lock(ctx context.Context, id uint, until time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entity.LoginThrottle{}).
+		Where("id = ?", id).
+		Update("locked_until", until).Error
+}
+
+// Reset forgets the failures of a scope and identifier
+func (r *loginThrottleRepository) Reset(ctx context.Context, scope entity.LoginThrottleScope, identifier string) error {
+	return r.db.WithContext(ctx).
+		Where("scope = ? AND identifier = ?", scope, identifier).
+		Delete(&entity.LoginThrottle{}).Error
+}
+
+// Delete deletes a throttle by ID
+func (r *loginThrottleRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&entity.LoginThrottle{}, id).Error
+}
+
+// FindAll retrieves throttles with pagination, most recent failure first
+func (r *loginThrottleRepository) FindAll(ctx context.Context, lockedOnly bool, now time.Time, page, limit int) ([]entity.LoginThrottle, int64, error) {
+	var throttles []entity.LoginThrottle
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entity.LoginThrottle{})
+	if lockedOnly {
+		query = query.Where("locked_until > ?", now)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err := query.
+		Order("last_failure_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&throttles).Error
+
+	return throttles, total, err
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+func TestLoginThrottleRepository_RecordFailure(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewLoginThrottleRepository(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	window := now.Add(-time.Hour)
+
+	for i := 1; i <= 3; i++ {
+		throttle, err := repo.RecordFailure(ctx, entity.LoginThrottleScopeAccount, "user@example.com", now, window)
+		if err != nil {
+			t.Fatalf("RecordFailure failed: %v", err)
+		}
+		if throttle.Failures != i {
+			t.Errorf("Expected %d failures, got %d", i, throttle.Failures)
+		}
+	}
+
+	// The same identifier in another scope is counted separately
+	ipThrottle, err := repo.RecordFailure(ctx, entity.LoginThrottleScopeIP, "user@example.com", now, window)
+	if err != nil {
+		t.Fatalf("RecordFailure failed: %v", err)
+	}
+	if ipThrottle.Failures != 1 {
+		t.Errorf("Expected 1 IP failure, got %d", ipThrottle.Failures)
+	}
+
+	// Failures outside the window are discarded
+	later := now.Add(2 * time.Hour)
+	throttle, err := repo.RecordFailure(ctx, entity.LoginThrottleScopeAccount, "user@example.com", later, later.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("RecordFailure failed: %v", err)
+	}
+	if throttle.Failures != 1 {
+		t.Errorf("Expected window to restart at 1 failure, got %d", throttle.Failures)
+	}
+
+	if err := repo.Reset(ctx, entity.LoginThrottleScopeAccount, "user@example.com"); err != nil {
+		t.Fatalf("Reset failed: %v", err)
+	}
+	reset, err := repo.Find(ctx, entity.LoginThrottleScopeAccount, "user@example.com")
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	if reset != nil {
+		t.Errorf("Expected throttle to be reset, got %+v", reset)
+	}
+}
+
+func TestLoginThrottleRepository_FindAllLocked(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewLoginThrottleRepository(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	locked, _ := repo.RecordFailure(ctx, entity.LoginThrottleScopeIP, "203.0.113.7", now, now.Add(-time.Hour))
+	expired, _ := repo.RecordFailure(ctx, entity.LoginThrottleScopeIP, "203.0.113.8", now, now.Add(-time.Hour))
+	if _, err := repo.RecordFailure(ctx, entity.LoginThrottleScopeIP, "203.0.113.9", now, now.Add(-time.Hour)); err != nil {
+		t.Fatalf("RecordFailure failed: %v", err)
+	}
+
+	if err := repo.Lock(ctx, locked.ID, now.Add(time.Minute)); err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+	if err := repo.Lock(ctx, expired.ID, now.Add(-time.Minute)); err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+
+	throttles, total, err := repo.FindAll(ctx, true, now, 1, 10)
+	if err != nil {
+		t.Fatalf("FindAll failed: %v", err)
+	}
+	if total != 1 || len(throttles) != 1 || throttles[0].ID != locked.ID {
+		t.Errorf("Expected only the locked throttle, got %d (%+v)", total, throttles)
+	}
+
+	_, total, err = repo.FindAll(ctx, false, now, 1, 10)
+	if err != nil {
+		t.Fatalf("FindAll failed: %v", err)
+	}
+	if total != 3 {
+		t.Errorf("Expected 3 throttles, got %d", total)
+	}
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// notificationRepository implements the NotificationRepository interface
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository creates a new notification repository
+func NewNotificationRepository(db *gorm.DB) repository.NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+// Create creates a new notification
+func (r *notificationRepository) Create(ctx context.Context, notification *entity.Notification) error {
+	return r.db.WithContext(ctx).Create(notification).Error
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// personalAccessTokenRepository implements the PersonalAccessTokenRepository interface
+type personalAccessTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewPersonalAccessTokenRepository creates a new personal access token repository
+func NewPersonalAccessTokenRepository(db *gorm.DB) repository.PersonalAccessTokenRepository {
+	return &personalAccessTokenRepository{db: db}
+}
+
+// Create stores a new token
+func (r *personalAccessTokenRepository) Create(ctx context.Context, token *entity.PersonalAccessToken) error {
+	return r.db.WithContext(ctx).Create(token).Error
+}
+
+// FindByPrefix finds a token by its public prefix
+func (r *personalAccessTokenRepository) FindByPrefix(ctx context.Context, prefix string) (*entity.PersonalAccessToken, error) {
+	var token entity.PersonalAccessToken
+	err := r.db.WithContext(ctx).Where("prefix = ?", prefix).First(&token).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// FindByUserID lists a user's tokens, newest first
+func (r *personalAccessTokenRepository) FindByUserID(ctx context.Context, userID uint) ([]entity.PersonalAccessToken, error) {
+	var tokens []entity.PersonalAccessToken
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Find(&tokens).Error
+	return tokens, err
+}
+
+// CountByUserID counts a user's tokens
+func (r *personalAccessTokenRepository) CountByUserID(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entity.PersonalAccessToken{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+// Delete deletes a user's token
+func (r *personalAccessTokenRepository) Delete(ctx context.Context, id, userID uint) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		Delete(&entity.PersonalAccessToken{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// DeleteByUserID deletes every token of a user
+func (r *personalAccessTokenRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&entity.PersonalAccessToken{}).Error
+}
+
+// UpdateLastUsed records when and from where a token was last used
+func (r *personalAccessTokenRepository) UpdateLastUsed(ctx context.Context, id uint, at time.Time, ip string) error {
+	return r.db.WithContext(ctx).
+		Model(&entity.PersonalAccessToken{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"last_used_at": at, "last_used_ip": ip}).Error
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/infrastructure/mail"
+	"github.com/yourusername/viblog/internal/usecase/user"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/utils"
+	"go.uber.org/zap"
+)
+
+func TestPersonalAccessTokenRepository_Lifecycle(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPersonalAccessTokenRepository(db)
+	ctx := context.Background()
+
+	owner := &entity.User{Email: "owner@example.com", Password: "hashedpassword", Nickname: "owner"}
+	other := &entity.User{Email: "other@example.com", Password: "hashedpassword", Nickname: "other"}
+	for _, u := range []*entity.User{owner, other} {
+		if err := db.Create(u).Error; err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+	}
+
+	token := &entity.PersonalAccessToken{UserID: owner.ID, Name: "ci", Prefix: "abcd2345", SecretHash: "hash"}
+	token.SetScopes([]entity.Permission{entity.PermissionPostsWrite})
+	if err := repo.Create(ctx, token); err != nil {
+		t.Fatalf("Failed to create token: %v", err)
+	}
+
+	found, err := repo.FindByPrefix(ctx, "abcd2345")
+	if err != nil {
+		t.Fatalf("FindByPrefix failed: %v", err)
+	}
+	if found == nil || found.ID != token.ID {
+		t.Fatalf("Expected token %d, got %+v", token.ID, found)
+	}
+	if scopes := found.ScopeList(); len(scopes) != 1 || scopes[0] != entity.PermissionPostsWrite {
+		t.Errorf("Expected posts:write scope, got %v", scopes)
+	}
+
+	usedAt := time.Now().Truncate(time.Second)
+	if err := repo.UpdateLastUsed(ctx, token.ID, usedAt, "203.0.113.7"); err != nil {
+		t.Fatalf("UpdateLastUsed failed: %v", err)
+	}
+	found, _ = repo.FindByPrefix(ctx, "abcd2345")
+	if found.LastUsedAt == nil || !found.LastUsedAt.Equal(usedAt) || found.LastUsedIP == nil || *found.LastUsedIP != "203.0.113.7" {
+		t.Errorf("Expected last use to be recorded, got %v %v", found.LastUsedAt, found.LastUsedIP)
+	}
+
+	// Another user cannot delete the token
+	deleted, err := repo.Delete(ctx, token.ID, other.ID)
+	if err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if deleted {
+		t.Error("Expected delete by another user to affect nothing")
+	}
+
+	deleted, err = repo.Delete(ctx, token.ID, owner.ID)
+	if err != nil || !deleted {
+		t.Fatalf("Expected owner to delete token, got %v, %v", deleted, err)
+	}
+	if count, _ := repo.CountByUserID(ctx, owner.ID); count != 0 {
+		t.Errorf("Expected no tokens left, got %d", count)
+	}
+}
+
+func TestPersonalAccessTokenRepository_RevokedByPasswordReset(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	userRepo := NewUserRepository(db)
+	tokenRepo := NewUserTokenRepository(db)
+	patRepo := NewPersonalAccessTokenRepository(db)
+	account := config.AccountConfig{FrontendURL: "https://example.com", PasswordResetTTL: time.Hour}
+	queue := mail.NewQueue(NewQueuedEmailRepository(db), nil, config.MailConfig{})
+
+	createPAT := user.NewCreatePersonalAccessTokenUseCase(userRepo, patRepo)
+	authenticate := user.NewAuthenticatePersonalAccessTokenUseCase(userRepo, patRepo)
+	resetPassword := user.NewResetPasswordUseCase(userRepo, tokenRepo, patRepo)
+	forceReset := user.NewForcePasswordResetUseCase(userRepo, tokenRepo, patRepo, queue, account, zap.NewNop())
+
+	owner := &entity.User{Email: "owner@example.com", Password: "hashedpassword", Nickname: "owner", Role: entity.RoleEditor}
+	if err := db.Create(owner).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	issue := func() string {
+		t.Helper()
+		out, err := createPAT.Execute(ctx, user.CreatePersonalAccessTokenInput{UserID: owner.ID, Name: "ci", Scopes: []string{string(entity.PermissionPostsWrite)}})
+		if err != nil {
+			t.Fatalf("Failed to create token: %v", err)
+		}
+		if _, _, err := authenticate.Execute(ctx, out.Token, "203.0.113.7"); err != nil {
+			t.Fatalf("Expected new token to authenticate, got %v", err)
+		}
+		return out.Token
+	}
+
+	tests := []struct {
+		name  string
+		reset func() error
+	}{
+		{
+			name: "self-service reset",
+			reset: func() error {
+				raw := "reset-token"
+				if err := tokenRepo.Create(ctx, &entity.UserToken{UserID: owner.ID, Purpose: entity.UserTokenPurposePasswordReset, TokenHash: utils.HashToken(raw), ExpiresAt: time.Now().Add(time.Hour)}); err != nil {
+					return err
+				}
+				return resetPassword.Execute(ctx, user.ResetPasswordInput{Token: raw, NewPassword: "NewPassw0rd!"})
+			},
+		},
+		{
+			name: "forced reset",
+			reset: func() error {
+				return forceReset.Execute(ctx, user.ForcePasswordResetInput{UserID: owner.ID})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := issue()
+			if err := tt.reset(); err != nil {
+				t.Fatalf("Reset failed: %v", err)
+			}
+			if _, _, err := authenticate.Execute(ctx, raw, "203.0.113.7"); err != errors.ErrInvalidToken {
+				t.Errorf("Expected token to be rejected after the reset, got %v", err)
+			}
+			if count, _ := patRepo.CountByUserID(ctx, owner.ID); count != 0 {
+				t.Errorf("Expected no tokens left, got %d", count)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/yourusername/viblog/internal/domain/entity"
@@ -32,6 +33,9 @@ func (r *postRepository) GetByID(ctx context.Context, id uint) (*entity.Post, er
 		Preload("Author").
 		Preload("Category").
 		Preload("Tags").
+		Preload("Translations").
+		Preload("Category.Translations").
+		Preload("Tags.Translations").
 		First(&post, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -49,6 +53,9 @@ func (r *postRepository) GetBySlug(ctx context.Context, slug string) (*entity.Po
 		Preload("Author").
 		Preload("Category").
 		Preload("Tags").
+		Preload("Translations").
+		Preload("Category.Translations").
+		Preload("Tags.Translations").
 		Where("slug = ?", slug).
 		First(&post).Error
 	if err != nil {
@@ -87,6 +94,9 @@ func (r *postRepository) List(ctx context.Context, page, limit int) ([]*entity.P
 		Preload("Author").
 		Preload("Category").
 		Preload("Tags").
+		Preload("Translations").
+		Preload("Category.Translations").
+		Preload("Tags.Translations").
 		Order("created_at DESC").
 		Offset(offset).
 		Limit(limit).
@@ -116,6 +126,9 @@ func (r *postRepository) ListPublished(ctx context.Context, page, limit int) ([]
 		Preload("Author").
 		Preload("Category").
 		Preload("Tags").
+		Preload("Translations").
+		Preload("Category.Translations").
+		Preload("Tags.Translations").
 		Order("published_at DESC").
 		Offset(offset).
 		Limit(limit).
@@ -147,6 +160,9 @@ func (r *postRepository) ListByCategory(ctx context.Context, categorySlug string
 		Preload("Author").
 		Preload("Category").
 		Preload("Tags").
+		Preload("Translations").
+		Preload("Category.Translations").
+		Preload("Tags.Translations").
 		Order("posts.published_at DESC").
 		Offset(offset).
 		Limit(limit).
@@ -179,6 +195,9 @@ func (r *postRepository) ListByTag(ctx context.Context, tagSlug string, page, li
 		Preload("Author").
 		Preload("Category").
 		Preload("Tags").
+		Preload("Translations").
+		Preload("Category.Translations").
+		Preload("Tags.Translations").
 		Order("posts.published_at DESC").
 		Offset(offset).
 		Limit(limit).
@@ -209,6 +228,9 @@ func (r *postRepository) ListByAuthor(ctx context.Context, authorID uint, page,
 		Preload("Author").
 		Preload("Category").
 		Preload("Tags").
+		Preload("Translations").
+		Preload("Category.Translations").
+		Preload("Tags.Translations").
 		Order("published_at DESC").
 		Offset(offset).
 		Limit(limit).
@@ -240,6 +262,9 @@ func (r *postRepository) Search(ctx context.Context, query string, page, limit i
 		Preload("Author").
 		Preload("Category").
 		Preload("Tags").
+		Preload("Translations").
+		Preload("Category.Translations").
+		Preload("Tags.Translations").
 		Order("published_at DESC").
 		Offset(offset).
 		Limit(limit).
@@ -270,13 +295,8 @@ func (r *postRepository) HasViewedRecently(ctx context.Context, postID uint, ipA
 }
 
 // RecordView records a view log entry
-func (r *postRepository) RecordView(ctx context.Context, postID uint, ipAddress, userAgent string) error {
-	viewLog := &entity.ViewLog{
-		PostID:    postID,
-		IPAddress: ipAddress,
-		UserAgent: userAgent,
-	}
-	return r.db.WithContext(ctx).Create(viewLog).Error
+func (r *postRepository) RecordView(ctx context.Context, view *entity.ViewLog) error {
+	return r.db.WithContext(ctx).Create(view).Error
 }
 
 // AddLike adds a like to a post
@@ -385,6 +405,9 @@ func (r *postRepository) ListBookmarkedByUser(ctx context.Context, userID uint,
 		Preload("Author").
 		Preload("Category").
 		Preload("Tags").
+		Preload("Translations").
+		Preload("Category.Translations").
+		Preload("Tags.Translations").
 		Order("bookmarks.created_at DESC").
 		Offset(offset).
 		Limit(limit).
@@ -439,3 +462,107 @@ func (r *postRepository) GetPublishedCount(ctx context.Context) (int64, error) {
 		Count(&count).Error
 	return count, err
 }
+
+// postSortColumns maps each admin post sort to its column
+var postSortColumns = map[repository.PostSort]string{
+	repository.PostSortCreated:   "created_at",
+	repository.PostSortUpdated:   "updated_at",
+	repository.PostSortPublished: "published_at",
+	repository.PostSortViews:     "view_count",
+	repository.PostSortLikes:     "like_count",
+	repository.PostSortComments:  "comment_count",
+}
+
+// likeEscaper escapes the LIKE wildcards of user input, for patterns with ESCAPE '\'
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLike makes s match itself literally in a LIKE pattern
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// FindAll retrieves posts of any status matching the filter with pagination
+func (r *postRepository) FindAll(ctx context.Context, filter repository.PostFilter, page, limit int) ([]*entity.Post, int64, error) {
+	var posts []*entity.Post
+	var total int64
+
+	offset := (page - 1) * limit
+
+	db := r.db.WithContext(ctx)
+	query := db.Model(&entity.Post{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.CategoryID != 0 {
+		query = query.Where("category_id = ?", filter.CategoryID)
+	}
+	if filter.TagID != 0 {
+		query = query.Where("id IN (?)", db.Table("post_tags").Select("post_id").Where("tag_id = ?", filter.TagID))
+	}
+	if filter.AuthorID != 0 {
+		query = query.Where("author_id = ?", filter.AuthorID)
+	}
+	if filter.Search != "" {
+		query = query.Where(`LOWER(title) LIKE ? ESCAPE '\'`, "%"+escapeLike(strings.ToLower(filter.Search))+"%")
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	column, ok := postSortColumns[filter.Sort]
+	if !ok {
+		column = "created_at"
+	}
+	direction := "DESC"
+	if filter.Ascending {
+		direction = "ASC"
+	}
+	if filter.Sort == repository.PostSortPublished {
+		// Databases disagree on where NULLs sort, so put unpublished posts last explicitly
+		query = query.Order("CASE WHEN published_at IS NULL THEN 1 ELSE 0 END")
+	}
+
+	// Get posts with pagination
+	err := query.
+		Preload("Author").
+		Preload("Category").
+		Preload("Tags").
+		Preload("Translations").
+		Order(column + " " + direction).
+		Order("id " + direction).
+		Offset(offset).
+		Limit(limit).
+		Find(&posts).Error
+
+	return posts, total, err
+}
+
+// CountByStatus counts posts per publication status
+func (r *postRepository) CountByStatus(ctx context.Context) (map[entity.PostStatus]int64, error) {
+	var rows []struct {
+		Status entity.PostStatus
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entity.Post{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[entity.PostStatus]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
@@ -2,48 +2,25 @@ package repository
 
 import (
 	"context"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/infrastructure/database"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
-// setupTestDB creates an in-memory SQLite database for testing
+// setupTestDB creates an in-memory SQLite database with the production schema
 func setupTestDB(t *testing.T) *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	if err != nil {
 		t.Fatalf("Failed to open test database: %v", err)
 	}
 
-	// Auto-migrate all entities - migrate them separately to avoid index conflicts
-	err = db.AutoMigrate(&entity.User{})
-	if err != nil {
-		t.Fatalf("Failed to migrate User: %v", err)
-	}
-	err = db.AutoMigrate(&entity.Category{})
-	if err != nil {
-		t.Fatalf("Failed to migrate Category: %v", err)
-	}
-	err = db.AutoMigrate(&entity.Tag{})
-	if err != nil {
-		t.Fatalf("Failed to migrate Tag: %v", err)
-	}
-	err = db.AutoMigrate(&entity.Post{})
-	if err != nil {
-		t.Fatalf("Failed to migrate Post: %v", err)
-	}
-	err = db.AutoMigrate(&entity.ViewLog{})
-	if err != nil {
-		t.Fatalf("Failed to migrate ViewLog: %v", err)
-	}
-	err = db.AutoMigrate(&entity.Like{})
-	if err != nil {
-		t.Fatalf("Failed to migrate Like: %v", err)
-	}
-	err = db.AutoMigrate(&entity.Bookmark{})
-	if err != nil {
+	if err := database.RunMigrations(db); err != nil {
 		t.Fatalf("Failed to migrate test database: %v", err)
 	}
 
@@ -227,6 +204,109 @@ func TestPostRepository_ListPublished(t *testing.T) {
 	}
 }
 
+func TestPostRepository_FindAll(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostRepository(db)
+	ctx := context.Background()
+
+	// Create test users, a category and a tag
+	author := &entity.User{Email: "author@example.com", Password: "hashedpassword", Nickname: "author"}
+	other := &entity.User{Email: "other@example.com", Password: "hashedpassword", Nickname: "other"}
+	db.Create(author)
+	db.Create(other)
+	category := &entity.Category{Name: "Go", Slug: "go"}
+	db.Create(category)
+	tag := &entity.Tag{Name: "Testing", Slug: "testing"}
+	db.Create(tag)
+
+	now := time.Now()
+	earlier := now.Add(-48 * time.Hour)
+	later := now.Add(24 * time.Hour)
+	posts := []*entity.Post{
+		{Title: "Go Generics", Slug: "go-generics", Content: "Content", Status: "published", PublishedAt: &earlier, AuthorID: author.ID, CategoryID: &category.ID, Tags: []entity.Tag{*tag}, ViewCount: 50},
+		{Title: "Draft About Go", Slug: "draft-go", Content: "Content", Status: "draft", AuthorID: author.ID, ViewCount: 5},
+		{Title: "Upcoming Release", Slug: "upcoming", Content: "Content", Status: "scheduled", PublishedAt: &later, AuthorID: other.ID, CategoryID: &category.ID, ViewCount: 0},
+		{Title: "Testing Tips", Slug: "testing-tips", Content: "Content", Status: "published", PublishedAt: &now, AuthorID: other.ID, Tags: []entity.Tag{*tag}, ViewCount: 120},
+	}
+	for i, p := range posts {
+		if err := db.Create(p).Error; err != nil {
+			t.Fatalf("Failed to create post: %v", err)
+		}
+		// Create the posts a day apart, the first one oldest
+		createdAt := now.Add(time.Duration(i-len(posts)) * 24 * time.Hour)
+		db.Model(p).UpdateColumn("created_at", createdAt)
+	}
+
+	slugs := func(posts []*entity.Post) []string {
+		result := make([]string, len(posts))
+		for i, p := range posts {
+			result[i] = p.Slug
+		}
+		return result
+	}
+	from := now.Add(-3*24*time.Hour - time.Minute)
+
+	tests := []struct {
+		name   string
+		filter repository.PostFilter
+		want   []string
+	}{
+		{"all, newest first", repository.PostFilter{}, []string{"testing-tips", "upcoming", "draft-go", "go-generics"}},
+		{"drafts", repository.PostFilter{Status: entity.PostStatusDraft}, []string{"draft-go"}},
+		{"scheduled", repository.PostFilter{Status: entity.PostStatusScheduled}, []string{"upcoming"}},
+		{"category", repository.PostFilter{CategoryID: category.ID}, []string{"upcoming", "go-generics"}},
+		{"tag", repository.PostFilter{TagID: tag.ID}, []string{"testing-tips", "go-generics"}},
+		{"author", repository.PostFilter{AuthorID: other.ID}, []string{"testing-tips", "upcoming"}},
+		{"title search", repository.PostFilter{Search: "GO"}, []string{"draft-go", "go-generics"}},
+		{"title search wildcards match literally", repository.PostFilter{Search: "%_"}, []string{}},
+		{"created range", repository.PostFilter{From: &from, To: &now}, []string{"testing-tips", "upcoming", "draft-go"}},
+		{"most viewed", repository.PostFilter{Sort: repository.PostSortViews}, []string{"testing-tips", "go-generics", "draft-go", "upcoming"}},
+		{"earliest published first, unpublished last", repository.PostFilter{Sort: repository.PostSortPublished, Ascending: true}, []string{"go-generics", "testing-tips", "upcoming", "draft-go"}},
+		{"latest published first, unpublished last", repository.PostFilter{Sort: repository.PostSortPublished}, []string{"upcoming", "testing-tips", "go-generics", "draft-go"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retrieved, total, err := repo.FindAll(ctx, tt.filter, 1, 10)
+			if err != nil {
+				t.Fatalf("FindAll() error = %v", err)
+			}
+			if total != int64(len(tt.want)) {
+				t.Errorf("Expected %d posts in total, got %d", len(tt.want), total)
+			}
+			if got := slugs(retrieved); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	// Paginate
+	retrieved, total, err := repo.FindAll(ctx, repository.PostFilter{}, 2, 3)
+	if err != nil {
+		t.Fatalf("FindAll() error = %v", err)
+	}
+	if total != 4 || len(retrieved) != 1 || retrieved[0].Slug != "go-generics" {
+		t.Errorf("Expected the oldest post alone on page 2 of 4, got %v of %d", slugs(retrieved), total)
+	}
+	if retrieved[0].Category == nil || len(retrieved[0].Tags) != 1 || retrieved[0].Author == nil {
+		t.Error("Expected the category, tags and author to be loaded")
+	}
+
+	// Count per status
+	counts, err := repo.CountByStatus(ctx)
+	if err != nil {
+		t.Fatalf("CountByStatus() error = %v", err)
+	}
+	want := map[entity.PostStatus]int64{
+		entity.PostStatusDraft:     1,
+		entity.PostStatusPublished: 2,
+		entity.PostStatusScheduled: 1,
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("Expected counts %v, got %v", want, counts)
+	}
+}
+
 func TestPostRepository_SlugExists(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewPostRepository(db)
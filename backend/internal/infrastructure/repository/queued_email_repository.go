@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// queuedEmailRepository implements the QueuedEmailRepository interface
+type queuedEmailRepository struct {
+	db *gorm.DB
+}
+
+// NewQueuedEmailRepository creates a new mail queue repository
+func NewQueuedEmailRepository(db *gorm.DB) repository.QueuedEmailRepository {
+	return &queuedEmailRepository{db: db}
+}
+
+// Create queues an email
+func (r *queuedEmailRepository) Create(ctx context.Context, email *entity.QueuedEmail) error {
+	return r.db.WithContext(ctx).Create(email).Error
+}
+
+// CountByAddressSince counts the emails queued to an address since the given time
+func (r *queuedEmailRepository) CountByAddressSince(ctx context.Context, to string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entity.QueuedEmail{}).
+		Where("recipient = ? AND created_at >= ?", to, since).
+		Count(&count).Error
+	return count, err
+}
+
+// FindPending retrieves unsent emails tried fewer than maxAttempts times, oldest first
+func (r *queuedEmailRepository) FindPending(ctx context.Context, maxAttempts, limit int) ([]entity.QueuedEmail, error) {
+	var emails []entity.QueuedEmail
+	err := r.db.WithContext(ctx).
+		Where("sent_at IS NULL AND attempts < ?", maxAttempts).
+		Order("id ASC").
+		Limit(limit).
+		Find(&emails).Error
+	return emails, err
+}
+
+// MarkSent records that an email was delivered
+func (r *queuedEmailRepository) MarkSent(ctx context.Context, id uint, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entity.QueuedEmail{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"sent_at":  at,
+			"attempts": gorm.Expr("attempts + 1"),
+		}).Error
+}
+
+// MarkFailed records a failed delivery attempt
+func (r *queuedEmailRepository) MarkFailed(ctx context.Context, id uint, reason string) error {
+	if len(reason) > 255 {
+		reason = reason[:255]
+	}
+	return r.db.WithContext(ctx).
+		Model(&entity.QueuedEmail{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"last_error": reason,
+			"attempts":   gorm.Expr("attempts + 1"),
+		}).Error
+}
+
+// DeleteSentBefore deletes emails delivered before the given time
+func (r *queuedEmailRepository) DeleteSentBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("sent_at IS NOT NULL AND sent_at < ?", before).
+		Delete(&entity.QueuedEmail{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+func TestQueuedEmailRepository_Delivery(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewQueuedEmailRepository(db)
+	ctx := context.Background()
+
+	for _, to := range []string{"reader@example.com", "reader@example.com", "other@example.com"} {
+		if err := repo.Create(ctx, &entity.QueuedEmail{To: to, Subject: "Reply", Body: "Someone replied"}); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	now := time.Now()
+	count, err := repo.CountByAddressSince(ctx, "reader@example.com", now.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("CountByAddressSince failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 emails to the address, got %d", count)
+	}
+
+	pending, err := repo.FindPending(ctx, 2, 10)
+	if err != nil {
+		t.Fatalf("FindPending failed: %v", err)
+	}
+	if len(pending) != 3 {
+		t.Fatalf("Expected 3 pending emails, got %d", len(pending))
+	}
+
+	if err := repo.MarkSent(ctx, pending[0].ID, now.Add(-8*24*time.Hour)); err != nil {
+		t.Fatalf("MarkSent failed: %v", err)
+	}
+	// An email failing maxAttempts times is given up on
+	for i := 0; i < 2; i++ {
+		if err := repo.MarkFailed(ctx, pending[1].ID, "connection refused"); err != nil {
+			t.Fatalf("MarkFailed failed: %v", err)
+		}
+	}
+
+	pending, err = repo.FindPending(ctx, 2, 10)
+	if err != nil {
+		t.Fatalf("FindPending failed: %v", err)
+	}
+	if len(pending) != 1 || pending[0].To != "other@example.com" {
+		t.Errorf("Expected only the untried email to be pending, got %+v", pending)
+	}
+
+	deleted, err := repo.DeleteSentBefore(ctx, now.Add(-7*24*time.Hour))
+	if err != nil {
+		t.Fatalf("DeleteSentBefore failed: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("Expected 1 deleted email, got %d", deleted)
+	}
+}
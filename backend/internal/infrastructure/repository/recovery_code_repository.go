@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// recoveryCodeRepository implements the RecoveryCodeRepository interface
+type recoveryCodeRepository struct {
+	db *gorm.DB
+}
+
+// NewRecoveryCodeRepository creates a new recovery code repository
+func NewRecoveryCodeRepository(db *gorm.DB) repository.RecoveryCodeRepository {
+	return &recoveryCodeRepository{db: db}
+}
+
+// Replace deletes every code of a user and stores the given hashes
+func (r *recoveryCodeRepository) Replace(ctx context.Context, userID uint, codeHashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&entity.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+
+		if len(codeHashes) == 0 {
+			return nil
+		}
+
+		codes := make([]entity.RecoveryCode, len(codeHashes))
+		for i, hash := range codeHashes {
+			codes[i] = entity.RecoveryCode{UserID: userID, CodeHash: hash}
+		}
+		return tx.Create(&codes).Error
+	})
+}
+
+// Consume atomically marks an unused code as used and returns it
+func (r *recoveryCodeRepository) Consume(ctx context.Context, userID uint, codeHash string, now time.Time) (*entity.RecoveryCode, error) {
+	var code entity.RecoveryCode
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		First(&code).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Guard on used_at so concurrent requests cannot both consume the code
+	result := r.db.WithContext(ctx).
+		Model(&entity.RecoveryCode{}).
+		Where("id = ? AND used_at IS NULL", code.ID).
+		Update("used_at", now)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	code.UsedAt = &now
+	return &code, nil
+}
+
+// CountUnused counts the codes a user has left
+func (r *recoveryCodeRepository) CountUnused(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entity.RecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// DeleteByUser deletes every code of a user
+func (r *recoveryCodeRepository) DeleteByUser(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&entity.RecoveryCode{}).Error
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+func TestRecoveryCodeRepository_ReplaceAndConsume(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRecoveryCodeRepository(db)
+	ctx := context.Background()
+
+	user := &entity.User{Email: "mfa@example.com", Password: "hashedpassword", Nickname: "mfauser"}
+	other := &entity.User{Email: "other@example.com", Password: "hashedpassword", Nickname: "otheruser"}
+	for _, u := range []*entity.User{user, other} {
+		if err := db.Create(u).Error; err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+	}
+
+	if err := repo.Replace(ctx, user.ID, []string{"old"}); err != nil {
+		t.Fatalf("Replace failed: %v", err)
+	}
+	if err := repo.Replace(ctx, user.ID, []string{"a", "b"}); err != nil {
+		t.Fatalf("Replace failed: %v", err)
+	}
+
+	count, err := repo.CountUnused(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("CountUnused failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 codes after replace, got %d", count)
+	}
+
+	now := time.Now()
+	tests := []struct {
+		name      string
+		userID    uint
+		codeHash  string
+		wantFound bool
+	}{
+		{"replaced code", user.ID, "old", false},
+		{"other user's code", other.ID, "a", false},
+		{"valid code", user.ID, "a", true},
+		{"already used", user.ID, "a", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := repo.Consume(ctx, tt.userID, tt.codeHash, now)
+			if err != nil {
+				t.Fatalf("Consume failed: %v", err)
+			}
+			if (code != nil) != tt.wantFound {
+				t.Errorf("Expected found=%v, got %+v", tt.wantFound, code)
+			}
+		})
+	}
+
+	count, _ = repo.CountUnused(ctx, user.ID)
+	if count != 1 {
+		t.Errorf("Expected 1 unused code, got %d", count)
+	}
+}
@@ -37,10 +37,10 @@ func (r *tagRepository) FindByID(ctx context.Context, id uint) (*entity.Tag, err
 	return &tag, nil
 }
 
-// FindBySlug finds a tag by slug
+// FindBySlug finds a tag by slug with its translations
 func (r *tagRepository) FindBySlug(ctx context.Context, slug string) (*entity.Tag, error) {
 	var tag entity.Tag
-	err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&tag).Error
+	err := r.db.WithContext(ctx).Preload("Translations").Where("slug = ?", slug).First(&tag).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -50,10 +50,10 @@ func (r *tagRepository) FindBySlug(ctx context.Context, slug string) (*entity.Ta
 	return &tag, nil
 }
 
-// FindAll retrieves all tags
+// FindAll retrieves all tags with their translations
 func (r *tagRepository) FindAll(ctx context.Context) ([]entity.Tag, error) {
 	var tags []entity.Tag
-	err := r.db.WithContext(ctx).Order("name ASC").Find(&tags).Error
+	err := r.db.WithContext(ctx).Preload("Translations").Order("name ASC").Find(&tags).Error
 	return tags, err
 }
 
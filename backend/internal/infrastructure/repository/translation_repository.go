@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// translationRepository implements the TranslationRepository interface
+type translationRepository struct {
+	db *gorm.DB
+}
+
+// NewTranslationRepository creates a new translation repository
+func NewTranslationRepository(db *gorm.DB) repository.TranslationRepository {
+	return &translationRepository{db: db}
+}
+
+// FindPostTranslations retrieves all translations of a post
+func (r *translationRepository) FindPostTranslations(ctx context.Context, postID uint) ([]entity.PostTranslation, error) {
+	var translations []entity.PostTranslation
+	err := r.db.WithContext(ctx).
+		Where("post_id = ?", postID).
+		Order("locale ASC").
+		Find(&translations).Error
+	return translations, err
+}
+
+// UpsertPostTranslation creates or replaces the translation of a post for its locale
+func (r *translationRepository) UpsertPostTranslation(ctx context.Context, translation *entity.PostTranslation) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "post_id"}, {Name: "locale"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"updated_at", "title", "excerpt", "content",
+				"meta_title", "meta_description", "meta_keywords",
+			}),
+		}).
+		Create(translation).Error
+}
+
+// DeletePostTranslation deletes the translation of a post for a locale
+func (r *translationRepository) DeletePostTranslation(ctx context.Context, postID uint, locale string) error {
+	return r.db.WithContext(ctx).
+		Where("post_id = ? AND locale = ?", postID, locale).
+		Delete(&entity.PostTranslation{}).Error
+}
+
+// UpsertCategoryTranslation creates or replaces the translation of a category for its locale
+func (r *translationRepository) UpsertCategoryTranslation(ctx context.Context, translation *entity.CategoryTranslation) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "category_id"}, {Name: "locale"}},
+			DoUpdates: clause.AssignmentColumns([]string{"updated_at", "name", "description"}),
+		}).
+		Create(translation).Error
+}
+
+// DeleteCategoryTranslation deletes the translation of a category for a locale
+func (r *translationRepository) DeleteCategoryTranslation(ctx context.Context, categoryID uint, locale string) error {
+	return r.db.WithContext(ctx).
+		Where("category_id = ? AND locale = ?", categoryID, locale).
+		Delete(&entity.CategoryTranslation{}).Error
+}
+
+// UpsertTagTranslation creates or replaces the translation of a tag for its locale
+func (r *translationRepository) UpsertTagTranslation(ctx context.Context, translation *entity.TagTranslation) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tag_id"}, {Name: "locale"}},
+			DoUpdates: clause.AssignmentColumns([]string{"updated_at", "name"}),
+		}).
+		Create(translation).Error
+}
+
+// DeleteTagTranslation deletes the translation of a tag for a locale
+func (r *translationRepository) DeleteTagTranslation(ctx context.Context, tagID uint, locale string) error {
+	return r.db.WithContext(ctx).
+		Where("tag_id = ? AND locale = ?", tagID, locale).
+		Delete(&entity.TagTranslation{}).Error
+}
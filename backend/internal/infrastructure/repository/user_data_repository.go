@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// deletedActorName replaces an anonymized user's nickname in notifications
+const deletedActorName = "A deleted user"
+
+// userDataRepository implements the UserDataRepository interface
+type userDataRepository struct {
+	db *gorm.DB
+}
+
+// NewUserDataRepository creates a new user data repository
+func NewUserDataRepository(db *gorm.DB) repository.UserDataRepository {
+	return &userDataRepository{db: db}
+}
+
+// FindComments lists the user's comments, oldest first
+func (r *userDataRepository) FindComments(ctx context.Context, userID uint) ([]entity.Comment, error) {
+	var comments []entity.Comment
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC, id ASC").
+		Find(&comments).Error
+	return comments, err
+}
+
+// FindLikes lists the user's post and comment likes, oldest first
+func (r *userDataRepository) FindLikes(ctx context.Context, userID uint) ([]entity.Like, error) {
+	var likes []entity.Like
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC, id ASC").
+		Find(&likes).Error
+	return likes, err
+}
+
+// FindBookmarks lists the user's bookmarks with their posts, oldest first
+func (r *userDataRepository) FindBookmarks(ctx context.Context, userID uint) ([]entity.Bookmark, error) {
+	var bookmarks []entity.Bookmark
+	err := r.db.WithContext(ctx).
+		Preload("Post").
+		Where("user_id = ?", userID).
+		Order("created_at ASC, id ASC").
+		Find(&bookmarks).Error
+	return bookmarks, err
+}
+
+// FindNotifications lists the notifications addressed to the user, oldest first
+func (r *userDataRepository) FindNotifications(ctx context.Context, userID uint) ([]entity.Notification, error) {
+	var notifications []entity.Notification
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC, id ASC").
+		Find(&notifications).Error
+	return notifications, err
+}
+
+// Anonymize removes the user's personal data in one transaction
+func (r *userDataRepository) Anonymize(ctx context.Context, user *entity.User) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Earlier versions of the user's comments go while the comments can
+		// still be told apart; edits they made to others' comments lose the
+		// editor
+		ownComments := tx.Unscoped().Model(&entity.Comment{}).Select("id").
+			Where("user_id = ? OR LOWER(author_email) = ?", user.ID, strings.ToLower(user.Email))
+		if err := tx.Where("comment_id IN (?)", ownComments).Delete(&entity.CommentRevision{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&entity.CommentRevision{}).
+			Where("editor_id = ?", user.ID).
+			Update("editor_id", nil).Error; err != nil {
+			return err
+		}
+
+		// Comments stay in their threads without an author. Soft deleted
+		// rows are included since they are still stored.
+		if err := tx.Unscoped().Model(&entity.Comment{}).
+			Where("user_id = ?", user.ID).
+			Updates(map[string]interface{}{"user_id": nil, "author_email": nil, "author_password": nil}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&entity.Comment{}).
+			Where("LOWER(author_email) = ?", strings.ToLower(user.Email)).
+			Updates(map[string]interface{}{"author_name": nil, "author_email": nil, "author_password": nil}).Error; err != nil {
+			return err
+		}
+
+		// Reply subscriptions and queued emails hold the address itself
+		if err := tx.Where("LOWER(email) = ?", strings.ToLower(user.Email)).Delete(&entity.CommentSubscription{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("LOWER(recipient) = ?", strings.ToLower(user.Email)).Delete(&entity.QueuedEmail{}).Error; err != nil {
+			return err
+		}
+
+		// Mentions of the user go with the account they pointed at
+		if err := tx.Where("user_id = ?", user.ID).Delete(&entity.CommentMention{}).Error; err != nil {
+			return err
+		}
+
+		// Reports the user filed stay in the moderators' inbox without a
+		// reporter. The key only has to stay unique per comment.
+		if err := tx.Model(&entity.CommentReport{}).
+			Where("reporter_id = ?", user.ID).
+			Updates(map[string]interface{}{"reporter_id": nil, "reporter_key": gorm.Expr("'deleted:' || id")}).Error; err != nil {
+			return err
+		}
+
+		// Likes and bookmarks go, along with the counts they contributed
+		likedPosts := tx.Model(&entity.Like{}).Select("post_id").Where("user_id = ? AND post_id IS NOT NULL", user.ID)
+		if err := tx.Model(&entity.Post{}).
+			Where("id IN (?)", likedPosts).
+			UpdateColumn("like_count", gorm.Expr("like_count - ?", 1)).Error; err != nil {
+			return err
+		}
+		likedComments := tx.Model(&entity.Like{}).Select("comment_id").Where("user_id = ? AND comment_id IS NOT NULL", user.ID)
+		if err := tx.Model(&entity.Comment{}).
+			Where("id IN (?)", likedComments).
+			UpdateColumn("like_count", gorm.Expr("like_count - ?", 1)).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", user.ID).Delete(&entity.Like{}).Error; err != nil {
+			return err
+		}
+		bookmarkedPosts := tx.Model(&entity.Bookmark{}).Select("post_id").Where("user_id = ?", user.ID)
+		if err := tx.Model(&entity.Post{}).
+			Where("id IN (?)", bookmarkedPosts).
+			UpdateColumn("bookmark_count", gorm.Expr("bookmark_count - ?", 1)).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", user.ID).Delete(&entity.Bookmark{}).Error; err != nil {
+			return err
+		}
+
+		// Notifications to the user go; those they triggered lose the actor,
+		// and the messages that open with the actor's nickname lose it too
+		if err := tx.Unscoped().Where("user_id = ?", user.ID).Delete(&entity.Notification{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&entity.Notification{}).
+			Where(`actor_id = ? AND message LIKE ? ESCAPE '\'`, user.ID, escapeLike(user.Nickname)+" %").
+			Update("message", gorm.Expr("? || SUBSTR(message, ?)", deletedActorName, utf8.RuneCountInString(user.Nickname)+1)).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&entity.Notification{}).
+			Where("actor_id = ?", user.ID).
+			Update("actor_id", nil).Error; err != nil {
+			return err
+		}
+
+		// Audit entries of the user's admin actions stay for accountability.
+		// The actor ID now points at the scrubbed row; the address goes.
+		if err := tx.Model(&entity.AuditLog{}).
+			Where("actor_id = ?", user.ID).
+			Update("ip_address", "").Error; err != nil {
+			return err
+		}
+
+		// Credentials and login state
+		for _, model := range []interface{}{
+			&entity.UserToken{},
+			&entity.UserIdentity{},
+			&entity.RecoveryCode{},
+			&entity.PersonalAccessToken{},
+		} {
+			if err := tx.Where("user_id = ?", user.ID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("scope = ? AND identifier = ?", entity.LoginThrottleScopeAccount, strings.ToLower(strings.TrimSpace(user.Email))).
+			Delete(&entity.LoginThrottle{}).Error; err != nil {
+			return err
+		}
+
+		// The row itself stays for posts and foreign keys, without anything
+		// that identifies the person. Email and nickname keep their unique
+		// indexes satisfied and free the originals for reuse.
+		user.Email = fmt.Sprintf("deleted-%d@deleted.invalid", user.ID)
+		user.Nickname = fmt.Sprintf("deleted-%d", user.ID)
+		user.Password = ""
+		user.EmailVerifiedAt = nil
+		user.TokenVersion++
+		user.TOTPSecret = nil
+		user.TOTPEnabledAt = nil
+		user.TOTPLastStep = 0
+		user.AvatarURL = nil
+		user.Bio = nil
+		user.SetRole(entity.RoleMember)
+		user.LastLoginAt = nil
+		user.DeletionScheduledAt = nil
+		if err := tx.Save(user).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.User{}, user.ID).Error
+	})
+}
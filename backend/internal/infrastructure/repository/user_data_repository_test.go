@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/usecase/admin"
+	"gorm.io/gorm"
+)
+
+func TestUserDataRepository_Anonymize(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewUserDataRepository(db)
+	ctx := context.Background()
+
+	author := &entity.User{Email: "author@example.com", Password: "hashedpassword", Nickname: "author"}
+	leaving := &entity.User{Email: "Leaving@example.com", Password: "hashedpassword", Nickname: "leaving"}
+	for _, u := range []*entity.User{author, leaving} {
+		if err := db.Create(u).Error; err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+	}
+
+	now := time.Now()
+	post := &entity.Post{Title: "Post", Slug: "post", Content: "Content", Status: "published", PublishedAt: &now, AuthorID: author.ID, LikeCount: 2, BookmarkCount: 1}
+	if err := db.Create(post).Error; err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+	comment := &entity.Comment{PostID: post.ID, UserID: &leaving.ID, Content: "Nice post", LikeCount: 1}
+	if err := db.Create(comment).Error; err != nil {
+		t.Fatalf("Failed to create test comment: %v", err)
+	}
+	email := "leaving@example.com"
+	anonymous := &entity.Comment{PostID: post.ID, AuthorName: &email, AuthorEmail: &email, Content: "Earlier, signed out"}
+	if err := db.Create(anonymous).Error; err != nil {
+		t.Fatalf("Failed to create test comment: %v", err)
+	}
+
+	other := &entity.Comment{PostID: post.ID, UserID: &author.ID, Content: "Fixed by a moderator"}
+	if err := db.Create(other).Error; err != nil {
+		t.Fatalf("Failed to create test comment: %v", err)
+	}
+
+	records := []interface{}{
+		&entity.CommentRevision{CommentID: comment.ID, PreviousContent: "Nice post, call me on 555-0100", Content: "Nice post", EditorID: &leaving.ID},
+		&entity.CommentRevision{CommentID: anonymous.ID, PreviousContent: "Earlier", Content: "Earlier, signed out", EditorIPHash: "203.0.113.0"},
+		&entity.CommentRevision{CommentID: other.ID, PreviousContent: "Fixd", Content: "Fixed by a moderator", EditorID: &leaving.ID},
+		&entity.Like{UserID: leaving.ID, PostID: &post.ID},
+		&entity.Like{UserID: author.ID, PostID: &post.ID},
+		&entity.Like{UserID: leaving.ID, CommentID: &comment.ID},
+		&entity.Bookmark{UserID: leaving.ID, PostID: post.ID},
+		&entity.CommentMention{CommentID: anonymous.ID, UserID: leaving.ID},
+		&entity.CommentSubscription{CommentID: anonymous.ID, Email: "Leaving@example.com", UnsubscribeToken: "unsubscribe-leaving"},
+		&entity.CommentSubscription{CommentID: anonymous.ID, Email: "someone@example.com", UnsubscribeToken: "unsubscribe-someone"},
+		&entity.QueuedEmail{To: "leaving@example.com", Subject: "Reply", Body: "b"},
+		&entity.CommentReport{CommentID: anonymous.ID, Reason: entity.ReportReasonSpam, ReporterID: &leaving.ID, ReporterKey: fmt.Sprintf("user:%d", leaving.ID), Status: entity.ReportStatusOpen},
+		&entity.Notification{UserID: leaving.ID, Type: entity.NotificationTypePostComment, Title: "To leaving", Message: "m"},
+		&entity.Notification{UserID: author.ID, ActorID: &leaving.ID, Type: entity.NotificationTypePostComment, Title: "You were mentioned", Message: `leaving mentioned you in a comment on "Post".`},
+		&entity.UserToken{UserID: leaving.ID, Purpose: entity.UserTokenPurposePasswordReset, TokenHash: "hash", ExpiresAt: now.Add(time.Hour)},
+		&entity.PersonalAccessToken{UserID: leaving.ID, Name: "ci", Prefix: "abcd2345", SecretHash: "hash"},
+		&entity.AuditLog{ActorID: leaving.ID, Action: "comment.delete", TargetType: entity.AuditTargetComment, Method: "DELETE", Path: "/api/v1/admin/comments/1", Status: 200, IPAddress: "203.0.113.7"},
+		&entity.LoginThrottle{Scope: entity.LoginThrottleScopeAccount, Identifier: "leaving@example.com", Failures: 2, LastFailureAt: now},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("Failed to create %T: %v", record, err)
+		}
+	}
+
+	if found, err := repo.FindComments(ctx, leaving.ID); err != nil || len(found) != 1 {
+		t.Fatalf("Expected 1 comment before anonymizing, got %d, %v", len(found), err)
+	}
+
+	if err := repo.Anonymize(ctx, leaving); err != nil {
+		t.Fatalf("Anonymize failed: %v", err)
+	}
+
+	// Comments keep their content without authorship
+	var kept entity.Comment
+	db.First(&kept, comment.ID)
+	if kept.Content != "Nice post" || kept.UserID != nil {
+		t.Errorf("Expected comment to keep content and lose its author, got %+v", kept)
+	}
+	if kept.LikeCount != 0 {
+		t.Errorf("Expected comment like count to drop to 0, got %d", kept.LikeCount)
+	}
+	var signedOut entity.Comment
+	db.First(&signedOut, anonymous.ID)
+	if signedOut.Content != "Earlier, signed out" || signedOut.AuthorName != nil || signedOut.AuthorEmail != nil {
+		t.Errorf("Expected anonymous comment to keep content and lose name and email, got %+v", signedOut)
+	}
+
+	// Only revisions of other people's comments are kept, without the editor
+	var revisions []entity.CommentRevision
+	db.Find(&revisions)
+	if len(revisions) != 1 || revisions[0].CommentID != other.ID || revisions[0].EditorID != nil {
+		t.Errorf("Expected only the anonymized edit of another comment to remain, got %+v", revisions)
+	}
+
+	// Only other people's subscriptions and emails are left
+	var subscriptions []entity.CommentSubscription
+	db.Find(&subscriptions)
+	if len(subscriptions) != 1 || subscriptions[0].Email != "someone@example.com" {
+		t.Errorf("Expected only the other subscriber to remain, got %+v", subscriptions)
+	}
+	var queued int64
+	db.Model(&entity.QueuedEmail{}).Count(&queued)
+	if queued != 0 {
+		t.Errorf("Expected queued emails to the address to be deleted, got %d", queued)
+	}
+
+	// Audit entries keep the action but not the actor's address
+	var entry entity.AuditLog
+	db.Where("actor_id = ?", leaving.ID).First(&entry)
+	if entry.Action != "comment.delete" || entry.IPAddress != "" {
+		t.Errorf("Expected audit entry to keep its action and lose the IP, got %+v", entry)
+	}
+
+	// Reports stay for moderators without the reporter
+	var report entity.CommentReport
+	db.Where("comment_id = ?", anonymous.ID).First(&report)
+	if report.ReporterID != nil || report.ReporterKey == fmt.Sprintf("user:%d", leaving.ID) {
+		t.Errorf("Expected report to lose its reporter, got %+v", report)
+	}
+
+	var updated entity.Post
+	db.First(&updated, post.ID)
+	if updated.LikeCount != 1 || updated.BookmarkCount != 0 {
+		t.Errorf("Expected counts 1/0 after anonymizing, got %d/%d", updated.LikeCount, updated.BookmarkCount)
+	}
+
+	counts := map[string]interface{}{
+		"likes":                  &entity.Like{},
+		"bookmarks":              &entity.Bookmark{},
+		"comment_mentions":       &entity.CommentMention{},
+		"user_tokens":            &entity.UserToken{},
+		"personal_access_tokens": &entity.PersonalAccessToken{},
+	}
+	for name, model := range counts {
+		var count int64
+		db.Model(model).Where("user_id = ?", leaving.ID).Count(&count)
+		if count != 0 {
+			t.Errorf("Expected no %s left, got %d", name, count)
+		}
+	}
+	var count int64
+	db.Model(&entity.LoginThrottle{}).Count(&count)
+	if count != 0 {
+		t.Errorf("Expected account login throttle to be deleted, got %d", count)
+	}
+
+	notifications, err := repo.FindNotifications(ctx, author.ID)
+	if err != nil || len(notifications) != 1 || notifications[0].ActorID != nil ||
+		notifications[0].Message != `A deleted user mentioned you in a comment on "Post".` {
+		t.Errorf("Expected author's notification to lose its actor, got %+v, %v", notifications, err)
+	}
+	if notifications, _ := repo.FindNotifications(ctx, leaving.ID); len(notifications) != 0 {
+		t.Errorf("Expected leaving user's notifications to be deleted, got %d", len(notifications))
+	}
+
+	// The account row is scrubbed and soft deleted, freeing email and nickname
+	var scrubbed entity.User
+	if err := db.Unscoped().First(&scrubbed, leaving.ID).Error; err != nil {
+		t.Fatalf("Failed to load anonymized user: %v", err)
+	}
+	if !scrubbed.DeletedAt.Valid || scrubbed.Email == "Leaving@example.com" || scrubbed.Nickname == "leaving" || scrubbed.Password != "" {
+		t.Errorf("Expected user to be scrubbed and deleted, got %+v", scrubbed)
+	}
+	assertNoTableContains(t, db, "leaving@example.com", "leaving")
+
+	reused := &entity.User{Email: "Leaving@example.com", Password: "hashedpassword", Nickname: "leaving"}
+	if err := db.Create(reused).Error; err != nil {
+		t.Errorf("Expected email and nickname to be reusable: %v", err)
+	}
+}
+
+func TestUserDataRepository_AnonymizeAuditSnapshots(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewUserDataRepository(db)
+	ctx := context.Background()
+	recorder := admin.NewAuditRecorder(
+		NewAuditLogRepository(db),
+		NewUserRepository(db),
+		NewPostRepository(db),
+		NewCommentRepository(db),
+		NewCommentReportRepository(db),
+		NewCategoryRepository(db),
+		NewTagRepository(db),
+		NewBanRepository(db),
+		NewLoginThrottleRepository(db),
+	)
+
+	moderator := &entity.User{Email: "moderator@example.com", Password: "hashedpassword", Nickname: "moderator"}
+	bio := "Lives on Elm Street"
+	reason := "Spam from leaving@example.com"
+	now := time.Now()
+	leaving := &entity.User{Email: "leaving@example.com", Password: "hashedpassword", Nickname: "leaving-user", Bio: &bio, BanReason: &reason, BannedAt: &now}
+	for _, u := range []*entity.User{moderator, leaving} {
+		if err := db.Create(u).Error; err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+	}
+	post := &entity.Post{Title: "Post", Slug: "post", Content: "Content", Status: "published", AuthorID: leaving.ID}
+	if err := db.Create(post).Error; err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+	name := "Leaving User"
+	comment := &entity.Comment{PostID: post.ID, AuthorName: &name, AuthorEmail: &leaving.Email, Content: "Signed out comment"}
+	if err := db.Create(comment).Error; err != nil {
+		t.Fatalf("Failed to create test comment: %v", err)
+	}
+
+	targets := []struct {
+		targetType entity.AuditTargetType
+		id         uint
+	}{
+		{entity.AuditTargetUser, leaving.ID},
+		{entity.AuditTargetPost, post.ID},
+		{entity.AuditTargetComment, comment.ID},
+	}
+	for _, target := range targets {
+		snap, err := recorder.Snapshot(ctx, target.targetType, target.id)
+		if err != nil || snap == nil {
+			t.Fatalf("Snapshot of %s failed: %v", target.targetType, err)
+		}
+		encoded, err := json.Marshal(snap)
+		if err != nil {
+			t.Fatalf("Failed to encode snapshot: %v", err)
+		}
+		id := target.id
+		if err := recorder.Record(ctx, &entity.AuditLog{
+			ActorID:    moderator.ID,
+			Action:     string(target.targetType) + ".update",
+			TargetType: target.targetType,
+			TargetID:   &id,
+			Before:     string(encoded),
+			After:      string(encoded),
+			Method:     "PUT",
+			Path:       "/api/v1/admin",
+			Status:     200,
+		}); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+	}
+
+	if err := repo.Anonymize(ctx, leaving); err != nil {
+		t.Fatalf("Anonymize failed: %v", err)
+	}
+
+	var entries []entity.AuditLog
+	db.Find(&entries)
+	if len(entries) != len(targets) {
+		t.Fatalf("Expected %d audit entries to be kept, got %d", len(targets), len(entries))
+	}
+	for _, entry := range entries {
+		for _, personal := range []string{"leaving@example.com", "leaving-user", "Leaving User", bio, reason} {
+			if strings.Contains(entry.Before, personal) || strings.Contains(entry.After, personal) {
+				t.Errorf("Expected %s entry to hold no %q, got %s", entry.Action, personal, entry.After)
+			}
+		}
+	}
+}
+
+// assertNoTableContains fails when any column of any table holds one of the values
+func assertNoTableContains(t *testing.T, db *gorm.DB, values ...string) {
+	t.Helper()
+	tables, err := db.Migrator().GetTables()
+	if err != nil {
+		t.Fatalf("Failed to list tables: %v", err)
+	}
+	for _, table := range tables {
+		var rows []map[string]interface{}
+		if err := db.Table(table).Find(&rows).Error; err != nil {
+			t.Fatalf("Failed to read %s: %v", table, err)
+		}
+		for _, row := range rows {
+			for column, value := range row {
+				var text string
+				switch v := value.(type) {
+				case string:
+					text = v
+				case []byte:
+					text = string(v)
+				default:
+					continue
+				}
+				for _, want := range values {
+					if strings.Contains(strings.ToLower(text), strings.ToLower(want)) {
+						t.Errorf("Expected %q to be erased, found in %s.%s: %q", want, table, column, text)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// userIdentityRepository implements the UserIdentityRepository interface
+type userIdentityRepository struct {
+	db *gorm.DB
+}
+
+// NewUserIdentityRepository creates a new user identity repository
+func NewUserIdentityRepository(db *gorm.DB) repository.UserIdentityRepository {
+	return &userIdentityRepository{db: db}
+}
+
+// Create links a new identity
+func (r *userIdentityRepository) Create(ctx context.Context, identity *entity.UserIdentity) error {
+	return r.db.WithContext(ctx).Create(identity).Error
+}
+
+// FindByProviderSubject finds the identity for a provider account
+func (r *userIdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (*entity.UserIdentity, error) {
+	var identity entity.UserIdentity
+	err := r.db.WithContext(ctx).
+		Where("provider = ? AND subject = ?", provider, subject).
+		First(&identity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// FindByUserID lists every identity linked to a user
+func (r *userIdentityRepository) FindByUserID(ctx context.Context, userID uint) ([]entity.UserIdentity, error) {
+	var identities []entity.UserIdentity
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("provider ASC").
+		Find(&identities).Error
+	return identities, err
+}
+
+// Update updates an identity
+func (r *userIdentityRepository) Update(ctx context.Context, identity *entity.UserIdentity) error {
+	return r.db.WithContext(ctx).Save(identity).Error
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+func TestUserIdentityRepository_FindByProviderSubject(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewUserIdentityRepository(db)
+	ctx := context.Background()
+
+	user := &entity.User{Email: "identity@example.com", Password: "hashedpassword", Nickname: "identityuser"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	identity := &entity.UserIdentity{UserID: user.ID, Provider: "github", Subject: "4242", Email: user.Email}
+	if err := repo.Create(ctx, identity); err != nil {
+		t.Fatalf("Failed to create identity: %v", err)
+	}
+
+	found, err := repo.FindByProviderSubject(ctx, "github", "4242")
+	if err != nil {
+		t.Fatalf("FindByProviderSubject failed: %v", err)
+	}
+	if found == nil || found.UserID != user.ID {
+		t.Fatalf("Expected identity for user %d, got %+v", user.ID, found)
+	}
+
+	// The same subject at another provider is a different account
+	missing, err := repo.FindByProviderSubject(ctx, "google", "4242")
+	if err != nil {
+		t.Fatalf("FindByProviderSubject failed: %v", err)
+	}
+	if missing != nil {
+		t.Errorf("Expected no identity, got %+v", missing)
+	}
+
+	duplicate := &entity.UserIdentity{UserID: user.ID, Provider: "github", Subject: "4242"}
+	if err := repo.Create(ctx, duplicate); err == nil {
+		t.Error("Expected duplicate provider subject to be rejected")
+	}
+
+	identities, err := repo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("FindByUserID failed: %v", err)
+	}
+	if len(identities) != 1 {
+		t.Errorf("Expected 1 identity, got %d", len(identities))
+	}
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/yourusername/viblog/internal/domain/entity"
@@ -94,19 +95,45 @@ func (r *userRepository) UpdateLastLoginAt(ctx context.Context, id uint) error {
 	return r.db.WithContext(ctx).Model(&entity.User{}).Where("id = ?", id).Update("last_login_at", now).Error
 }
 
-// FindAll retrieves all users with pagination
-func (r *userRepository) FindAll(ctx context.Context, page, limit int) ([]entity.User, int64, error) {
+// AdvanceTOTPStep records step as the user's last accepted TOTP step unless
+// it is not newer
+func (r *userRepository) AdvanceTOTPStep(ctx context.Context, id uint, step int64) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&entity.User{}).
+		Where("id = ? AND totp_last_step < ?", id, step).
+		Update("totp_last_step", step)
+	return result.RowsAffected > 0, result.Error
+}
+
+// FindAll retrieves users matching the filter with pagination
+func (r *userRepository) FindAll(ctx context.Context, filter repository.UserFilter, page, limit int) ([]entity.User, int64, error) {
 	var users []entity.User
 	var total int64
 
+	query := r.db.WithContext(ctx).Model(&entity.User{})
+	if filter.Search != "" {
+		pattern := "%" + strings.ToLower(filter.Search) + "%"
+		query = query.Where("LOWER(email) LIKE ? OR LOWER(nickname) LIKE ?", pattern, pattern)
+	}
+	if filter.Role != "" {
+		query = query.Where("role = ?", filter.Role)
+	}
+	switch filter.Status {
+	case entity.UserStatusActive:
+		query = query.Where("banned_at IS NULL AND (suspended_until IS NULL OR suspended_until <= ?)", filter.At)
+	case entity.UserStatusSuspended:
+		query = query.Where("banned_at IS NULL AND suspended_until > ?", filter.At)
+	case entity.UserStatusBanned:
+		query = query.Where("banned_at IS NOT NULL")
+	}
+
 	// Get total count
-	if err := r.db.WithContext(ctx).Model(&entity.User{}).Count(&total).Error; err != nil {
+	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
 	// Get paginated users
 	offset := (page - 1) * limit
-	err := r.db.WithContext(ctx).
+	err := query.
 		Limit(limit).
 		Offset(offset).
 		Order("created_at DESC").
@@ -121,3 +148,21 @@ func (r *userRepository) GetTotalCount(ctx context.Context) (int64, error) {
 	err := r.db.WithContext(ctx).Model(&entity.User{}).Count(&count).Error
 	return count, err
 }
+
+// CountByRole counts users that have the given role
+func (r *userRepository) CountByRole(ctx context.Context, role entity.Role) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entity.User{}).Where("role = ?", role).Count(&count).Error
+	return count, err
+}
+
+// FindDueForDeletion finds up to limit users whose scheduled deletion is at or before the given time
+func (r *userRepository) FindDueForDeletion(ctx context.Context, before time.Time, limit int) ([]entity.User, error) {
+	var users []entity.User
+	err := r.db.WithContext(ctx).
+		Where("deletion_scheduled_at IS NOT NULL AND deletion_scheduled_at <= ?", before).
+		Order("deletion_scheduled_at ASC").
+		Limit(limit).
+		Find(&users).Error
+	return users, err
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+)
+
+func TestUserRepository_CountByRole(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewUserRepository(db)
+	ctx := context.Background()
+
+	admin := &entity.User{Email: "admin@example.com", Password: "hashedpassword", Nickname: "adminuser"}
+	admin.SetRole(entity.RoleAdmin)
+	editor := &entity.User{Email: "editor@example.com", Password: "hashedpassword", Nickname: "editoruser"}
+	editor.SetRole(entity.RoleEditor)
+	// Role left empty falls back to the column default
+	member := &entity.User{Email: "member@example.com", Password: "hashedpassword", Nickname: "memberuser"}
+
+	for _, u := range []*entity.User{admin, editor, member} {
+		if err := repo.Create(ctx, u); err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+	}
+
+	tests := map[entity.Role]int64{
+		entity.RoleAdmin:     1,
+		entity.RoleEditor:    1,
+		entity.RoleModerator: 0,
+		entity.RoleMember:    1,
+	}
+	for role, want := range tests {
+		got, err := repo.CountByRole(ctx, role)
+		if err != nil {
+			t.Fatalf("CountByRole(%s) failed: %v", role, err)
+		}
+		if got != want {
+			t.Errorf("CountByRole(%s) = %d, want %d", role, got, want)
+		}
+	}
+}
+
+func TestUserRepository_FindDueForDeletion(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewUserRepository(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	users := []*entity.User{
+		{Email: "due@example.com", Password: "hashedpassword", Nickname: "due", DeletionScheduledAt: &past},
+		{Email: "later@example.com", Password: "hashedpassword", Nickname: "later", DeletionScheduledAt: &future},
+		{Email: "staying@example.com", Password: "hashedpassword", Nickname: "staying"},
+	}
+	for _, u := range users {
+		if err := repo.Create(ctx, u); err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+	}
+
+	due, err := repo.FindDueForDeletion(ctx, now, 10)
+	if err != nil {
+		t.Fatalf("FindDueForDeletion failed: %v", err)
+	}
+	if len(due) != 1 || due[0].ID != users[0].ID {
+		t.Errorf("Expected only the due user, got %+v", due)
+	}
+}
+
+func TestUserRepository_AdvanceTOTPStep(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewUserRepository(db)
+	ctx := context.Background()
+
+	user := &entity.User{Email: "mfa@example.com", Password: "hashedpassword", Nickname: "mfa", TOTPLastStep: 100}
+	if err := repo.Create(ctx, user); err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		step     int64
+		advanced bool
+	}{
+		{"older step", 99, false},
+		{"same step", 100, false},
+		{"newer step", 101, true},
+		{"replayed step", 101, false},
+	}
+	for _, tt := range tests {
+		advanced, err := repo.AdvanceTOTPStep(ctx, user.ID, tt.step)
+		if err != nil {
+			t.Fatalf("AdvanceTOTPStep failed: %v", err)
+		}
+		if advanced != tt.advanced {
+			t.Errorf("%s: expected advanced=%v, got %v", tt.name, tt.advanced, advanced)
+		}
+	}
+
+	found, _ := repo.FindByID(ctx, user.ID)
+	if found.TOTPLastStep != 101 {
+		t.Errorf("Expected last step 101, got %d", found.TOTPLastStep)
+	}
+}
+
+func TestUserRepository_FindAllFilters(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewUserRepository(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+	reason := "spam"
+
+	editor := &entity.User{Email: "alice@example.com", Password: "hashedpassword", Nickname: "alice"}
+	editor.SetRole(entity.RoleEditor)
+	suspended := &entity.User{Email: "bob@example.com", Password: "hashedpassword", Nickname: "bob", SuspendedUntil: &later, SuspensionReason: &reason}
+	expired := &entity.User{Email: "carol@example.com", Password: "hashedpassword", Nickname: "carol", SuspendedUntil: &earlier}
+	banned := &entity.User{Email: "dave@example.org", Password: "hashedpassword", Nickname: "Dave", BannedAt: &earlier, BanReason: &reason}
+	for _, u := range []*entity.User{editor, suspended, expired, banned} {
+		if err := repo.Create(ctx, u); err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter repository.UserFilter
+		want   []uint
+	}{
+		{"no filter", repository.UserFilter{At: now}, []uint{editor.ID, suspended.ID, expired.ID, banned.ID}},
+		{"search email", repository.UserFilter{Search: "EXAMPLE.ORG", At: now}, []uint{banned.ID}},
+		{"search nickname", repository.UserFilter{Search: "dav", At: now}, []uint{banned.ID}},
+		{"role", repository.UserFilter{Role: entity.RoleEditor, At: now}, []uint{editor.ID}},
+		{"active", repository.UserFilter{Status: entity.UserStatusActive, At: now}, []uint{editor.ID, expired.ID}},
+		{"suspended", repository.UserFilter{Status: entity.UserStatusSuspended, At: now}, []uint{suspended.ID}},
+		{"banned", repository.UserFilter{Status: entity.UserStatusBanned, At: now}, []uint{banned.ID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, total, err := repo.FindAll(ctx, tt.filter, 1, 10)
+			if err != nil {
+				t.Fatalf("FindAll failed: %v", err)
+			}
+			if total != int64(len(tt.want)) || len(users) != len(tt.want) {
+				t.Fatalf("Expected %d users, got %d (total %d)", len(tt.want), len(users), total)
+			}
+			found := make(map[uint]bool, len(users))
+			for _, u := range users {
+				found[u.ID] = true
+			}
+			for _, id := range tt.want {
+				if !found[id] {
+					t.Errorf("Expected user %d in results", id)
+				}
+			}
+		})
+	}
+}
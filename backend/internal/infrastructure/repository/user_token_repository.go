@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// userTokenRepository implements the UserTokenRepository interface
+type userTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewUserTokenRepository creates a new user token repository
+func NewUserTokenRepository(db *gorm.DB) repository.UserTokenRepository {
+	return &userTokenRepository{db: db}
+}
+
+// Create stores a new token
+func (r *userTokenRepository) Create(ctx context.Context, token *entity.UserToken) error {
+	return r.db.WithContext(ctx).Create(token).Error
+}
+
+// Consume atomically marks a usable token as used and returns it
+func (r *userTokenRepository) Consume(ctx context.Context, purpose entity.UserTokenPurpose, tokenHash string, now time.Time) (*entity.UserToken, error) {
+	var token entity.UserToken
+	err := r.db.WithContext(ctx).
+		Where("token_hash = ? AND purpose = ?", tokenHash, purpose).
+		Where("used_at IS NULL AND expires_at > ?", now).
+		First(&token).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Guard on used_at so concurrent requests cannot both consume the token
+	result := r.db.WithContext(ctx).
+		Model(&entity.UserToken{}).
+		Where("id = ? AND used_at IS NULL", token.ID).
+		Update("used_at", now)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	token.UsedAt = &now
+	return &token, nil
+}
+
+// DeleteByUser deletes every token of a user for a purpose
+func (r *userTokenRepository) DeleteByUser(ctx context.Context, userID uint, purpose entity.UserTokenPurpose) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND purpose = ?", userID, purpose).
+		Delete(&entity.UserToken{}).Error
+}
+
+// DeleteExpired deletes tokens that expired before the given time
+func (r *userTokenRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("expires_at < ?", before).
+		Delete(&entity.UserToken{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+)
+
+func TestUserTokenRepository_Consume(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewUserTokenRepository(db)
+	ctx := context.Background()
+
+	user := &entity.User{Email: "token@example.com", Password: "hashedpassword", Nickname: "tokenuser"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	now := time.Now()
+	tokens := []*entity.UserToken{
+		{UserID: user.ID, Purpose: entity.UserTokenPurposePasswordReset, TokenHash: "valid", ExpiresAt: now.Add(time.Hour)},
+		{UserID: user.ID, Purpose: entity.UserTokenPurposePasswordReset, TokenHash: "expired", ExpiresAt: now.Add(-time.Minute)},
+		{UserID: user.ID, Purpose: entity.UserTokenPurposeEmailVerification, TokenHash: "other-purpose", ExpiresAt: now.Add(time.Hour)},
+	}
+	for _, token := range tokens {
+		if err := repo.Create(ctx, token); err != nil {
+			t.Fatalf("Failed to create token: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		tokenHash string
+		wantFound bool
+	}{
+		{"valid token", "valid", true},
+		{"already used", "valid", false},
+		{"expired", "expired", false},
+		{"wrong purpose", "other-purpose", false},
+		{"unknown", "unknown", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := repo.Consume(ctx, entity.UserTokenPurposePasswordReset, tt.tokenHash, now)
+			if err != nil {
+				t.Fatalf("Consume failed: %v", err)
+			}
+			if (token != nil) != tt.wantFound {
+				t.Fatalf("expected found=%v, got %v", tt.wantFound, token != nil)
+			}
+			if token != nil && token.UsedAt == nil {
+				t.Error("expected consumed token to be marked used")
+			}
+		})
+	}
+}
+
+func TestUserTokenRepository_DeleteByUserAndExpired(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewUserTokenRepository(db)
+	ctx := context.Background()
+
+	user := &entity.User{Email: "cleanup@example.com", Password: "hashedpassword", Nickname: "cleanupuser"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	now := time.Now()
+	for _, token := range []*entity.UserToken{
+		{UserID: user.ID, Purpose: entity.UserTokenPurposePasswordReset, TokenHash: "reset", ExpiresAt: now.Add(time.Hour)},
+		{UserID: user.ID, Purpose: entity.UserTokenPurposeEmailVerification, TokenHash: "verify", ExpiresAt: now.Add(time.Hour)},
+		{UserID: user.ID, Purpose: entity.UserTokenPurposeEmailVerification, TokenHash: "stale", ExpiresAt: now.Add(-time.Hour)},
+	} {
+		if err := repo.Create(ctx, token); err != nil {
+			t.Fatalf("Failed to create token: %v", err)
+		}
+	}
+
+	if err := repo.DeleteByUser(ctx, user.ID, entity.UserTokenPurposePasswordReset); err != nil {
+		t.Fatalf("DeleteByUser failed: %v", err)
+	}
+	if token, _ := repo.Consume(ctx, entity.UserTokenPurposePasswordReset, "reset", now); token != nil {
+		t.Error("expected reset token to be deleted")
+	}
+
+	deleted, err := repo.DeleteExpired(ctx, now)
+	if err != nil {
+		t.Fatalf("DeleteExpired failed: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("expected 1 expired token deleted, got %d", deleted)
+	}
+
+	if token, _ := repo.Consume(ctx, entity.UserTokenPurposeEmailVerification, "verify", now); token == nil {
+		t.Error("expected verification token to survive")
+	}
+}
@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Job is a task that runs periodically in the background
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context, now time.Time) error
+}
+
+// Scheduler runs jobs on their intervals until it is stopped. Every job runs
+// once at start, then after each interval; runs of one job never overlap.
+type Scheduler struct {
+	logger *zap.Logger
+	jobs   []Job
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a new Scheduler
+func New(logger *zap.Logger, jobs ...Job) *Scheduler {
+	return &Scheduler{
+		logger: logger,
+		jobs:   jobs,
+	}
+}
+
+// Start launches every job in the background. Calling it again has no effect.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+}
+
+// Stop cancels running jobs and waits for them to return
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.run(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	start := time.Now()
+	if err := job.Run(ctx, start); err != nil {
+		if ctx.Err() == nil {
+			s.logger.Error("Scheduled job failed", zap.String("job", job.Name), zap.Error(err))
+		}
+		return
+	}
+	s.logger.Debug("Scheduled job finished", zap.String("job", job.Name), zap.Duration("duration", time.Since(start)))
+}
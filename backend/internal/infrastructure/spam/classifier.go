@@ -0,0 +1,91 @@
+package spam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// classifierWeight scales the external classifier's 0-1 probability
+const classifierWeight = 2.0
+
+// ClassifierRequest is the JSON body sent to an external classifier
+type ClassifierRequest struct {
+	PostID      uint   `json:"post_id"`
+	Anonymous   bool   `json:"anonymous"`
+	AuthorName  string `json:"author_name,omitempty"`
+	AuthorEmail string `json:"author_email,omitempty"`
+	Content     string `json:"content"`
+	IP          string `json:"ip"`
+	UserAgent   string `json:"user_agent,omitempty"`
+}
+
+// ClassifierResponse is the JSON body an external classifier answers with
+type ClassifierResponse struct {
+	SpamProbability float64 `json:"spam_probability"` // 0 (ham) to 1 (spam)
+	Reason          string  `json:"reason,omitempty"`
+}
+
+// ClassifierScorer asks an external HTTP service to rate the comment
+type ClassifierScorer struct {
+	url    string
+	client *http.Client
+}
+
+// NewClassifierScorer creates a ClassifierScorer posting to the URL
+func NewClassifierScorer(url string, timeout time.Duration) *ClassifierScorer {
+	return &ClassifierScorer{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Name identifies the scorer
+func (s *ClassifierScorer) Name() string { return "classifier" }
+
+// Score rates the submission
+func (s *ClassifierScorer) Score(ctx context.Context, sub *Submission) (Result, error) {
+	body, err := json.Marshal(ClassifierRequest{
+		PostID:      sub.PostID,
+		Anonymous:   sub.UserID == nil,
+		AuthorName:  sub.AuthorName,
+		AuthorEmail: sub.AuthorEmail,
+		Content:     sub.Content,
+		IP:          sub.IP,
+		UserAgent:   sub.UserAgent,
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return Result{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return Result{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Result{}, fmt.Errorf("classifier returned %s", resp.Status)
+	}
+
+	var answer ClassifierResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&answer); err != nil {
+		return Result{}, fmt.Errorf("decoding classifier response: %w", err)
+	}
+	if answer.SpamProbability < 0 || answer.SpamProbability > 1 {
+		return Result{}, fmt.Errorf("classifier returned probability %v", answer.SpamProbability)
+	}
+
+	return Result{Score: answer.SpamProbability * classifierWeight, Reason: answer.Reason}, nil
+}
@@ -0,0 +1,203 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Scores added by the built-in scorers. With the default thresholds (hold at
+// 1, spam at 2) a filled honeypot, a blocked term or a bot-speed submission
+// is spam on its own, and a duplicate or an anonymous comment posted without
+// the form is held.
+const (
+	honeypotScore     = 3.0
+	blocklistScore    = 2.0
+	linkScore         = 0.5 // Per link above the limit
+	submitTimeScore   = 2.0
+	missingTokenScore = 1.0
+	duplicateScore    = 1.5
+)
+
+// linkPattern matches URLs and bare www. hosts, capturing the host
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)([a-z0-9][a-z0-9.-]*)`)
+
+// Links returns the hosts of the links in a text, lowercased
+func Links(text string) []string {
+	matches := linkPattern.FindAllStringSubmatch(text, -1)
+	hosts := make([]string, len(matches))
+	for i, m := range matches {
+		hosts[i] = strings.TrimPrefix(strings.ToLower(m[1]), "www.")
+	}
+	return hosts
+}
+
+// HoneypotScorer flags submissions that fill in the hidden honeypot field
+type HoneypotScorer struct{}
+
+// NewHoneypotScorer creates a new HoneypotScorer
+func NewHoneypotScorer() *HoneypotScorer {
+	return &HoneypotScorer{}
+}
+
+// Name identifies the scorer
+func (s *HoneypotScorer) Name() string { return "honeypot" }
+
+// Score rates the submission
+func (s *HoneypotScorer) Score(ctx context.Context, sub *Submission) (Result, error) {
+	if strings.TrimSpace(sub.Honeypot) == "" {
+		return Result{}, nil
+	}
+	return Result{Score: honeypotScore, Reason: "honeypot field filled in"}, nil
+}
+
+// BlocklistScorer flags blocked words in the comment or author name, and
+// links or author emails on blocked domains
+type BlocklistScorer struct {
+	words   []string
+	domains []string
+}
+
+// NewBlocklistScorer creates a new BlocklistScorer. Matching is case-insensitive.
+func NewBlocklistScorer(words, domains []string) *BlocklistScorer {
+	s := &BlocklistScorer{}
+	for _, w := range words {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			s.words = append(s.words, w)
+		}
+	}
+	for _, d := range domains {
+		if d = strings.ToLower(strings.Trim(strings.TrimSpace(d), ".")); d != "" {
+			s.domains = append(s.domains, d)
+		}
+	}
+	return s
+}
+
+// Name identifies the scorer
+func (s *BlocklistScorer) Name() string { return "blocklist" }
+
+// Score rates the submission
+func (s *BlocklistScorer) Score(ctx context.Context, sub *Submission) (Result, error) {
+	text := strings.ToLower(sub.Content + "\n" + sub.AuthorName)
+	for _, word := range s.words {
+		if strings.Contains(text, word) {
+			return Result{Score: blocklistScore, Reason: fmt.Sprintf("blocked word %q", word)}, nil
+		}
+	}
+
+	hosts := Links(sub.Content)
+	if at := strings.LastIndex(sub.AuthorEmail, "@"); at >= 0 {
+		hosts = append(hosts, strings.ToLower(sub.AuthorEmail[at+1:]))
+	}
+	for _, host := range hosts {
+		for _, domain := range s.domains {
+			if host == domain || strings.HasSuffix(host, "."+domain) {
+				return Result{Score: blocklistScore, Reason: fmt.Sprintf("blocked domain %q", domain)}, nil
+			}
+		}
+	}
+
+	return Result{}, nil
+}
+
+// LinkScorer flags comments with more links than allowed
+type LinkScorer struct {
+	maxLinks int
+}
+
+// NewLinkScorer creates a new LinkScorer
+func NewLinkScorer(maxLinks int) *LinkScorer {
+	return &LinkScorer{maxLinks: maxLinks}
+}
+
+// Name identifies the scorer
+func (s *LinkScorer) Name() string { return "links" }
+
+// Score rates the submission
+func (s *LinkScorer) Score(ctx context.Context, sub *Submission) (Result, error) {
+	count := len(Links(sub.Content))
+	if count <= s.maxLinks {
+		return Result{}, nil
+	}
+	return Result{
+		Score:  float64(count-s.maxLinks) * linkScore,
+		Reason: fmt.Sprintf("%d links", count),
+	}, nil
+}
+
+// SubmitTimeScorer flags comments submitted faster than a person could write
+// them, with a forged form token, or anonymously without one. Signed-in users
+// and API clients may post without a token; edits and stale tokens are not
+// scored.
+type SubmitTimeScorer struct {
+	tokens  *FormTokens
+	minTime time.Duration
+}
+
+// NewSubmitTimeScorer creates a new SubmitTimeScorer
+func NewSubmitTimeScorer(tokens *FormTokens, minTime time.Duration) *SubmitTimeScorer {
+	return &SubmitTimeScorer{tokens: tokens, minTime: minTime}
+}
+
+// Name identifies the scorer
+func (s *SubmitTimeScorer) Name() string { return "submit_time" }
+
+// Score rates the submission
+func (s *SubmitTimeScorer) Score(ctx context.Context, sub *Submission) (Result, error) {
+	if sub.Edit {
+		return Result{}, nil
+	}
+	if sub.FormToken == "" {
+		if sub.UserID != nil {
+			return Result{}, nil
+		}
+		return Result{Score: missingTokenScore, Reason: "missing form token"}, nil
+	}
+
+	issuedAt, err := s.tokens.Verify(sub.FormToken, sub.SubmittedAt)
+	switch {
+	case err == ErrFormTokenExpired:
+		return Result{}, nil
+	case err != nil:
+		return Result{Score: submitTimeScore, Reason: "invalid form token"}, nil
+	}
+
+	if elapsed := sub.SubmittedAt.Sub(issuedAt); elapsed < s.minTime {
+		return Result{Score: submitTimeScore, Reason: fmt.Sprintf("submitted after %s", elapsed.Round(time.Millisecond))}, nil
+	}
+	return Result{}, nil
+}
+
+// DuplicateFinder counts earlier comments with the same content
+type DuplicateFinder interface {
+	CountRecentByContent(ctx context.Context, content string, since time.Time) (int64, error)
+}
+
+// DuplicateScorer flags content that was already posted recently, by anyone
+type DuplicateScorer struct {
+	finder DuplicateFinder
+	window time.Duration
+}
+
+// NewDuplicateScorer creates a new DuplicateScorer
+func NewDuplicateScorer(finder DuplicateFinder, window time.Duration) *DuplicateScorer {
+	return &DuplicateScorer{finder: finder, window: window}
+}
+
+// Name identifies the scorer
+func (s *DuplicateScorer) Name() string { return "duplicate" }
+
+// Score rates the submission
+func (s *DuplicateScorer) Score(ctx context.Context, sub *Submission) (Result, error) {
+	count, err := s.finder.CountRecentByContent(ctx, strings.TrimSpace(sub.Content), sub.SubmittedAt.Add(-s.window))
+	if err != nil {
+		return Result{}, err
+	}
+	if count == 0 {
+		return Result{}, nil
+	}
+	return Result{Score: duplicateScore, Reason: fmt.Sprintf("posted %d times before", count)}, nil
+}
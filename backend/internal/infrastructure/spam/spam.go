@@ -0,0 +1,161 @@
+package spam
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// Decision is the outcome of a spam check
+type Decision string
+
+const (
+	DecisionAllow Decision = "allow" // Publish, subject to the moderation hold rules
+	DecisionHold  Decision = "hold"  // Keep pending for review
+	DecisionSpam  Decision = "spam"  // File as spam
+)
+
+// Submission is a comment being checked
+type Submission struct {
+	PostID      uint
+	UserID      *uint // nil for anonymous comments
+	AuthorName  string
+	AuthorEmail string
+	Content     string
+	IP          string
+	UserAgent   string
+	Honeypot    string // Hidden form field that people leave empty
+	FormToken   string // Issued with the comment form, see FormTokens
+	Edit        bool   // An edit of an existing comment, sent without a form token
+	SubmittedAt time.Time
+}
+
+// Result is the score one scorer gave a submission
+type Result struct {
+	Scorer string  `json:"scorer"`
+	Score  float64 `json:"score"`
+	Reason string  `json:"reason,omitempty"`
+}
+
+// Scorer rates one aspect of a submission. A score of 0 means nothing
+// suspicious was found.
+type Scorer interface {
+	// Name identifies the scorer in results and logs
+	Name() string
+
+	// Score rates the submission
+	Score(ctx context.Context, sub *Submission) (Result, error)
+}
+
+// Verdict is the combined result of every scorer
+type Verdict struct {
+	Decision Decision
+	Score    float64
+	Results  []Result // Non-zero results only
+}
+
+// Reasons lists the scorers that contributed to the verdict
+func (v Verdict) Reasons() []string {
+	reasons := make([]string, len(v.Results))
+	for i, r := range v.Results {
+		reasons[i] = r.Scorer
+	}
+	return reasons
+}
+
+// Checker decides whether a comment is spam
+type Checker interface {
+	Check(ctx context.Context, sub *Submission) Verdict
+}
+
+// Pipeline runs scorers in sequence and adds up their scores. It stops early
+// once a submission is certain to be spam, so expensive scorers go last.
+// Scorer errors are logged and skipped: a failing check never blocks comments.
+type Pipeline struct {
+	scorers   []Scorer
+	holdScore float64
+	spamScore float64
+	logger    *zap.Logger
+}
+
+// NewPipeline creates a Pipeline with the given scorers and thresholds
+func NewPipeline(logger *zap.Logger, holdScore, spamScore float64, scorers ...Scorer) *Pipeline {
+	return &Pipeline{
+		scorers:   scorers,
+		holdScore: holdScore,
+		spamScore: spamScore,
+		logger:    logger,
+	}
+}
+
+// NewChecker creates the Checker configured by SPAM_* settings
+func NewChecker(cfg config.SpamConfig, finder DuplicateFinder, tokens *FormTokens, logger *zap.Logger) Checker {
+	if !cfg.Enabled {
+		return NewPipeline(logger, cfg.HoldScore, cfg.SpamScore)
+	}
+
+	scorers := []Scorer{
+		NewHoneypotScorer(),
+		NewBlocklistScorer(cfg.BlockedWords, cfg.BlockedDomains),
+		NewLinkScorer(cfg.MaxLinks),
+		NewSubmitTimeScorer(tokens, cfg.MinSubmitTime),
+		NewDuplicateScorer(finder, cfg.DuplicateWindow),
+	}
+	if cfg.ClassifierURL != "" {
+		scorers = append(scorers, NewClassifierScorer(cfg.ClassifierURL, cfg.ClassifierTimeout))
+	}
+	return NewPipeline(logger, cfg.HoldScore, cfg.SpamScore, scorers...)
+}
+
+// Check scores the submission and logs the decision for tuning the thresholds
+func (p *Pipeline) Check(ctx context.Context, sub *Submission) Verdict {
+	verdict := Verdict{Decision: DecisionAllow}
+
+	for _, scorer := range p.scorers {
+		if verdict.Score >= p.spamScore {
+			break
+		}
+		result, err := scorer.Score(ctx, sub)
+		if err != nil {
+			p.logger.Warn("Spam scorer failed",
+				zap.String("scorer", scorer.Name()),
+				zap.Uint("post_id", sub.PostID),
+				zap.Error(err),
+			)
+			continue
+		}
+		if result.Score == 0 {
+			continue
+		}
+		result.Scorer = scorer.Name()
+		verdict.Score += result.Score
+		verdict.Results = append(verdict.Results, result)
+	}
+
+	switch {
+	case len(p.scorers) == 0:
+		// Spam checks are disabled
+	case verdict.Score >= p.spamScore:
+		verdict.Decision = DecisionSpam
+	case verdict.Score >= p.holdScore:
+		verdict.Decision = DecisionHold
+	}
+
+	fields := []zap.Field{
+		zap.String("decision", string(verdict.Decision)),
+		zap.Float64("score", verdict.Score),
+		zap.Any("results", verdict.Results),
+		zap.Uint("post_id", sub.PostID),
+		zap.Bool("anonymous", sub.UserID == nil),
+		zap.String("ip", utils.HashIP(sub.IP)),
+	}
+	if sub.UserID != nil {
+		fields = append(fields, zap.Uint("user_id", *sub.UserID))
+	}
+	p.logger.Info("Comment spam check", fields...)
+
+	return verdict
+}
@@ -0,0 +1,142 @@
+package spam_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/yourusername/viblog/internal/infrastructure/spam"
+	"go.uber.org/zap"
+)
+
+type fakeFinder struct {
+	count int64
+	err   error
+}
+
+func (f fakeFinder) CountRecentByContent(ctx context.Context, content string, since time.Time) (int64, error) {
+	return f.count, f.err
+}
+
+func TestScorers(t *testing.T) {
+	now := time.Now()
+	tokens, err := spam.NewFormTokens("secret", time.Hour)
+	if err != nil {
+		t.Fatalf("NewFormTokens failed: %v", err)
+	}
+	userID := uint(1)
+
+	tests := []struct {
+		name   string
+		scorer spam.Scorer
+		sub    spam.Submission
+		flag   bool
+	}{
+		{"honeypot empty", spam.NewHoneypotScorer(), spam.Submission{}, false},
+		{"honeypot filled", spam.NewHoneypotScorer(), spam.Submission{Honeypot: "http://seo.example"}, true},
+		{"blocked word", spam.NewBlocklistScorer([]string{"Casino"}, nil), spam.Submission{Content: "Best CASINO bonus"}, true},
+		{"blocked domain link", spam.NewBlocklistScorer(nil, []string{"spam.example"}), spam.Submission{Content: "see https://www.shop.spam.example/x"}, true},
+		{"blocked email domain", spam.NewBlocklistScorer(nil, []string{"spam.example"}), spam.Submission{AuthorEmail: "a@spam.example"}, true},
+		{"similar domain allowed", spam.NewBlocklistScorer(nil, []string{"spam.example"}), spam.Submission{Content: "https://notspam.example"}, false},
+		{"links within limit", spam.NewLinkScorer(2), spam.Submission{Content: "https://a.example www.b.example"}, false},
+		{"too many links", spam.NewLinkScorer(1), spam.Submission{Content: "https://a.example www.b.example"}, true},
+		{"no form token, anonymous", spam.NewSubmitTimeScorer(tokens, 3*time.Second), spam.Submission{SubmittedAt: now}, true},
+		{"no form token, signed in", spam.NewSubmitTimeScorer(tokens, 3*time.Second), spam.Submission{UserID: &userID, SubmittedAt: now}, false},
+		{"no form token, edit", spam.NewSubmitTimeScorer(tokens, 3*time.Second), spam.Submission{Edit: true, SubmittedAt: now}, false},
+		{"slow enough", spam.NewSubmitTimeScorer(tokens, 3*time.Second), spam.Submission{FormToken: tokens.Issue(now.Add(-time.Minute)), SubmittedAt: now}, false},
+		{"too fast", spam.NewSubmitTimeScorer(tokens, 3*time.Second), spam.Submission{FormToken: tokens.Issue(now.Add(-time.Second)), SubmittedAt: now}, true},
+		{"forged token", spam.NewSubmitTimeScorer(tokens, 3*time.Second), spam.Submission{FormToken: "1.forged", SubmittedAt: now}, true},
+		{"expired token", spam.NewSubmitTimeScorer(tokens, 3*time.Second), spam.Submission{FormToken: tokens.Issue(now.Add(-2 * time.Hour)), SubmittedAt: now}, false},
+		{"unique content", spam.NewDuplicateScorer(fakeFinder{}, time.Hour), spam.Submission{Content: "hi", SubmittedAt: now}, false},
+		{"duplicate content", spam.NewDuplicateScorer(fakeFinder{count: 2}, time.Hour), spam.Submission{Content: "hi", SubmittedAt: now}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.scorer.Score(context.Background(), &tt.sub)
+			if err != nil {
+				t.Fatalf("Score failed: %v", err)
+			}
+			if flagged := result.Score > 0; flagged != tt.flag {
+				t.Errorf("Expected flagged=%v, got score %v (%s)", tt.flag, result.Score, result.Reason)
+			}
+		})
+	}
+}
+
+func TestPipeline_Decisions(t *testing.T) {
+	logger := zap.NewNop()
+	pipeline := spam.NewPipeline(logger, 1, 2,
+		spam.NewHoneypotScorer(),
+		spam.NewLinkScorer(2),
+		spam.NewDuplicateScorer(fakeFinder{err: errors.New("db down")}, time.Hour),
+	)
+	ctx := context.Background()
+
+	verdict := pipeline.Check(ctx, &spam.Submission{Content: "Nice post"})
+	if verdict.Decision != spam.DecisionAllow || verdict.Score != 0 {
+		t.Errorf("Expected allow, got %+v", verdict)
+	}
+
+	// Four links over the limit of two add 1.0; the failing scorer is skipped
+	verdict = pipeline.Check(ctx, &spam.Submission{Content: "www.a.example www.b.example www.c.example www.d.example"})
+	if verdict.Decision != spam.DecisionHold {
+		t.Errorf("Expected hold, got %+v", verdict)
+	}
+	if reasons := verdict.Reasons(); len(reasons) != 1 || reasons[0] != "links" {
+		t.Errorf("Expected links to be the only reason, got %v", reasons)
+	}
+
+	verdict = pipeline.Check(ctx, &spam.Submission{Content: "Nice post", Honeypot: "x"})
+	if verdict.Decision != spam.DecisionSpam {
+		t.Errorf("Expected spam, got %+v", verdict)
+	}
+
+	disabled := spam.NewPipeline(logger, 1, 2)
+	if verdict := disabled.Check(ctx, &spam.Submission{Honeypot: "x"}); verdict.Decision != spam.DecisionAllow {
+		t.Errorf("Expected a pipeline without scorers to allow everything, got %+v", verdict)
+	}
+}
+
+func TestClassifierScorer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req spam.ClassifierRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode classifier request: %v", err)
+		}
+		probability := 0.0
+		if req.Content == "buy now" {
+			probability = 0.9
+		}
+		json.NewEncoder(w).Encode(spam.ClassifierResponse{SpamProbability: probability, Reason: "model"})
+	}))
+	defer server.Close()
+
+	scorer := spam.NewClassifierScorer(server.URL, time.Second)
+	ctx := context.Background()
+
+	result, err := scorer.Score(ctx, &spam.Submission{Content: "buy now"})
+	if err != nil {
+		t.Fatalf("Score failed: %v", err)
+	}
+	if result.Score < 1.7 || result.Reason != "model" {
+		t.Errorf("Expected a high score from the classifier, got %+v", result)
+	}
+
+	result, err = scorer.Score(ctx, &spam.Submission{Content: "thanks"})
+	if err != nil {
+		t.Fatalf("Score failed: %v", err)
+	}
+	if result.Score != 0 {
+		t.Errorf("Expected ham to score 0, got %v", result.Score)
+	}
+
+	down := spam.NewClassifierScorer("http://127.0.0.1:1", time.Second)
+	if _, err := down.Score(ctx, &spam.Submission{Content: "x"}); err == nil {
+		t.Error("Expected an error when the classifier is unreachable")
+	}
+}
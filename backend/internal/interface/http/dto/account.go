@@ -0,0 +1,94 @@
+package dto
+
+import "time"
+
+// EmailRequest represents a request that only carries an email address
+type EmailRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// VerifyEmailRequest represents an email verification confirmation
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// ResetPasswordRequest represents a password reset completion
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=8"`
+}
+
+// DeleteAccountRequest represents a request to delete the current account
+type DeleteAccountRequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code"` // TOTP or recovery code, required when MFA is enabled
+}
+
+// DeleteAccountResponse represents a scheduled account deletion
+type DeleteAccountResponse struct {
+	Message             string    `json:"message"`
+	DeletionScheduledAt time.Time `json:"deletion_scheduled_at"`
+}
+
+// AccountExportResponse represents everything stored about the current user
+type AccountExportResponse struct {
+	ExportedAt    time.Time                    `json:"exported_at"`
+	Profile       ExportProfileResponse        `json:"profile"`
+	Comments      []ExportCommentResponse      `json:"comments"`
+	Likes         []ExportLikeResponse         `json:"likes"`
+	Bookmarks     []ExportBookmarkResponse     `json:"bookmarks"`
+	Notifications []ExportNotificationResponse `json:"notifications"`
+}
+
+// ExportProfileResponse represents the account in a data export
+type ExportProfileResponse struct {
+	ID                  uint       `json:"id"`
+	Email               string     `json:"email"`
+	EmailVerifiedAt     *time.Time `json:"email_verified_at,omitempty"`
+	Nickname            string     `json:"nickname"`
+	AvatarURL           *string    `json:"avatar_url,omitempty"`
+	Bio                 *string    `json:"bio,omitempty"`
+	Role                string     `json:"role"`
+	HideActivity        bool       `json:"hide_activity"`
+	MFAEnabledAt        *time.Time `json:"mfa_enabled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	LastLoginAt         *time.Time `json:"last_login_at,omitempty"`
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+}
+
+// ExportCommentResponse represents a comment in a data export
+type ExportCommentResponse struct {
+	ID        uint      `json:"id"`
+	PostID    uint      `json:"post_id"`
+	ParentID  *uint     `json:"parent_id,omitempty"`
+	Content   string    `json:"content"`
+	IsEdited  bool      `json:"is_edited"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ExportLikeResponse represents a post or comment like in a data export
+type ExportLikeResponse struct {
+	PostID    *uint     `json:"post_id,omitempty"`
+	CommentID *uint     `json:"comment_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportBookmarkResponse represents a bookmark in a data export
+type ExportBookmarkResponse struct {
+	PostID    uint      `json:"post_id"`
+	PostTitle string    `json:"post_title,omitempty"`
+	PostSlug  string    `json:"post_slug,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportNotificationResponse represents a notification in a data export
+type ExportNotificationResponse struct {
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	Message   string     `json:"message"`
+	Link      string     `json:"link,omitempty"`
+	IsRead    bool       `json:"is_read"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
@@ -1,11 +1,60 @@
 package dto
 
+import "time"
+
 // AdminDashboardResponse represents the admin dashboard response
 type AdminDashboardResponse struct {
 	TotalUsers     int64 `json:"total_users"`
 	TotalPosts     int64 `json:"total_posts"`
 	PublishedPosts int64 `json:"published_posts"`
 	TotalComments  int64 `json:"total_comments"`
+
+	// Period covered by the series and rankings
+	Days     int    `json:"days"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Timezone string `json:"timezone"` // Time zone the days are counted in
+
+	Series         DashboardSeriesResponse `json:"series"`
+	TopPosts       []DashboardPostResponse `json:"top_posts"`
+	TopTags        []DashboardTagResponse  `json:"top_tags"`
+	RecentComments []AdminCommentResponse  `json:"recent_comments"` // Latest comments and replies of any status
+}
+
+// DashboardPointResponse represents a metric's value on one day
+type DashboardPointResponse struct {
+	Date  string `json:"date"` // YYYY-MM-DD
+	Count int64  `json:"count"`
+}
+
+// DashboardSeriesResponse represents the daily series of the dashboard
+// period, with one point per day
+type DashboardSeriesResponse struct {
+	Views     []DashboardPointResponse `json:"views"`
+	Visitors  []DashboardPointResponse `json:"visitors"` // Unique visitors per post, summed
+	Likes     []DashboardPointResponse `json:"likes"`
+	Comments  []DashboardPointResponse `json:"comments"`
+	Bookmarks []DashboardPointResponse `json:"bookmarks"`
+	Signups   []DashboardPointResponse `json:"signups"`
+}
+
+// DashboardPostResponse represents a post's activity over the dashboard period
+type DashboardPostResponse struct {
+	ID       uint   `json:"id"`
+	Title    string `json:"title"`
+	Slug     string `json:"slug"`
+	Views    int64  `json:"views"`
+	Likes    int64  `json:"likes"`
+	Comments int64  `json:"comments"`
+}
+
+// DashboardTagResponse represents the activity on a tag's posts over the dashboard period
+type DashboardTagResponse struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Slug  string `json:"slug"`
+	Views int64  `json:"views"`
+	Posts int64  `json:"posts"` // Posts of the tag that were viewed
 }
 
 // AdminUserResponse represents a user in admin context
@@ -16,8 +65,33 @@ type AdminUserResponse struct {
 	AvatarURL   *string `json:"avatar_url,omitempty"`
 	Bio         *string `json:"bio,omitempty"`
 	IsAdmin     bool    `json:"is_admin"`
+	Role        string  `json:"role"`
 	CreatedAt   string  `json:"created_at"`
 	LastLoginAt *string `json:"last_login_at,omitempty"`
+
+	Status           string  `json:"status"` // active, suspended or banned
+	SuspendedUntil   *string `json:"suspended_until,omitempty"`
+	SuspensionReason *string `json:"suspension_reason,omitempty"`
+	BannedAt         *string `json:"banned_at,omitempty"`
+	BanReason        *string `json:"ban_reason,omitempty"`
+}
+
+// AdminUpdateUserRequest represents an admin edit of a user's profile.
+// An empty avatar_url removes the avatar.
+type AdminUpdateUserRequest struct {
+	Nickname  *string `json:"nickname,omitempty" binding:"omitempty,min=2,max=20"`
+	AvatarURL *string `json:"avatar_url,omitempty" binding:"omitempty,max=500"`
+}
+
+// SuspendUserRequest represents a request to suspend a user
+type SuspendUserRequest struct {
+	Reason string    `json:"reason" binding:"required,max=500"`
+	Until  time.Time `json:"until" binding:"required"` // RFC 3339
+}
+
+// BanUserRequest represents a request to ban a user
+type BanUserRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
 }
 
 // AdminUsersListResponse represents paginated users list response
@@ -29,6 +103,43 @@ type AdminUsersListResponse struct {
 	TotalPages int                 `json:"total_pages"`
 }
 
+// AdminPostResponse represents a post of any status in admin context
+type AdminPostResponse struct {
+	ID            uint                    `json:"id"`
+	Title         string                  `json:"title"`
+	Slug          string                  `json:"slug"`
+	Status        string                  `json:"status"`
+	PublishedAt   *string                 `json:"published_at,omitempty"` // Publication time, in the future for scheduled posts
+	AuthorID      uint                    `json:"author_id"`
+	AuthorName    *string                 `json:"author_name,omitempty"`
+	Category      *AdminPostTermResponse  `json:"category,omitempty"`
+	Tags          []AdminPostTermResponse `json:"tags"`
+	Locales       []string                `json:"locales"` // Locales the post is translated into
+	ViewCount     int                     `json:"view_count"`
+	LikeCount     int                     `json:"like_count"`
+	CommentCount  int                     `json:"comment_count"`
+	BookmarkCount int                     `json:"bookmark_count"`
+	CreatedAt     string                  `json:"created_at"`
+	UpdatedAt     string                  `json:"updated_at"`
+}
+
+// AdminPostTermResponse represents a post's category or tag
+type AdminPostTermResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// AdminPostsListResponse represents paginated posts list response
+type AdminPostsListResponse struct {
+	Posts        []AdminPostResponse `json:"posts"`
+	StatusCounts map[string]int64    `json:"status_counts"` // Posts per status across the whole site
+	Total        int64               `json:"total"`
+	Page         int                 `json:"page"`
+	Limit        int                 `json:"limit"`
+	TotalPages   int                 `json:"total_pages"`
+}
+
 // AdminCommentResponse represents a comment in admin context
 type AdminCommentResponse struct {
 	ID          uint    `json:"id"`
@@ -40,6 +151,13 @@ type AdminCommentResponse struct {
 	AuthorName  *string `json:"author_name,omitempty"`
 	AuthorEmail *string `json:"author_email,omitempty"`
 	ParentID    *uint   `json:"parent_id,omitempty"`
+	Status      string  `json:"status"`
+	ModeratedAt *string `json:"moderated_at,omitempty"`
+	ModeratedBy *uint   `json:"moderated_by,omitempty"`
+	SpamScore   float64 `json:"spam_score"`
+	SpamReasons *string `json:"spam_reasons,omitempty"` // Comma-separated spam checks that flagged the comment
+	PinnedAt    *string `json:"pinned_at,omitempty"`
+	AuthorIP    *string `json:"author_ip,omitempty"` // Commenter's network, e.g. 203.0.113.0/24, usable as an IP ban
 	LikeCount   int     `json:"like_count"`
 	IsEdited    bool    `json:"is_edited"`
 	CreatedAt   string  `json:"created_at"`
@@ -48,11 +166,108 @@ type AdminCommentResponse struct {
 
 // AdminCommentsListResponse represents paginated comments list response
 type AdminCommentsListResponse struct {
-	Comments   []AdminCommentResponse `json:"comments"`
-	Total      int64                  `json:"total"`
-	Page       int                    `json:"page"`
-	Limit      int                    `json:"limit"`
-	TotalPages int                    `json:"total_pages"`
+	Comments     []AdminCommentResponse `json:"comments"`
+	StatusCounts map[string]int64       `json:"status_counts"` // Comments per status across the whole site
+	Total        int64                  `json:"total"`
+	Page         int                    `json:"page"`
+	Limit        int                    `json:"limit"`
+	TotalPages   int                    `json:"total_pages"`
+}
+
+// ModerateCommentsRequest represents a bulk moderation request
+type ModerateCommentsRequest struct {
+	CommentIDs []uint `json:"comment_ids" binding:"required,min=1,max=100,dive,min=1"`
+	Status     string `json:"status" binding:"required,oneof=approved spam rejected"`
+}
+
+// ModerateCommentsResponse represents the comments changed by a moderation request
+type ModerateCommentsResponse struct {
+	Comments []AdminCommentResponse `json:"comments"`
+	Changed  int                    `json:"changed"`
+}
+
+// AdminCommentReportResponse represents a report in the admin inbox
+type AdminCommentReportResponse struct {
+	ID           uint                  `json:"id"`
+	Reason       string                `json:"reason"`
+	Details      *string               `json:"details,omitempty"`
+	Status       string                `json:"status"`
+	ReporterID   *uint                 `json:"reporter_id,omitempty"`
+	ReporterName *string               `json:"reporter_name,omitempty"` // Empty for anonymous readers
+	Comment      *AdminCommentResponse `json:"comment,omitempty"`
+	ResolvedAt   *string               `json:"resolved_at,omitempty"`
+	ResolvedBy   *uint                 `json:"resolved_by,omitempty"`
+	CreatedAt    string                `json:"created_at"`
+}
+
+// AdminCommentReportsListResponse represents paginated comment reports list response
+type AdminCommentReportsListResponse struct {
+	Reports      []AdminCommentReportResponse `json:"reports"`
+	StatusCounts map[string]int64             `json:"status_counts"` // Reports per status across the whole site
+	Total        int64                        `json:"total"`
+	Page         int                          `json:"page"`
+	Limit        int                          `json:"limit"`
+	TotalPages   int                          `json:"total_pages"`
+}
+
+// AdminCommentRevisionResponse represents one edit of a comment
+type AdminCommentRevisionResponse struct {
+	ID              uint    `json:"id"`
+	PreviousContent string  `json:"previous_content"`
+	Content         string  `json:"content"`
+	EditorID        *uint   `json:"editor_id,omitempty"`
+	EditorName      *string `json:"editor_name,omitempty"`
+	EditorIP        *string `json:"editor_ip,omitempty"` // Anonymous editor's network, e.g. 203.0.113.0/24
+	IsAnonymous     bool    `json:"is_anonymous"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+// AdminCommentHistoryResponse represents a comment with its edit history
+type AdminCommentHistoryResponse struct {
+	Comment   AdminCommentResponse           `json:"comment"`
+	Revisions []AdminCommentRevisionResponse `json:"revisions"` // Oldest first
+}
+
+// ResolveCommentReportRequest represents an admin decision on a report
+type ResolveCommentReportRequest struct {
+	Action string `json:"action" binding:"required,oneof=dismiss delete"`
+}
+
+// ResolveCommentReportResponse represents the reports closed by a decision
+type ResolveCommentReportResponse struct {
+	Action string `json:"action"`
+	Closed int64  `json:"closed"` // Open reports of the same comment closed together
+}
+
+// CreateBanRequest represents a request to ban an IP network, email address or user
+type CreateBanRequest struct {
+	Type      string     `json:"type" binding:"required,oneof=ip email user"`
+	Value     string     `json:"value" binding:"required,max=255"` // IP or CIDR, email address, or user ID
+	Reason    string     `json:"reason" binding:"max=500"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"` // RFC 3339; omit for a permanent ban
+}
+
+// BanResponse represents a ban. Email bans only show the address hash.
+type BanResponse struct {
+	ID        uint    `json:"id"`
+	Type      string  `json:"type"`
+	Value     string  `json:"value"`
+	Reason    string  `json:"reason"`
+	Active    bool    `json:"active"`
+	ExpiresAt *string `json:"expires_at,omitempty"`
+	CreatedBy *uint   `json:"created_by,omitempty"`
+	HitCount  int     `json:"hit_count"`
+	LastHitAt *string `json:"last_hit_at,omitempty"`
+	CreatedAt string  `json:"created_at"`
+}
+
+// BansListResponse represents paginated bans list response
+type BansListResponse struct {
+	Bans       []BanResponse `json:"bans"`
+	Total      int64         `json:"total"`
+	Page       int           `json:"page"`
+	Limit      int           `json:"limit"`
+	TotalPages int           `json:"total_pages"`
 }
 
 // CreateCategoryRequest represents a category creation request
@@ -114,3 +329,39 @@ type TagsListResponse struct {
 type MessageResponse struct {
 	Message string `json:"message"`
 }
+
+// AdminLockoutResponse represents a failed login throttle
+type AdminLockoutResponse struct {
+	ID            uint    `json:"id"`
+	Scope         string  `json:"scope"`
+	Identifier    string  `json:"identifier"`
+	Failures      int     `json:"failures"`
+	Locked        bool    `json:"locked"`
+	LastFailureAt string  `json:"last_failure_at"`
+	LockedUntil   *string `json:"locked_until,omitempty"`
+}
+
+// AdminLockoutsListResponse represents paginated login throttles list response
+type AdminLockoutsListResponse struct {
+	Lockouts   []AdminLockoutResponse `json:"lockouts"`
+	Total      int64                  `json:"total"`
+	Page       int                    `json:"page"`
+	Limit      int                    `json:"limit"`
+	TotalPages int                    `json:"total_pages"`
+}
+
+// RoleResponse represents a role and the permissions it grants
+type RoleResponse struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// RolesListResponse represents the list of assignable roles
+type RolesListResponse struct {
+	Roles []RoleResponse `json:"roles"`
+}
+
+// AssignRoleRequest represents a role assignment request
+type AssignRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
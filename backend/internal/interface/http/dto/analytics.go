@@ -0,0 +1,39 @@
+package dto
+
+// AnalyticsRollupRequest represents an on-demand rollup of past days
+type AnalyticsRollupRequest struct {
+	From string `json:"from" binding:"required,datetime=2006-01-02"` // First day, YYYY-MM-DD in the server time zone
+	To   string `json:"to" binding:"required,datetime=2006-01-02"`   // Last day, up to today
+}
+
+// AnalyticsRollupResponse represents a finished rollup
+type AnalyticsRollupResponse struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Days int    `json:"days"` // Days rolled up
+}
+
+// TrafficBreakdownResponse represents where views came from over a period
+type TrafficBreakdownResponse struct {
+	PostID   *uint  `json:"post_id,omitempty"` // Absent for the whole site
+	Days     int    `json:"days"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Timezone string `json:"timezone"` // Time zone the days are counted in
+
+	Dimensions []DimensionBreakdownResponse `json:"dimensions"`
+}
+
+// DimensionBreakdownResponse represents the top values of one dimension
+type DimensionBreakdownResponse struct {
+	Dimension string              `json:"dimension"` // referrer, utm_source, utm_medium, utm_campaign, device, browser, os or country
+	Total     int64               `json:"total"`     // Views of all values
+	Values    []ViewCountResponse `json:"values"`
+	Other     int64               `json:"other"` // Views of the values beyond the top ones
+}
+
+// ViewCountResponse represents the views sharing a value
+type ViewCountResponse struct {
+	Value string `json:"value"` // "" for direct visits and unknown values
+	Views int64  `json:"views"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import "encoding/json"
+
+// AuditLogResponse represents an audit log entry
+type AuditLogResponse struct {
+	ID         uint            `json:"id"`
+	ActorID    uint            `json:"actor_id"`
+	ActorName  string          `json:"actor_name,omitempty"`
+	ActorEmail string          `json:"actor_email,omitempty"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   *uint           `json:"target_id,omitempty"`
+	Before     json.RawMessage `json:"before,omitempty" swaggertype:"object"` // Target before the action; absent when it did not exist
+	After      json.RawMessage `json:"after,omitempty" swaggertype:"object"`  // Target after the action, or the request when no target could be loaded; absent when it was deleted
+	Method     string          `json:"method"`
+	Path       string          `json:"path"`
+	Status     int             `json:"status"`
+	IPAddress  string          `json:"ip_address"`
+	RequestID  string          `json:"request_id"`
+	CreatedAt  string          `json:"created_at"`
+}
+
+// AuditLogListResponse represents paginated audit log response
+type AuditLogListResponse struct {
+	Entries    []AuditLogResponse `json:"entries"`
+	Total      int64              `json:"total"`
+	Page       int                `json:"page"`
+	Limit      int                `json:"limit"`
+	TotalPages int                `json:"total_pages"`
+}
@@ -1 +1,90 @@
 package dto
+
+import "time"
+
+// CreateCommentRequest represents a comment or reply creation request.
+// Anonymous visitors must give a name and a password to manage the comment later.
+type CreateCommentRequest struct {
+	Content        string `json:"content" binding:"required,max=5000"` // Markdown: emphasis, code, links and @mentions
+	AuthorName     string `json:"author_name,omitempty" binding:"omitempty,max=100"`
+	AuthorEmail    string `json:"author_email,omitempty" binding:"omitempty,max=255"`
+	AuthorPassword string `json:"author_password,omitempty" binding:"omitempty,min=4,max=72"`
+	NotifyReplies  bool   `json:"notify_replies,omitempty"` // Anonymous only: email author_email about replies once confirmed
+
+	// Spam protection: website is a honeypot that must stay empty (hide it
+	// from people), and form_token comes from GET /comments/form-token.
+	// Anonymous comments without a form_token are held for review.
+	Website   string `json:"website,omitempty"`
+	FormToken string `json:"form_token,omitempty"`
+}
+
+// UpdateCommentRequest represents an edit of a comment. Anonymous authors
+// must give the password the comment was posted with.
+type UpdateCommentRequest struct {
+	Content        string `json:"content" binding:"required,max=5000"`
+	AuthorPassword string `json:"author_password,omitempty" binding:"omitempty,max=72"`
+}
+
+// CommentFormTokenResponse represents a token to submit with the comment form
+type CommentFormTokenResponse struct {
+	FormToken string `json:"form_token"`
+}
+
+// CommentAuthorResponse represents the author of a comment
+type CommentAuthorResponse struct {
+	UserID       *uint   `json:"user_id,omitempty"`
+	Name         string  `json:"name"`
+	AvatarURL    *string `json:"avatar_url,omitempty"`
+	IsAnonymous  bool    `json:"is_anonymous"`
+	IsPostAuthor bool    `json:"is_post_author"` // Written by the author of the post
+	IsAdmin      bool    `json:"is_admin"`       // Written by an admin
+}
+
+// CommentResponse represents a public comment
+type CommentResponse struct {
+	ID          uint                  `json:"id"`
+	PostID      uint                  `json:"post_id"`
+	ParentID    *uint                 `json:"parent_id,omitempty"`
+	Content     string                `json:"content"`      // Markdown as written
+	ContentHTML string                `json:"content_html"` // Sanitized HTML to display
+	Author      CommentAuthorResponse `json:"author"`
+	Status      string                `json:"status"` // pending until approved when held for moderation
+	LikeCount   int                   `json:"like_count"`
+	IsEdited    bool                  `json:"is_edited"`
+	IsPinned    bool                  `json:"is_pinned"`
+	ReplyCount  int64                 `json:"reply_count"` // Approved replies, for collapsed threads
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
+// CommentsListResponse represents a list of comments
+type CommentsListResponse struct {
+	Comments []CommentResponse `json:"comments"`
+	Total    int               `json:"total"`
+}
+
+// CommentSubscriptionTokenRequest represents a token from a reply
+// notification email
+type CommentSubscriptionTokenRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// CommentSubscriptionResponse represents a confirmed reply notification subscription
+type CommentSubscriptionResponse struct {
+	CommentID   uint      `json:"comment_id"`
+	Email       string    `json:"email"`
+	ConfirmedAt time.Time `json:"confirmed_at"`
+}
+
+// ReportCommentRequest represents a reader's report of a comment
+type ReportCommentRequest struct {
+	Reason  string `json:"reason" binding:"required,oneof=spam harassment hate off_topic other"`
+	Details string `json:"details,omitempty" binding:"omitempty,max=500"`
+}
+
+// ReportCommentResponse represents an accepted report
+type ReportCommentResponse struct {
+	ID     uint   `json:"id"`
+	Reason string `json:"reason"`
+	Hidden bool   `json:"hidden"` // The comment was hidden pending review
+}
@@ -0,0 +1,46 @@
+package dto
+
+import "time"
+
+// MFAChallengeResponse is returned instead of tokens when a login still
+// needs a second factor
+type MFAChallengeResponse struct {
+	MFARequired bool   `json:"mfa_required"`
+	MFAToken    string `json:"mfa_token"`
+}
+
+// MFALoginRequest represents the second step of a login
+type MFALoginRequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"` // TOTP code or recovery code
+}
+
+// MFACodeRequest carries a TOTP or recovery code for the current user
+type MFACodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// MFAStatusResponse describes the current user's two-factor setup
+type MFAStatusResponse struct {
+	Enabled                bool       `json:"enabled"`
+	EnabledAt              *time.Time `json:"enabled_at,omitempty"`
+	RecoveryCodesRemaining int64      `json:"recovery_codes_remaining"`
+}
+
+// TOTPEnrollmentResponse carries a new TOTP secret for an authenticator app
+type TOTPEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+// RecoveryCodesResponse carries recovery codes, which are shown only once
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// MFAEnabledResponse is returned when two-factor authentication is turned on.
+// It carries fresh tokens because enabling revokes every existing session.
+type MFAEnabledResponse struct {
+	AuthResponse
+	RecoveryCodes []string `json:"recovery_codes"`
+}
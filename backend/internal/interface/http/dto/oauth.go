@@ -0,0 +1,17 @@
+package dto
+
+// OAuthProvidersResponse lists the social login providers that are enabled
+type OAuthProvidersResponse struct {
+	Providers []string `json:"providers"`
+}
+
+// OAuthAuthorizeResponse carries the provider consent URL
+type OAuthAuthorizeResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+}
+
+// OAuthCallbackRequest carries the parameters the provider sent to the redirect URL
+type OAuthCallbackRequest struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+}
@@ -71,6 +71,8 @@ type PostResponse struct {
 	Tags            []TagResponse         `json:"tags,omitempty"`
 	IsLiked         bool                  `json:"is_liked,omitempty"`         // Whether current user liked the post
 	IsBookmarked    bool                  `json:"is_bookmarked,omitempty"`    // Whether current user bookmarked the post
+	Locale          string                `json:"locale,omitempty"`            // Locale the content is served in
+	AvailableLocales []string             `json:"available_locales,omitempty"` // Locales with a translation, for hreflang links
 }
 
 // PostListResponse represents a paginated list of posts
@@ -93,3 +95,15 @@ type PaginationResponse struct {
 	Total      int64 `json:"total"`
 	TotalPages int   `json:"total_pages"`
 }
+
+// RecordViewRequest represents a post view reported by the post page. Both
+// fields default to the Referer header.
+type RecordViewRequest struct {
+	Referrer string `json:"referrer" binding:"omitempty,max=2000"` // document.referrer of the post page
+	URL      string `json:"url" binding:"omitempty,max=2000"`      // Post page URL, carrying any UTM parameters
+}
+
+// RecordViewResponse reports whether a view was counted
+type RecordViewResponse struct {
+	Counted bool `json:"counted"` // False for repeat views within 24 hours and bots
+}
@@ -0,0 +1,56 @@
+package dto
+
+// UpsertPostTranslationRequest represents a post translation save request
+type UpsertPostTranslationRequest struct {
+	Title           string  `json:"title" binding:"required,min=1,max=255"`
+	Excerpt         string  `json:"excerpt"`
+	Content         string  `json:"content" binding:"required"`
+	MetaTitle       *string `json:"meta_title,omitempty" binding:"omitempty,max=255"`
+	MetaDescription *string `json:"meta_description,omitempty" binding:"omitempty,max=500"`
+	MetaKeywords    *string `json:"meta_keywords,omitempty" binding:"omitempty,max=255"`
+}
+
+// PostTranslationResponse represents a post translation response
+type PostTranslationResponse struct {
+	PostID          uint    `json:"post_id"`
+	Locale          string  `json:"locale"`
+	Title           string  `json:"title"`
+	Excerpt         string  `json:"excerpt,omitempty"`
+	Content         string  `json:"content"`
+	MetaTitle       *string `json:"meta_title,omitempty"`
+	MetaDescription *string `json:"meta_description,omitempty"`
+	MetaKeywords    *string `json:"meta_keywords,omitempty"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+// PostTranslationsListResponse represents the translations of a post
+type PostTranslationsListResponse struct {
+	Translations []PostTranslationResponse `json:"translations"`
+	Total        int                       `json:"total"`
+}
+
+// UpsertCategoryTranslationRequest represents a category translation save request
+type UpsertCategoryTranslationRequest struct {
+	Name        string  `json:"name" binding:"required,min=1,max=100"`
+	Description *string `json:"description,omitempty"`
+}
+
+// CategoryTranslationResponse represents a category translation response
+type CategoryTranslationResponse struct {
+	CategoryID  uint    `json:"category_id"`
+	Locale      string  `json:"locale"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+}
+
+// UpsertTagTranslationRequest represents a tag translation save request
+type UpsertTagTranslationRequest struct {
+	Name string `json:"name" binding:"required,min=1,max=50"`
+}
+
+// TagTranslationResponse represents a tag translation response
+type TagTranslationResponse struct {
+	TagID  uint   `json:"tag_id"`
+	Locale string `json:"locale"`
+	Name   string `json:"name"`
+}
@@ -20,6 +20,8 @@ type UpdateProfileRequest struct {
 	Nickname  *string `json:"nickname,omitempty" binding:"omitempty,min=2,max=20"`
 	AvatarURL *string `json:"avatar_url,omitempty" binding:"omitempty,url"`
 	Bio       *string `json:"bio,omitempty" binding:"omitempty,max=500"`
+	// HideActivity hides your comments from your public profile
+	HideActivity *bool `json:"hide_activity,omitempty"`
 }
 
 // RefreshTokenRequest represents a token refresh request
@@ -29,14 +31,18 @@ type RefreshTokenRequest struct {
 
 // UserResponse represents a user in responses
 type UserResponse struct {
-	ID          uint       `json:"id"`
-	Email       string     `json:"email"`
-	Nickname    string     `json:"nickname"`
-	AvatarURL   *string    `json:"avatar_url,omitempty"`
-	Bio         *string    `json:"bio,omitempty"`
-	IsAdmin     bool       `json:"is_admin"`
-	CreatedAt   time.Time  `json:"created_at"`
-	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	ID            uint       `json:"id"`
+	Email         string     `json:"email"`
+	EmailVerified bool       `json:"email_verified"`
+	Nickname      string     `json:"nickname"`
+	AvatarURL     *string    `json:"avatar_url,omitempty"`
+	Bio           *string    `json:"bio,omitempty"`
+	IsAdmin       bool       `json:"is_admin"`
+	Role          string     `json:"role"`
+	Permissions   []string   `json:"permissions"`
+	HideActivity  bool       `json:"hide_activity"`
+	CreatedAt     time.Time  `json:"created_at"`
+	LastLoginAt   *time.Time `json:"last_login_at,omitempty"`
 }
 
 // AuthResponse represents authentication response with tokens
@@ -50,3 +56,63 @@ type AuthResponse struct {
 type TokenResponse struct {
 	AccessToken string `json:"access_token"`
 }
+
+// CreatePersonalAccessTokenRequest represents a personal access token creation request
+type CreatePersonalAccessTokenRequest struct {
+	Name          string   `json:"name" binding:"required,min=1,max=100"`
+	Scopes        []string `json:"scopes" binding:"required,min=1"`
+	ExpiresInDays *int     `json:"expires_in_days,omitempty" binding:"omitempty,min=1,max=365"` // Omit for a token that never expires
+}
+
+// PersonalAccessTokenResponse describes a personal access token without its secret
+type PersonalAccessTokenResponse struct {
+	ID         uint       `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"` // Start of the token, to recognize it
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP *string    `json:"last_used_ip,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreatePersonalAccessTokenResponse carries a new token, which is shown only once
+type CreatePersonalAccessTokenResponse struct {
+	PersonalAccessTokenResponse
+	Token string `json:"token"`
+}
+
+// PersonalAccessTokensListResponse represents the current user's tokens
+type PersonalAccessTokensListResponse struct {
+	Tokens []PersonalAccessTokenResponse `json:"tokens"`
+}
+
+// PublicProfileResponse represents a user's public profile. Activity fields
+// are null when the user hides their activity.
+type PublicProfileResponse struct {
+	Nickname       string                  `json:"nickname"`
+	AvatarURL      *string                 `json:"avatar_url,omitempty"`
+	Bio            *string                 `json:"bio,omitempty"`
+	JoinedAt       time.Time               `json:"joined_at"`
+	ActivityHidden bool                    `json:"activity_hidden"`
+	CommentCount   *int64                  `json:"comment_count"`
+	Comments       []PublicCommentResponse `json:"comments"`
+	Pagination     *PaginationResponse     `json:"pagination"`
+}
+
+// PublicCommentResponse represents a comment on a public profile
+type PublicCommentResponse struct {
+	ID          uint               `json:"id"`
+	Content     string             `json:"content"`      // Markdown as written
+	ContentHTML string             `json:"content_html"` // Sanitized HTML to display
+	IsEdited    bool               `json:"is_edited"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Post        PublicPostResponse `json:"post"`
+}
+
+// PublicPostResponse links to the post a comment was written on
+type PublicPostResponse struct {
+	ID    uint   `json:"id"`
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
+	"github.com/yourusername/viblog/internal/usecase/user"
+)
+
+// AccountHandler handles email verification and password recovery
+type AccountHandler struct {
+	requestVerificationUC *user.RequestEmailVerificationUseCase
+	confirmVerificationUC *user.ConfirmEmailVerificationUseCase
+	requestResetUC        *user.RequestPasswordResetUseCase
+	resetPasswordUC       *user.ResetPasswordUseCase
+}
+
+// NewAccountHandler creates a new AccountHandler
+func NewAccountHandler(
+	requestVerificationUC *user.RequestEmailVerificationUseCase,
+	confirmVerificationUC *user.ConfirmEmailVerificationUseCase,
+	requestResetUC *user.RequestPasswordResetUseCase,
+	resetPasswordUC *user.ResetPasswordUseCase,
+) *AccountHandler {
+	return &AccountHandler{
+		requestVerificationUC: requestVerificationUC,
+		confirmVerificationUC: confirmVerificationUC,
+		requestResetUC:        requestResetUC,
+		resetPasswordUC:       resetPasswordUC,
+	}
+}
+
+// RequestEmailVerification sends an email verification link
+// @Summary Request email verification
+// @Description Send a verification link to the address. The response is the same whether or not the email is registered.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.EmailRequest true "Email address"
+// @Success 202 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 429 {object} map[string]interface{}
+// @Router /auth/verify-email/request [post]
+func (h *AccountHandler) RequestEmailVerification(c *gin.Context) {
+	var req dto.EmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	err := h.requestVerificationUC.Execute(c.Request.Context(), user.RequestEmailVerificationInput{
+		Email:  req.Email,
+		Locale: middleware.GetLocale(c),
+	})
+	acceptSilently(c, err, "If the email is registered and unverified, a verification link has been sent")
+}
+
+// ConfirmEmailVerification confirms an email verification link
+// @Summary Confirm email verification
+// @Description Mark the account's email as verified using the emailed token
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.VerifyEmailRequest true "Verification token"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{} "Invalid, expired or used token"
+// @Router /auth/verify-email/confirm [post]
+func (h *AccountHandler) ConfirmEmailVerification(c *gin.Context) {
+	var req dto.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	if _, err := h.confirmVerificationUC.Execute(c.Request.Context(), req.Token); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Email verified successfully"})
+}
+
+// RequestPasswordReset sends a password reset link
+// @Summary Request password reset
+// @Description Send a password reset link to the address. The response is the same whether or not the email is registered.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.EmailRequest true "Email address"
+// @Success 202 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 429 {object} map[string]interface{}
+// @Router /auth/password/forgot [post]
+func (h *AccountHandler) RequestPasswordReset(c *gin.Context) {
+	var req dto.EmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	err := h.requestResetUC.Execute(c.Request.Context(), user.RequestPasswordResetInput{
+		Email:  req.Email,
+		Locale: middleware.GetLocale(c),
+	})
+	acceptSilently(c, err, "If the email is registered, a password reset link has been sent")
+}
+
+// ResetPassword completes a password reset
+// @Summary Reset password
+// @Description Set a new password using the emailed token. All existing sessions are revoked.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.ResetPasswordRequest true "Reset token and new password"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{} "Invalid token or weak password"
+// @Router /auth/password/reset [post]
+func (h *AccountHandler) ResetPassword(c *gin.Context) {
+	var req dto.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	err := h.resetPasswordUC.Execute(c.Request.Context(), user.ResetPasswordInput{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Password reset successfully. Please log in again."})
+}
+
+// acceptSilently answers 202 with the same message regardless of outcome so
+// the response never reveals whether an email is registered. Failures are
+// still attached to the context for the request logger.
+func acceptSilently(c *gin.Context, err error, message string) {
+	if err != nil {
+		_ = c.Error(err)
+	}
+	c.JSON(http.StatusAccepted, dto.MessageResponse{Message: message})
+}
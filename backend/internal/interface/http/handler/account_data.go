@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
+	"github.com/yourusername/viblog/internal/usecase/user"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// AccountDataHandler handles exporting and deleting the current user's account
+type AccountDataHandler struct {
+	exportUC *user.ExportAccountDataUseCase
+	deleteUC *user.RequestAccountDeletionUseCase
+}
+
+// NewAccountDataHandler creates a new AccountDataHandler
+func NewAccountDataHandler(
+	exportUC *user.ExportAccountDataUseCase,
+	deleteUC *user.RequestAccountDeletionUseCase,
+) *AccountDataHandler {
+	return &AccountDataHandler{
+		exportUC: exportUC,
+		deleteUC: deleteUC,
+	}
+}
+
+// Export downloads the current user's data
+// @Summary Export account data
+// @Description Download your profile, comments, likes, bookmarks and notifications as one JSON document, or as a ZIP archive with one JSON file per section.
+// @Tags me
+// @Produce json
+// @Produce application/zip
+// @Security BearerAuth
+// @Param format query string false "json (default) or zip"
+// @Success 200 {object} dto.AccountExportResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 429 {object} map[string]interface{}
+// @Router /me/export [get]
+func (h *AccountDataHandler) Export(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "zip" {
+		respondError(c, invalidParamError("format"))
+		return
+	}
+
+	export, err := h.exportUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := newAccountExportResponse(export, time.Now())
+	filename := fmt.Sprintf("viblog-export-%d-%s", userID, response.ExportedAt.Format("20060102"))
+
+	if format == "json" {
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, filename))
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	archive, err := zipAccountExport(response)
+	if err != nil {
+		respondError(c, errors.ErrInternal.WithError(err))
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.zip"`, filename))
+	c.Data(http.StatusOK, "application/zip", archive)
+}
+
+// Delete schedules the current user's account for deletion
+// @Summary Delete account
+// @Description Confirm with your password (and a TOTP or recovery code when two-factor authentication is on) to delete your account. Every session is signed out and the account is anonymized after a grace period; signing in before then cancels the deletion. Comments stay on the site without your name. Accounts without a password (social login only) must set one through password reset first. Admins must give up the admin role first.
+// @Tags me
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.DeleteAccountRequest true "Password and optional MFA code"
+// @Success 202 {object} dto.DeleteAccountResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /me [delete]
+func (h *AccountDataHandler) Delete(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	var req dto.DeleteAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	deleted, err := h.deleteUC.Execute(c.Request.Context(), user.RequestAccountDeletionInput{
+		UserID:   userID,
+		Password: req.Password,
+		Code:     req.Code,
+		Locale:   middleware.GetLocale(c),
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.DeleteAccountResponse{
+		Message:             "Account scheduled for deletion. Sign in before the deletion date to cancel.",
+		DeletionScheduledAt: *deleted.DeletionScheduledAt,
+	})
+}
+
+// newAccountExportResponse converts an export to its response
+func newAccountExportResponse(export *user.AccountExport, now time.Time) dto.AccountExportResponse {
+	u := export.User
+	response := dto.AccountExportResponse{
+		ExportedAt: now.UTC(),
+		Profile: dto.ExportProfileResponse{
+			ID:                  u.ID,
+			Email:               u.Email,
+			EmailVerifiedAt:     u.EmailVerifiedAt,
+			Nickname:            u.Nickname,
+			AvatarURL:           u.AvatarURL,
+			Bio:                 u.Bio,
+			Role:                string(u.Role),
+			HideActivity:        u.HideActivity,
+			MFAEnabledAt:        u.TOTPEnabledAt,
+			CreatedAt:           u.CreatedAt,
+			LastLoginAt:         u.LastLoginAt,
+			DeletionScheduledAt: u.DeletionScheduledAt,
+		},
+		Comments:      make([]dto.ExportCommentResponse, len(export.Comments)),
+		Likes:         make([]dto.ExportLikeResponse, len(export.Likes)),
+		Bookmarks:     make([]dto.ExportBookmarkResponse, len(export.Bookmarks)),
+		Notifications: make([]dto.ExportNotificationResponse, len(export.Notifications)),
+	}
+
+	for i, comment := range export.Comments {
+		response.Comments[i] = dto.ExportCommentResponse{
+			ID:        comment.ID,
+			PostID:    comment.PostID,
+			ParentID:  comment.ParentID,
+			Content:   comment.Content,
+			IsEdited:  comment.IsEdited,
+			CreatedAt: comment.CreatedAt,
+			UpdatedAt: comment.UpdatedAt,
+		}
+	}
+	for i, like := range export.Likes {
+		response.Likes[i] = dto.ExportLikeResponse{
+			PostID:    like.PostID,
+			CommentID: like.CommentID,
+			CreatedAt: like.CreatedAt,
+		}
+	}
+	for i, bookmark := range export.Bookmarks {
+		response.Bookmarks[i] = dto.ExportBookmarkResponse{
+			PostID:    bookmark.PostID,
+			CreatedAt: bookmark.CreatedAt,
+		}
+		if bookmark.Post != nil {
+			response.Bookmarks[i].PostTitle = bookmark.Post.Title
+			response.Bookmarks[i].PostSlug = bookmark.Post.Slug
+		}
+	}
+	for i, notification := range export.Notifications {
+		response.Notifications[i] = dto.ExportNotificationResponse{
+			Type:      string(notification.Type),
+			Title:     notification.Title,
+			Message:   notification.Message,
+			Link:      notification.Link,
+			IsRead:    notification.IsRead,
+			ReadAt:    notification.ReadAt,
+			CreatedAt: notification.CreatedAt,
+		}
+	}
+
+	return response
+}
+
+// zipAccountExport writes each section of an export to its own JSON file
+func zipAccountExport(response dto.AccountExportResponse) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile.json", response.Profile},
+		{"comments.json", response.Comments},
+		{"likes.json", response.Likes},
+		{"bookmarks.json", response.Bookmarks},
+		{"notifications.json", response.Notifications},
+	}
+	for _, file := range files {
+		w, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: response.ExportedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(file.data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -3,8 +3,11 @@ package handler
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
 	"github.com/yourusername/viblog/internal/interface/http/dto"
 	"github.com/yourusername/viblog/internal/interface/http/presenter"
 	"github.com/yourusername/viblog/internal/usecase/admin"
@@ -15,6 +18,7 @@ type AdminHandler struct {
 	dashboardUC       *admin.GetDashboardUseCase
 	listUsersUC       *admin.ListUsersUseCase
 	deleteUserUC      *admin.DeleteUserUseCase
+	listPostsUC       *admin.ListPostsUseCase
 	listCommentsUC    *admin.ListCommentsUseCase
 	deleteCommentUC   *admin.DeleteCommentUseCase
 	listCategoriesUC  *admin.ListCategoriesUseCase
@@ -32,6 +36,7 @@ func NewAdminHandler(
 	dashboardUC *admin.GetDashboardUseCase,
 	listUsersUC *admin.ListUsersUseCase,
 	deleteUserUC *admin.DeleteUserUseCase,
+	listPostsUC *admin.ListPostsUseCase,
 	listCommentsUC *admin.ListCommentsUseCase,
 	deleteCommentUC *admin.DeleteCommentUseCase,
 	listCategoriesUC *admin.ListCategoriesUseCase,
@@ -47,6 +52,7 @@ func NewAdminHandler(
 		dashboardUC:      dashboardUC,
 		listUsersUC:      listUsersUC,
 		deleteUserUC:     deleteUserUC,
+		listPostsUC:      listPostsUC,
 		listCommentsUC:   listCommentsUC,
 		deleteCommentUC:  deleteCommentUC,
 		listCategoriesUC: listCategoriesUC,
@@ -62,20 +68,28 @@ func NewAdminHandler(
 
 // GetDashboard retrieves dashboard data
 // @Summary Get admin dashboard
-// @Description Get dashboard statistics and metrics (Admin only)
+// @Description Get site totals, daily series of views, unique visitors, post likes, approved comments, bookmarks and sign-ups over the last 7, 30 or 90 days counted in the server time zone, the most viewed posts and tags of the period and the latest comments and replies. Views, visitors, likes and comments are read from the daily rollups, so today's numbers trail by up to one rollup interval (Admin only)
 // @Tags admin
 // @Accept json
 // @Produce json
 // @Security BearerAuth
+// @Param days query int false "Period in days, including today" Enums(7, 30, 90) default(30)
 // @Success 200 {object} dto.AdminDashboardResponse
+// @Failure 400 {object} map[string]interface{}
 // @Failure 401 {object} map[string]interface{}
 // @Failure 403 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Router /admin/dashboard [get]
 func (h *AdminHandler) GetDashboard(c *gin.Context) {
-	stats, err := h.dashboardUC.Execute(c.Request.Context())
+	days, err := strconv.Atoi(c.DefaultQuery("days", strconv.Itoa(admin.DefaultDashboardDays)))
+	if err != nil {
+		respondError(c, invalidParamError("days"))
+		return
+	}
+
+	stats, err := h.dashboardUC.Execute(c.Request.Context(), days, time.Now())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve dashboard statistics"})
+		respondError(c, err)
 		return
 	}
 
@@ -85,14 +99,18 @@ func (h *AdminHandler) GetDashboard(c *gin.Context) {
 
 // ListUsers lists all users
 // @Summary List all users
-// @Description Get paginated list of all users (Admin only)
+// @Description Get paginated list of users, optionally searched by email or nickname and filtered by role and status (Admin only)
 // @Tags admin
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
+// @Param search query string false "Substring of the email or nickname"
+// @Param role query string false "Role" Enums(admin, editor, moderator, member)
+// @Param status query string false "Status" Enums(active, suspended, banned)
 // @Success 200 {object} dto.AdminUsersListResponse
+// @Failure 400 {object} map[string]interface{}
 // @Failure 401 {object} map[string]interface{}
 // @Failure 403 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
@@ -102,9 +120,13 @@ func (h *AdminHandler) ListUsers(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
 
-	users, total, err := h.listUsersUC.Execute(c.Request.Context(), page, limit)
+	users, total, err := h.listUsersUC.Execute(c.Request.Context(), admin.ListUsersInput{
+		Search: c.Query("search"),
+		Role:   entity.Role(c.Query("role")),
+		Status: entity.UserStatus(c.Query("status")),
+	}, page, limit)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve users"})
+		respondError(c, err)
 		return
 	}
 
@@ -129,36 +151,118 @@ func (h *AdminHandler) ListUsers(c *gin.Context) {
 func (h *AdminHandler) DeleteUser(c *gin.Context) {
 	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		respondError(c, invalidParamError("id"))
 		return
 	}
 
 	if err := h.deleteUserUC.Execute(c.Request.Context(), uint(userID)); err != nil {
-		if err == admin.ErrUserNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
-			return
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "User deleted successfully"})
+}
+
+// ListPosts lists posts of every status
+// @Summary List all posts
+// @Description Get paginated list of posts including drafts and scheduled posts, optionally searched by title and filtered by status, category, tag, author and creation time, plus post counts per status (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param status query string false "Status" Enums(draft, published, scheduled)
+// @Param category_id query int false "Category ID"
+// @Param tag_id query int false "Tag ID"
+// @Param author_id query int false "Author ID"
+// @Param search query string false "Substring of the title"
+// @Param from query string false "Earliest creation time, RFC 3339"
+// @Param to query string false "Time before which posts were created, RFC 3339"
+// @Param sort query string false "Sort field" Enums(created, updated, published, views, likes, comments) default(created)
+// @Param order query string false "Sort order" Enums(asc, desc) default(desc)
+// @Success 200 {object} dto.AdminPostsListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /admin/posts [get]
+func (h *AdminHandler) ListPosts(c *gin.Context) {
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	input := admin.ListPostsInput{
+		Status: entity.PostStatus(c.Query("status")),
+		Search: c.Query("search"),
+		Sort:   repository.PostSort(c.Query("sort")),
+	}
+
+	for param, dest := range map[string]*uint{
+		"category_id": &input.CategoryID,
+		"tag_id":      &input.TagID,
+		"author_id":   &input.AuthorID,
+	} {
+		if raw := c.Query(param); raw != "" {
+			id, err := strconv.ParseUint(raw, 10, 32)
+			if err != nil {
+				respondError(c, invalidParamError(param))
+				return
+			}
+			*dest = uint(id)
 		}
-		if err == admin.ErrCannotDeleteAdmin {
-			c.JSON(http.StatusForbidden, gin.H{"error": "Cannot delete admin user"})
-			return
+	}
+
+	for param, dest := range map[string]**time.Time{"from": &input.From, "to": &input.To} {
+		if raw := c.Query(param); raw != "" {
+			t, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				respondError(c, invalidParamError(param))
+				return
+			}
+			*dest = &t
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete user"})
+	}
+
+	switch c.DefaultQuery("order", "desc") {
+	case "asc":
+		input.Ascending = true
+	case "desc":
+	default:
+		respondError(c, invalidParamError("order"))
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.MessageResponse{Message: "User deleted successfully"})
+	posts, total, err := h.listPostsUC.Execute(c.Request.Context(), input, page, limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	counts, err := h.listPostsUC.StatusCounts(c.Request.Context())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := presenter.PresentAdminPostsList(posts, counts, total, page, limit)
+	c.JSON(http.StatusOK, response)
 }
 
 // ListComments lists all comments
 // @Summary List all comments
-// @Description Get paginated list of all comments (Admin only)
+// @Description Get paginated list of comments, e.g. the moderation queue with status=pending, plus comment counts per status (Admin only)
 // @Tags admin
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
+// @Param status query string false "Status" Enums(pending, approved, spam, rejected)
+// @Param post_id query int false "Post ID"
+// @Param search query string false "Substring of the content or anonymous author name"
 // @Success 200 {object} dto.AdminCommentsListResponse
+// @Failure 400 {object} map[string]interface{}
 // @Failure 401 {object} map[string]interface{}
 // @Failure 403 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
@@ -168,13 +272,32 @@ func (h *AdminHandler) ListComments(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
 
-	comments, total, err := h.listCommentsUC.Execute(c.Request.Context(), page, limit)
+	var postID uint64
+	if raw := c.Query("post_id"); raw != "" {
+		var err error
+		if postID, err = strconv.ParseUint(raw, 10, 32); err != nil {
+			respondError(c, invalidParamError("post_id"))
+			return
+		}
+	}
+
+	comments, total, err := h.listCommentsUC.Execute(c.Request.Context(), admin.ListCommentsInput{
+		Status: entity.CommentStatus(c.Query("status")),
+		PostID: uint(postID),
+		Search: c.Query("search"),
+	}, page, limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	counts, err := h.listCommentsUC.StatusCounts(c.Request.Context())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve comments"})
+		respondError(c, err)
 		return
 	}
 
-	response := presenter.PresentCommentsList(comments, total, page, limit)
+	response := presenter.PresentCommentsList(comments, counts, total, page, limit)
 	c.JSON(http.StatusOK, response)
 }
 
@@ -195,16 +318,12 @@ func (h *AdminHandler) ListComments(c *gin.Context) {
 func (h *AdminHandler) DeleteComment(c *gin.Context) {
 	commentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		respondError(c, invalidParamError("id"))
 		return
 	}
 
 	if err := h.deleteCommentUC.Execute(c.Request.Context(), uint(commentID)); err != nil {
-		if err == admin.ErrCommentNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Comment not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete comment"})
+		respondError(c, err)
 		return
 	}
 
@@ -226,7 +345,7 @@ func (h *AdminHandler) DeleteComment(c *gin.Context) {
 func (h *AdminHandler) ListCategories(c *gin.Context) {
 	categories, err := h.listCategoriesUC.Execute(c.Request.Context())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve categories"})
+		respondError(c, err)
 		return
 	}
 
@@ -251,7 +370,7 @@ func (h *AdminHandler) ListCategories(c *gin.Context) {
 func (h *AdminHandler) CreateCategory(c *gin.Context) {
 	var req dto.CreateCategoryRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, bindError(err))
 		return
 	}
 
@@ -262,15 +381,7 @@ func (h *AdminHandler) CreateCategory(c *gin.Context) {
 
 	category, err := h.createCategoryUC.Execute(c.Request.Context(), input)
 	if err != nil {
-		if err == admin.ErrCategoryNameRequired {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Category name is required"})
-			return
-		}
-		if err == admin.ErrCategoryNameExists || err == admin.ErrCategorySlugExists {
-			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create category"})
+		respondError(c, err)
 		return
 	}
 
@@ -297,13 +408,13 @@ func (h *AdminHandler) CreateCategory(c *gin.Context) {
 func (h *AdminHandler) UpdateCategory(c *gin.Context) {
 	categoryID, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid category ID"})
+		respondError(c, invalidParamError("id"))
 		return
 	}
 
 	var req dto.UpdateCategoryRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, bindError(err))
 		return
 	}
 
@@ -314,15 +425,7 @@ func (h *AdminHandler) UpdateCategory(c *gin.Context) {
 
 	category, err := h.updateCategoryUC.Execute(c.Request.Context(), uint(categoryID), input)
 	if err != nil {
-		if err == admin.ErrCategoryNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Category not found"})
-			return
-		}
-		if err == admin.ErrCategoryNameExists {
-			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update category"})
+		respondError(c, err)
 		return
 	}
 
@@ -347,16 +450,12 @@ func (h *AdminHandler) UpdateCategory(c *gin.Context) {
 func (h *AdminHandler) DeleteCategory(c *gin.Context) {
 	categoryID, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid category ID"})
+		respondError(c, invalidParamError("id"))
 		return
 	}
 
 	if err := h.deleteCategoryUC.Execute(c.Request.Context(), uint(categoryID)); err != nil {
-		if err == admin.ErrCategoryNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Category not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete category"})
+		respondError(c, err)
 		return
 	}
 
@@ -378,7 +477,7 @@ func (h *AdminHandler) DeleteCategory(c *gin.Context) {
 func (h *AdminHandler) ListTags(c *gin.Context) {
 	tags, err := h.listTagsUC.Execute(c.Request.Context())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tags"})
+		respondError(c, err)
 		return
 	}
 
@@ -403,7 +502,7 @@ func (h *AdminHandler) ListTags(c *gin.Context) {
 func (h *AdminHandler) CreateTag(c *gin.Context) {
 	var req dto.CreateTagRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, bindError(err))
 		return
 	}
 
@@ -413,15 +512,7 @@ func (h *AdminHandler) CreateTag(c *gin.Context) {
 
 	tag, err := h.createTagUC.Execute(c.Request.Context(), input)
 	if err != nil {
-		if err == admin.ErrTagNameRequired {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Tag name is required"})
-			return
-		}
-		if err == admin.ErrTagNameExists || err == admin.ErrTagSlugExists {
-			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create tag"})
+		respondError(c, err)
 		return
 	}
 
@@ -448,13 +539,13 @@ func (h *AdminHandler) CreateTag(c *gin.Context) {
 func (h *AdminHandler) UpdateTag(c *gin.Context) {
 	tagID, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tag ID"})
+		respondError(c, invalidParamError("id"))
 		return
 	}
 
 	var req dto.UpdateTagRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, bindError(err))
 		return
 	}
 
@@ -464,15 +555,7 @@ func (h *AdminHandler) UpdateTag(c *gin.Context) {
 
 	tag, err := h.updateTagUC.Execute(c.Request.Context(), uint(tagID), input)
 	if err != nil {
-		if err == admin.ErrTagNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
-			return
-		}
-		if err == admin.ErrTagNameExists {
-			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update tag"})
+		respondError(c, err)
 		return
 	}
 
@@ -497,16 +580,12 @@ func (h *AdminHandler) UpdateTag(c *gin.Context) {
 func (h *AdminHandler) DeleteTag(c *gin.Context) {
 	tagID, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tag ID"})
+		respondError(c, invalidParamError("id"))
 		return
 	}
 
 	if err := h.deleteTagUC.Execute(c.Request.Context(), uint(tagID)); err != nil {
-		if err == admin.ErrTagNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete tag"})
+		respondError(c, err)
 		return
 	}
 
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/presenter"
+	"github.com/yourusername/viblog/internal/usecase/admin"
+)
+
+// AnalyticsHandler handles admin analytics requests
+type AnalyticsHandler struct {
+	rollupUC  *admin.RollUpAnalyticsUseCase
+	trafficUC *admin.GetTrafficBreakdownUseCase
+}
+
+// NewAnalyticsHandler creates a new AnalyticsHandler
+func NewAnalyticsHandler(rollupUC *admin.RollUpAnalyticsUseCase, trafficUC *admin.GetTrafficBreakdownUseCase) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		rollupUC:  rollupUC,
+		trafficUC: trafficUC,
+	}
+}
+
+// GetTraffic breaks down the views of all posts
+// @Summary Get site traffic
+// @Description Rank the referrer hosts, UTM sources, media and campaigns, devices, browsers, operating systems and countries of all post views over the last 7, 30 or 90 days counted in the server time zone. An empty value stands for direct visits or an unknown value. Read from the daily rollups (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param days query int false "Period in days, including today" Enums(7, 30, 90) default(30)
+// @Param limit query int false "Top values per dimension" default(10) maximum(50)
+// @Success 200 {object} dto.TrafficBreakdownResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /admin/analytics/traffic [get]
+func (h *AnalyticsHandler) GetTraffic(c *gin.Context) {
+	h.getTraffic(c, nil)
+}
+
+// GetPostTraffic breaks down the views of one post
+// @Summary Get post traffic
+// @Description Rank the referrer hosts, UTM sources, media and campaigns, devices, browsers, operating systems and countries of a post's views over the last 7, 30 or 90 days counted in the server time zone. An empty value stands for direct visits or an unknown value. Read from the daily rollups (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Post ID"
+// @Param days query int false "Period in days, including today" Enums(7, 30, 90) default(30)
+// @Param limit query int false "Top values per dimension" default(10) maximum(50)
+// @Success 200 {object} dto.TrafficBreakdownResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /admin/analytics/posts/{id}/traffic [get]
+func (h *AnalyticsHandler) GetPostTraffic(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	postID := uint(id)
+	h.getTraffic(c, &postID)
+}
+
+// getTraffic responds with the traffic breakdown of a post, or of all posts when postID is nil
+func (h *AnalyticsHandler) getTraffic(c *gin.Context, postID *uint) {
+	days, err := strconv.Atoi(c.DefaultQuery("days", strconv.Itoa(admin.DefaultDashboardDays)))
+	if err != nil {
+		respondError(c, invalidParamError("days"))
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(admin.DefaultTrafficLimit)))
+	if err != nil {
+		respondError(c, invalidParamError("limit"))
+		return
+	}
+
+	traffic, err := h.trafficUC.Execute(c.Request.Context(), postID, days, limit, time.Now())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentTrafficBreakdown(traffic))
+}
+
+// Rollup rolls up past days on demand
+// @Summary Roll up analytics
+// @Description Recount the daily per-post views, unique visitors, likes and comments the dashboard reads, and the traffic breakdown of views, for every day from from through to (at most 366 days, ending today at the latest). Rolling up a day again replaces its totals. Views of days whose raw view logs were already pruned are kept as previously rolled up (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.AnalyticsRollupRequest true "Days to roll up"
+// @Success 200 {object} dto.AnalyticsRollupResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /admin/analytics/rollup [post]
+func (h *AnalyticsHandler) Rollup(c *gin.Context) {
+	var req dto.AnalyticsRollupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	result, err := h.rollupUC.Execute(c.Request.Context(), req.From, req.To, time.Now())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.AnalyticsRollupResponse{
+		From: result.From,
+		To:   result.To,
+		Days: result.Days,
+	})
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/interface/http/presenter"
+	"github.com/yourusername/viblog/internal/usecase/admin"
+)
+
+// AuditLogHandler handles admin audit log requests
+type AuditLogHandler struct {
+	listAuditLogUC *admin.ListAuditLogUseCase
+}
+
+// NewAuditLogHandler creates a new AuditLogHandler
+func NewAuditLogHandler(listAuditLogUC *admin.ListAuditLogUseCase) *AuditLogHandler {
+	return &AuditLogHandler{
+		listAuditLogUC: listAuditLogUC,
+	}
+}
+
+// List lists the audit log
+// @Summary List audit log
+// @Description Get the privileged actions taken through admin and post write routes, newest first: who did what to which record, with snapshots of the record before and after, the client IP and the request ID also sent in the X-Request-ID response header. Entries cannot be changed and are deleted once past the retention period (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param actor_id query int false "User who took the action"
+// @Param action query string false "Action such as comment.delete, or a prefix ending in a dot such as user."
+// @Param target_type query string false "Target type" Enums(user, post, comment, comment_report, category, tag, ban, lockout, analytics)
+// @Param target_id query int false "Target ID"
+// @Param request_id query string false "Request ID"
+// @Param from query string false "Earliest time, RFC 3339"
+// @Param to query string false "Time before which entries were recorded, RFC 3339"
+// @Success 200 {object} dto.AuditLogListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /admin/audit-log [get]
+func (h *AuditLogHandler) List(c *gin.Context) {
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	input := admin.ListAuditLogInput{
+		Action:     c.Query("action"),
+		TargetType: entity.AuditTargetType(c.Query("target_type")),
+		RequestID:  c.Query("request_id"),
+	}
+
+	for param, dest := range map[string]*uint{"actor_id": &input.ActorID, "target_id": &input.TargetID} {
+		if raw := c.Query(param); raw != "" {
+			id, err := strconv.ParseUint(raw, 10, 32)
+			if err != nil {
+				respondError(c, invalidParamError(param))
+				return
+			}
+			*dest = uint(id)
+		}
+	}
+
+	for param, dest := range map[string]**time.Time{"from": &input.From, "to": &input.To} {
+		if raw := c.Query(param); raw != "" {
+			t, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				respondError(c, invalidParamError(param))
+				return
+			}
+			*dest = &t
+		}
+	}
+
+	logs, total, err := h.listAuditLogUC.Execute(c.Request.Context(), input, page, limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := presenter.PresentAuditLogList(logs, total, page, limit)
+	c.JSON(http.StatusOK, response)
+}
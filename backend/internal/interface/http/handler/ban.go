@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
+	"github.com/yourusername/viblog/internal/interface/http/presenter"
+	"github.com/yourusername/viblog/internal/usecase/admin"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// BanHandler handles admin management of IP, email and user bans
+type BanHandler struct {
+	listBansUC  *admin.ListBansUseCase
+	createBanUC *admin.CreateBanUseCase
+	deleteBanUC *admin.DeleteBanUseCase
+}
+
+// NewBanHandler creates a new BanHandler
+func NewBanHandler(
+	listBansUC *admin.ListBansUseCase,
+	createBanUC *admin.CreateBanUseCase,
+	deleteBanUC *admin.DeleteBanUseCase,
+) *BanHandler {
+	return &BanHandler{
+		listBansUC:  listBansUC,
+		createBanUC: createBanUC,
+		deleteBanUC: deleteBanUC,
+	}
+}
+
+// ListBans lists bans
+// @Summary List bans
+// @Description Get IP network, email and user bans, newest first (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param type query string false "Ban type" Enums(ip, email, user)
+// @Param active query bool false "Only bans in force" default(true)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.BansListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /admin/bans [get]
+func (h *BanHandler) ListBans(c *gin.Context) {
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	activeOnly, err := strconv.ParseBool(c.DefaultQuery("active", "true"))
+	if err != nil {
+		respondError(c, invalidParamError("active"))
+		return
+	}
+
+	bans, total, err := h.listBansUC.Execute(c.Request.Context(), admin.ListBansInput{
+		Type:       entity.BanType(c.Query("type")),
+		ActiveOnly: activeOnly,
+	}, page, limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := presenter.PresentBansList(bans, total, page, limit)
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateBan adds a ban
+// @Summary Add ban
+// @Description Keep an IP address or CIDR network, email address or user from commenting, registering and signing in. Email addresses are stored only as hashes; banning a user also ends their sessions (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateBanRequest true "Ban details"
+// @Success 201 {object} dto.BanResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{} "An active ban already covers the value"
+// @Router /admin/bans [post]
+func (h *BanHandler) CreateBan(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	var req dto.CreateBanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	created, err := h.createBanUC.Execute(c.Request.Context(), admin.CreateBanInput{
+		ActorID:   actorID,
+		Type:      entity.BanType(req.Type),
+		Value:     req.Value,
+		Reason:    req.Reason,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.PresentBan(created, time.Now()))
+}
+
+// DeleteBan lifts a ban
+// @Summary Lift ban
+// @Description Delete a ban so it is no longer enforced (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Ban ID"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/bans/{id} [delete]
+func (h *BanHandler) DeleteBan(c *gin.Context) {
+	banID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	if err := h.deleteBanUC.Execute(c.Request.Context(), uint(banID)); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Ban lifted successfully"})
+}
@@ -2,67 +2,156 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
+	"github.com/yourusername/viblog/internal/interface/http/presenter"
+	"github.com/yourusername/viblog/internal/usecase/comment"
 )
 
 // CommentHandler handles comment-related HTTP requests
 type CommentHandler struct {
-	// TODO: Add use case dependencies
+	createUC      *comment.CreateUseCase
+	updateUC      *comment.UpdateUseCase
+	listUC        *comment.ListUseCase
+	listRepliesUC *comment.ListRepliesUseCase
+	reportUC      *comment.ReportUseCase
 }
 
 // NewCommentHandler creates a new CommentHandler
-func NewCommentHandler(useCase interface{}) *CommentHandler {
-	return &CommentHandler{}
+func NewCommentHandler(
+	createUC *comment.CreateUseCase,
+	updateUC *comment.UpdateUseCase,
+	listUC *comment.ListUseCase,
+	listRepliesUC *comment.ListRepliesUseCase,
+	reportUC *comment.ReportUseCase,
+) *CommentHandler {
+	return &CommentHandler{
+		createUC:      createUC,
+		updateUC:      updateUC,
+		listUC:        listUC,
+		listRepliesUC: listRepliesUC,
+		reportUC:      reportUC,
+	}
 }
 
 // List lists comments for a post
 // @Summary List comments
-// @Description Get all comments for a specific post
+// @Description Get the approved top-level comments of a published post. The comment pinned by an admin comes first, then the rest in the requested order: oldest, newest or top (most liked). Each comment carries its reply_count so collapsed threads can be expanded through the replies endpoint. Comments held for moderation are not listed.
 // @Tags comments
 // @Accept json
 // @Produce json
 // @Param postId path int true "Post ID"
-// @Success 200 {object} map[string]interface{}
+// @Param sort query string false "Sort order" Enums(oldest, newest, top) default(oldest)
+// @Success 200 {object} dto.CommentsListResponse
+// @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Router /comments/post/{postId} [get]
 func (h *CommentHandler) List(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{"message": "List comments - TODO"})
+	postID, err := strconv.ParseUint(c.Param("postId"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("postId"))
+		return
+	}
+
+	sort := repository.CommentSort(c.DefaultQuery("sort", string(repository.CommentSortOldest)))
+	comments, err := h.listUC.Execute(c.Request.Context(), uint(postID), sort)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.ToCommentsListResponse(comments))
+}
+
+// FormToken issues a comment form token
+// @Summary Get comment form token
+// @Description Get a token to send as form_token when the comment form is submitted. It records when the form was shown so comments posted faster than a person can type are treated as spam. Fetch a new one for each form.
+// @Tags comments
+// @Produce json
+// @Success 200 {object} dto.CommentFormTokenResponse
+// @Router /comments/form-token [get]
+func (h *CommentHandler) FormToken(c *gin.Context) {
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, dto.CommentFormTokenResponse{
+		FormToken: h.createUC.IssueFormToken(time.Now()),
+	})
 }
 
 // Create creates a new comment
 // @Summary Create comment
-// @Description Create a new comment on a post (Anonymous or Authenticated)
+// @Description Create a new comment on a post (Anonymous or Authenticated). Anonymous visitors must give author_name and author_password, and may set notify_replies with an author_email to be emailed about replies after confirming the address. Every comment is spam-checked; depending on the result and the moderation rules it is published right away, held with status pending until an admin approves it, or filed with status spam.
 // @Tags comments
 // @Accept json
 // @Produce json
 // @Param postId path int true "Post ID"
-// @Param request body map[string]interface{} true "Comment creation request"
-// @Success 201 {object} map[string]interface{}
+// @Param request body dto.CreateCommentRequest true "Comment creation request"
+// @Success 201 {object} dto.CommentResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 429 {object} map[string]interface{}
 // @Router /comments/post/{postId} [post]
 func (h *CommentHandler) Create(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{"message": "Create comment - TODO"})
+	postID, err := strconv.ParseUint(c.Param("postId"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("postId"))
+		return
+	}
+
+	h.create(c, uint(postID), nil)
 }
 
 // Update updates a comment
 // @Summary Update comment
-// @Description Update an existing comment (Owner or Admin)
+// @Description Update an existing comment (Owner or Admin). Anonymous comments are edited with the author_password they were posted with. Every edit is kept in the comment's history for admins.
 // @Tags comments
 // @Accept json
 // @Produce json
 // @Param id path int true "Comment ID"
-// @Param request body map[string]interface{} true "Comment update request"
-// @Success 200 {object} map[string]interface{}
+// @Param request body dto.UpdateCommentRequest true "Comment update request"
+// @Success 200 {object} dto.CommentResponse
 // @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{} "Wrong author password"
 // @Failure 403 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 429 {object} map[string]interface{}
 // @Router /comments/{id} [put]
 func (h *CommentHandler) Update(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{"message": "Update comment - TODO"})
+	commentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	var req dto.UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	input := comment.UpdateInput{
+		CommentID:      uint(commentID),
+		AuthorPassword: req.AuthorPassword,
+		Content:        req.Content,
+		IP:             c.ClientIP(),
+		UserAgent:      c.Request.UserAgent(),
+	}
+	if userID, ok := middleware.GetUserID(c); ok {
+		input.UserID = &userID
+	}
+
+	updated, err := h.updateUC.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.ToCommentResponse(updated))
 }
 
 // Delete deletes a comment
@@ -117,31 +206,137 @@ func (h *CommentHandler) Unlike(c *gin.Context) {
 
 // ListReplies lists all replies to a comment
 // @Summary List replies
-// @Description Get all replies to a specific comment (nested comments)
+// @Description Get the approved replies to a specific comment (nested comments), oldest first, each with its reply_count
 // @Tags comments
 // @Accept json
 // @Produce json
 // @Param id path int true "Comment ID"
-// @Success 200 {object} map[string]interface{}
+// @Success 200 {object} dto.CommentsListResponse
+// @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Router /comments/{id}/replies [get]
 func (h *CommentHandler) ListReplies(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{"message": "List replies - TODO"})
+	commentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	replies, err := h.listRepliesUC.Execute(c.Request.Context(), uint(commentID))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.ToCommentsListResponse(replies))
 }
 
 // CreateReply creates a reply to a comment
 // @Summary Create reply
-// @Description Create a reply to an existing comment (Anonymous or Authenticated)
+// @Description Create a reply to an existing comment (Anonymous or Authenticated). Replies follow the same moderation rules as comments.
 // @Tags comments
 // @Accept json
 // @Produce json
 // @Param id path int true "Comment ID"
-// @Param request body map[string]interface{} true "Reply creation request"
-// @Success 201 {object} map[string]interface{}
+// @Param request body dto.CreateCommentRequest true "Reply creation request"
+// @Success 201 {object} dto.CommentResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 429 {object} map[string]interface{}
 // @Router /comments/{id}/replies [post]
 func (h *CommentHandler) CreateReply(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{"message": "Create reply - TODO"})
+	parentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	id := uint(parentID)
+	h.create(c, 0, &id)
+}
+
+// Report reports a comment
+// @Summary Report comment
+// @Description Report an abusive comment (Anonymous or Authenticated). Each user, or each IP address for anonymous readers, can report a comment once. A comment reaching the report threshold is hidden until an admin reviews it.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Comment ID"
+// @Param request body dto.ReportCommentRequest true "Reason category and optional details"
+// @Success 201 {object} dto.ReportCommentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{} "Already reported"
+// @Failure 429 {object} map[string]interface{}
+// @Router /comments/{id}/report [post]
+func (h *CommentHandler) Report(c *gin.Context) {
+	commentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	var req dto.ReportCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	input := comment.ReportInput{
+		CommentID: uint(commentID),
+		IP:        c.ClientIP(),
+		Reason:    entity.ReportReason(req.Reason),
+		Details:   req.Details,
+	}
+	if userID, ok := middleware.GetUserID(c); ok {
+		input.UserID = &userID
+	}
+
+	result, err := h.reportUC.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ReportCommentResponse{
+		ID:     result.Report.ID,
+		Reason: string(result.Report.Reason),
+		Hidden: result.Hidden,
+	})
+}
+
+// create binds a comment request and creates the comment as the current
+// user, or anonymously when the request is not authenticated
+func (h *CommentHandler) create(c *gin.Context, postID uint, parentID *uint) {
+	var req dto.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	input := comment.CreateInput{
+		PostID:         postID,
+		ParentID:       parentID,
+		Content:        req.Content,
+		AuthorName:     req.AuthorName,
+		AuthorEmail:    req.AuthorEmail,
+		AuthorPassword: req.AuthorPassword,
+		NotifyReplies:  req.NotifyReplies,
+		Locale:         middleware.GetLocale(c),
+		IP:             c.ClientIP(),
+		UserAgent:      c.Request.UserAgent(),
+		Honeypot:       req.Website,
+		FormToken:      req.FormToken,
+	}
+	if userID, ok := middleware.GetUserID(c); ok {
+		input.UserID = &userID
+	}
+
+	created, err := h.createUC.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.ToCommentResponse(created))
 }
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
+	"github.com/yourusername/viblog/internal/interface/http/presenter"
+	"github.com/yourusername/viblog/internal/usecase/admin"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// CommentModerationHandler handles the admin comment moderation queue
+type CommentModerationHandler struct {
+	moderateUC *admin.ModerateCommentsUseCase
+	pinUC      *admin.PinCommentUseCase
+	historyUC  *admin.GetCommentHistoryUseCase
+}
+
+// NewCommentModerationHandler creates a new CommentModerationHandler
+func NewCommentModerationHandler(
+	moderateUC *admin.ModerateCommentsUseCase,
+	pinUC *admin.PinCommentUseCase,
+	historyUC *admin.GetCommentHistoryUseCase,
+) *CommentModerationHandler {
+	return &CommentModerationHandler{
+		moderateUC: moderateUC,
+		pinUC:      pinUC,
+		historyUC:  historyUC,
+	}
+}
+
+// Moderate approves, rejects or marks comments as spam in bulk
+// @Summary Moderate comments
+// @Description Set the status of up to 100 comments at once. Only approved comments are public and counted on their post; signed-in authors are notified when their comment is approved. Nothing changes if any comment is missing. (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.ModerateCommentsRequest true "Comment IDs and new status"
+// @Success 200 {object} dto.ModerateCommentsResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/comments/moderate [post]
+func (h *CommentModerationHandler) Moderate(c *gin.Context) {
+	moderatorID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	var req dto.ModerateCommentsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	changed, err := h.moderateUC.Execute(c.Request.Context(), admin.ModerateCommentsInput{
+		ModeratorID: moderatorID,
+		CommentIDs:  req.CommentIDs,
+		Status:      entity.CommentStatus(req.Status),
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := dto.ModerateCommentsResponse{
+		Comments: make([]dto.AdminCommentResponse, len(changed)),
+		Changed:  len(changed),
+	}
+	for i := range changed {
+		response.Comments[i] = presenter.PresentComment(&changed[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// History shows how a comment was edited
+// @Summary Get comment edit history
+// @Description Get a comment with every edit made to it, oldest first: the content before and after, when, and who made it. Signed-in editors are identified by user, anonymous authors by their network. (Admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Comment ID"
+// @Success 200 {object} dto.AdminCommentHistoryResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/comments/{id}/history [get]
+func (h *CommentModerationHandler) History(c *gin.Context) {
+	commentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	comment, revisions, err := h.historyUC.Execute(c.Request.Context(), uint(commentID))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentCommentHistory(comment, revisions))
+}
+
+// Pin pins a comment to the top of its post
+// @Summary Pin comment
+// @Description Pin an approved top-level comment so it is listed first on its post. A post has at most one pinned comment; pinning another replaces it. (Admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Comment ID"
+// @Success 200 {object} dto.AdminCommentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/comments/{id}/pin [post]
+func (h *CommentModerationHandler) Pin(c *gin.Context) {
+	h.setPinned(c, true)
+}
+
+// Unpin unpins a comment
+// @Summary Unpin comment
+// @Description Unpin a comment so it is listed in its normal order again (Admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Comment ID"
+// @Success 200 {object} dto.AdminCommentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/comments/{id}/pin [delete]
+func (h *CommentModerationHandler) Unpin(c *gin.Context) {
+	h.setPinned(c, false)
+}
+
+// setPinned pins or unpins the comment in the path
+func (h *CommentModerationHandler) setPinned(c *gin.Context, pinned bool) {
+	commentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	comment, err := h.pinUC.Execute(c.Request.Context(), uint(commentID), pinned)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentComment(comment))
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
+	"github.com/yourusername/viblog/internal/interface/http/presenter"
+	"github.com/yourusername/viblog/internal/usecase/admin"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// CommentReportHandler handles the admin inbox of reported comments
+type CommentReportHandler struct {
+	listReportsUC   *admin.ListCommentReportsUseCase
+	resolveReportUC *admin.ResolveCommentReportUseCase
+}
+
+// NewCommentReportHandler creates a new CommentReportHandler
+func NewCommentReportHandler(
+	listReportsUC *admin.ListCommentReportsUseCase,
+	resolveReportUC *admin.ResolveCommentReportUseCase,
+) *CommentReportHandler {
+	return &CommentReportHandler{
+		listReportsUC:   listReportsUC,
+		resolveReportUC: resolveReportUC,
+	}
+}
+
+// ListReports lists comment reports
+// @Summary List comment reports
+// @Description Get reader reports of comments, oldest first, with the reported comments and report counts per status (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Status" Enums(open, dismissed, resolved) default(open)
+// @Param reason query string false "Reason" Enums(spam, harassment, hate, off_topic, other)
+// @Param comment_id query int false "Comment ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.AdminCommentReportsListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /admin/reports [get]
+func (h *CommentReportHandler) ListReports(c *gin.Context) {
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	var commentID uint64
+	if raw := c.Query("comment_id"); raw != "" {
+		var err error
+		if commentID, err = strconv.ParseUint(raw, 10, 32); err != nil {
+			respondError(c, invalidParamError("comment_id"))
+			return
+		}
+	}
+
+	reports, total, err := h.listReportsUC.Execute(c.Request.Context(), admin.ListCommentReportsInput{
+		Status:    entity.ReportStatus(c.DefaultQuery("status", string(entity.ReportStatusOpen))),
+		Reason:    entity.ReportReason(c.Query("reason")),
+		CommentID: uint(commentID),
+	}, page, limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	counts, err := h.listReportsUC.StatusCounts(c.Request.Context())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := presenter.PresentCommentReportsList(reports, counts, total, page, limit)
+	c.JSON(http.StatusOK, response)
+}
+
+// ResolveReport resolves a comment report
+// @Summary Resolve comment report
+// @Description Dismiss a report, restoring the comment if the reports hid it, or delete the reported comment. Every open report of the same comment is closed (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Report ID"
+// @Param request body dto.ResolveCommentReportRequest true "Decision"
+// @Success 200 {object} dto.ResolveCommentReportResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/reports/{id}/resolve [post]
+func (h *CommentReportHandler) ResolveReport(c *gin.Context) {
+	moderatorID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	reportID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	var req dto.ResolveCommentReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	closed, err := h.resolveReportUC.Execute(c.Request.Context(), admin.ResolveCommentReportInput{
+		ModeratorID: moderatorID,
+		ReportID:    uint(reportID),
+		Action:      req.Action,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ResolveCommentReportResponse{
+		Action: req.Action,
+		Closed: closed,
+	})
+}
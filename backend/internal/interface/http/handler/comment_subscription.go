@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/usecase/comment"
+)
+
+// CommentSubscriptionHandler handles the links in reply notification emails
+type CommentSubscriptionHandler struct {
+	confirmUC     *comment.ConfirmSubscriptionUseCase
+	unsubscribeUC *comment.UnsubscribeUseCase
+}
+
+// NewCommentSubscriptionHandler creates a new CommentSubscriptionHandler
+func NewCommentSubscriptionHandler(
+	confirmUC *comment.ConfirmSubscriptionUseCase,
+	unsubscribeUC *comment.UnsubscribeUseCase,
+) *CommentSubscriptionHandler {
+	return &CommentSubscriptionHandler{
+		confirmUC:     confirmUC,
+		unsubscribeUC: unsubscribeUC,
+	}
+}
+
+// Confirm confirms a reply notification subscription
+// @Summary Confirm reply notifications
+// @Description Confirm the address an anonymous commenter asked to be emailed at when someone replies to their comment, using the emailed token. Nothing is sent before confirmation.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param request body dto.CommentSubscriptionTokenRequest true "Confirmation token"
+// @Success 200 {object} dto.CommentSubscriptionResponse
+// @Failure 400 {object} map[string]interface{} "Invalid, expired or used token"
+// @Failure 429 {object} map[string]interface{}
+// @Router /comments/subscriptions/confirm [post]
+func (h *CommentSubscriptionHandler) Confirm(c *gin.Context) {
+	var req dto.CommentSubscriptionTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	subscription, err := h.confirmUC.Execute(c.Request.Context(), req.Token)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CommentSubscriptionResponse{
+		CommentID:   subscription.CommentID,
+		Email:       subscription.Email,
+		ConfirmedAt: *subscription.ConfirmedAt,
+	})
+}
+
+// Unsubscribe stops reply notifications
+// @Summary Unsubscribe from reply notifications
+// @Description Stop the reply emails for one comment using the token from the unsubscribe link every notification carries
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param request body dto.CommentSubscriptionTokenRequest true "Unsubscribe token"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{} "Invalid token"
+// @Failure 429 {object} map[string]interface{}
+// @Router /comments/subscriptions/unsubscribe [post]
+func (h *CommentSubscriptionHandler) Unsubscribe(c *gin.Context) {
+	var req dto.CommentSubscriptionTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	if err := h.unsubscribeUC.Execute(c.Request.Context(), req.Token); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "You will no longer be emailed about replies to this comment"})
+}
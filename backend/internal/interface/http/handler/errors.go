@@ -0,0 +1,62 @@
+package handler
+
+import (
+	stderrors "errors"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+func init() {
+	// Report validation failures by JSON field name rather than Go field name
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(jsonFieldName)
+	}
+}
+
+// jsonFieldName returns the JSON name of a struct field, or "" when it is skipped
+func jsonFieldName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// respondError hands err to middleware.ErrorHandler, which renders the
+// localized error envelope once the handler returns
+func respondError(c *gin.Context, err error) {
+	_ = c.Error(err)
+}
+
+// bindError converts a request binding failure into a validation AppError.
+// Field level failures are reported as details keyed by the JSON field name.
+func bindError(err error) *errors.AppError {
+	var validationErrs validator.ValidationErrors
+	if !stderrors.As(err, &validationErrs) {
+		return errors.ErrInvalidInput.WithError(err)
+	}
+
+	fields := make(map[string]interface{}, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		fields[fieldErr.Field()] = fieldErr.Tag()
+	}
+
+	return errors.ErrValidation.WithDetails(map[string]interface{}{
+		"fields": fields,
+	}).WithError(err)
+}
+
+// invalidParamError reports a malformed path or query parameter
+func invalidParamError(name string) *errors.AppError {
+	return errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+		"param": name,
+	})
+}
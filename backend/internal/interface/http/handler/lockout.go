@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/presenter"
+	"github.com/yourusername/viblog/internal/usecase/admin"
+)
+
+// LockoutHandler handles admin management of failed login lockouts
+type LockoutHandler struct {
+	listLockoutsUC *admin.ListLockoutsUseCase
+	clearLockoutUC *admin.ClearLockoutUseCase
+}
+
+// NewLockoutHandler creates a new LockoutHandler
+func NewLockoutHandler(
+	listLockoutsUC *admin.ListLockoutsUseCase,
+	clearLockoutUC *admin.ClearLockoutUseCase,
+) *LockoutHandler {
+	return &LockoutHandler{
+		listLockoutsUC: listLockoutsUC,
+		clearLockoutUC: clearLockoutUC,
+	}
+}
+
+// ListLockouts lists throttled accounts and IPs
+// @Summary List login lockouts
+// @Description Get accounts and client IPs with recent failed logins (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param locked query bool false "Only currently locked entries" default(true)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.AdminLockoutsListResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /admin/lockouts [get]
+func (h *LockoutHandler) ListLockouts(c *gin.Context) {
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	lockedOnly, err := strconv.ParseBool(c.DefaultQuery("locked", "true"))
+	if err != nil {
+		respondError(c, invalidParamError("locked"))
+		return
+	}
+
+	throttles, total, err := h.listLockoutsUC.Execute(c.Request.Context(), lockedOnly, page, limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := presenter.PresentLockoutsList(throttles, total, page, limit)
+	c.JSON(http.StatusOK, response)
+}
+
+// ClearLockout lifts a lockout
+// @Summary Clear login lockout
+// @Description Unlock an account or client IP and reset its failed login count (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Lockout ID"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/lockouts/{id} [delete]
+func (h *LockoutHandler) ClearLockout(c *gin.Context) {
+	lockoutID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	if err := h.clearLockoutUC.Execute(c.Request.Context(), uint(lockoutID)); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Lockout cleared successfully"})
+}
@@ -0,0 +1,267 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/infrastructure/auth"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
+	"github.com/yourusername/viblog/internal/usecase/user"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// MFAHandler handles two-factor authentication
+type MFAHandler struct {
+	getStatusUC      *user.GetMFAStatusUseCase
+	enrollUC         *user.EnrollTOTPUseCase
+	confirmUC        *user.ConfirmTOTPUseCase
+	disableUC        *user.DisableTOTPUseCase
+	regenerateCodeUC *user.RegenerateRecoveryCodesUseCase
+	verifyLoginUC    *user.VerifyMFALoginUseCase
+	jwtService       *auth.JWTService
+}
+
+// NewMFAHandler creates a new MFAHandler
+func NewMFAHandler(
+	getStatusUC *user.GetMFAStatusUseCase,
+	enrollUC *user.EnrollTOTPUseCase,
+	confirmUC *user.ConfirmTOTPUseCase,
+	disableUC *user.DisableTOTPUseCase,
+	regenerateCodeUC *user.RegenerateRecoveryCodesUseCase,
+	verifyLoginUC *user.VerifyMFALoginUseCase,
+	jwtService *auth.JWTService,
+) *MFAHandler {
+	return &MFAHandler{
+		getStatusUC:      getStatusUC,
+		enrollUC:         enrollUC,
+		confirmUC:        confirmUC,
+		disableUC:        disableUC,
+		regenerateCodeUC: regenerateCodeUC,
+		verifyLoginUC:    verifyLoginUC,
+		jwtService:       jwtService,
+	}
+}
+
+// VerifyLogin completes a login that requires a second factor
+// @Summary Complete two-factor login
+// @Description Exchange the MFA token from /auth/login and a TOTP or recovery code for access/refresh tokens
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.MFALoginRequest true "MFA token and code"
+// @Success 200 {object} dto.AuthResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{} "Invalid token or code"
+// @Failure 429 {object} map[string]interface{}
+// @Router /auth/login/mfa [post]
+func (h *MFAHandler) VerifyLogin(c *gin.Context) {
+	var req dto.MFALoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	claims, err := h.jwtService.ValidateMFAPendingToken(req.MFAToken)
+	if err != nil {
+		respondError(c, errors.ErrInvalidToken.WithError(err))
+		return
+	}
+
+	authenticatedUser, err := h.verifyLoginUC.Execute(c.Request.Context(), user.VerifyMFALoginInput{
+		UserID:       claims.UserID,
+		TokenVersion: claims.TokenVersion,
+		Code:         req.Code,
+		IP:           c.ClientIP(),
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	authResp, err := newAuthResponse(h.jwtService, authenticatedUser, true)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, authResp)
+}
+
+// GetStatus returns the current user's two-factor setup
+// @Summary Get two-factor status
+// @Description Get whether two-factor authentication is enabled and how many recovery codes are left
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.MFAStatusResponse
+// @Failure 401 {object} map[string]interface{}
+// @Router /auth/mfa [get]
+func (h *MFAHandler) GetStatus(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	status, err := h.getStatusUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MFAStatusResponse{
+		Enabled:                status.Enabled,
+		EnabledAt:              status.EnabledAt,
+		RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+	})
+}
+
+// Enroll starts TOTP enrollment
+// @Summary Start TOTP enrollment
+// @Description Generate a TOTP secret and otpauth URI for an authenticator app. Two-factor authentication is enabled once a code is confirmed.
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.TOTPEnrollmentResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{} "Already enabled"
+// @Router /auth/mfa/totp/enroll [post]
+func (h *MFAHandler) Enroll(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	enrollment, err := h.enrollUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TOTPEnrollmentResponse{
+		Secret:     enrollment.Secret,
+		OTPAuthURI: enrollment.URI,
+	})
+}
+
+// Confirm finishes TOTP enrollment
+// @Summary Confirm TOTP enrollment
+// @Description Enable two-factor authentication with a code from the authenticator app. Other sessions are revoked; the response carries new tokens and the recovery codes.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MFACodeRequest true "TOTP code"
+// @Success 200 {object} dto.MFAEnabledResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{} "Invalid code"
+// @Failure 409 {object} map[string]interface{} "Already enabled"
+// @Router /auth/mfa/totp/confirm [post]
+func (h *MFAHandler) Confirm(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	var req dto.MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	result, err := h.confirmUC.Execute(c.Request.Context(), user.MFACodeInput{
+		UserID: userID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	authResp, err := newAuthResponse(h.jwtService, result.User, true)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MFAEnabledResponse{
+		AuthResponse:  authResp,
+		RecoveryCodes: result.RecoveryCodes,
+	})
+}
+
+// Disable turns two-factor authentication off
+// @Summary Disable two-factor authentication
+// @Description Remove the TOTP secret and recovery codes after checking a TOTP or recovery code
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MFACodeRequest true "TOTP or recovery code"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{} "Invalid code"
+// @Router /auth/mfa/totp/disable [post]
+func (h *MFAHandler) Disable(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	var req dto.MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	if err := h.disableUC.Execute(c.Request.Context(), user.MFACodeInput{
+		UserID: userID,
+		Code:   req.Code,
+	}); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Two-factor authentication disabled"})
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes
+// @Summary Regenerate recovery codes
+// @Description Invalidate every recovery code and issue a new set after checking a TOTP or recovery code
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MFACodeRequest true "TOTP or recovery code"
+// @Success 200 {object} dto.RecoveryCodesResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{} "Invalid code"
+// @Router /auth/mfa/recovery-codes [post]
+func (h *MFAHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	var req dto.MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	codes, err := h.regenerateCodeUC.Execute(c.Request.Context(), user.MFACodeInput{
+		UserID: userID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RecoveryCodesResponse{RecoveryCodes: codes})
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/infrastructure/auth"
+	"github.com/yourusername/viblog/internal/infrastructure/oauth"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/usecase/user"
+)
+
+// oauthStateCookie keeps the state of a started login in the browser that
+// started it, so the callback can tell the login was not started elsewhere
+const (
+	oauthStateCookie     = "oauth_state"
+	oauthStateCookiePath = "/api/v1/auth/oauth"
+)
+
+// OAuthHandler handles social login through OAuth2/OIDC providers
+type OAuthHandler struct {
+	registry     *oauth.Registry
+	startOAuthUC *user.StartOAuthUseCase
+	oauthLoginUC *user.OAuthLoginUseCase
+	jwtService   *auth.JWTService
+	secureCookie bool // Only send the state cookie over HTTPS
+}
+
+// NewOAuthHandler creates a new OAuthHandler
+func NewOAuthHandler(
+	registry *oauth.Registry,
+	startOAuthUC *user.StartOAuthUseCase,
+	oauthLoginUC *user.OAuthLoginUseCase,
+	jwtService *auth.JWTService,
+	secureCookie bool,
+) *OAuthHandler {
+	return &OAuthHandler{
+		registry:     registry,
+		startOAuthUC: startOAuthUC,
+		oauthLoginUC: oauthLoginUC,
+		jwtService:   jwtService,
+		secureCookie: secureCookie,
+	}
+}
+
+// ListProviders lists the enabled social login providers
+// @Summary List social login providers
+// @Description Get the names of the OAuth providers that are configured
+// @Tags auth
+// @Produce json
+// @Success 200 {object} dto.OAuthProvidersResponse
+// @Router /auth/oauth/providers [get]
+func (h *OAuthHandler) ListProviders(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.OAuthProvidersResponse{
+		Providers: h.registry.Names(),
+	})
+}
+
+// Authorize starts a social login
+// @Summary Start social login
+// @Description Create a state and PKCE challenge and return the provider consent URL. The frontend redirects the user there. The state is also set in an HttpOnly cookie that the callback must carry.
+// @Tags auth
+// @Produce json
+// @Param provider path string true "Provider name" Enums(github, google)
+// @Success 200 {object} dto.OAuthAuthorizeResponse
+// @Failure 404 {object} map[string]interface{}
+// @Failure 429 {object} map[string]interface{}
+// @Router /auth/oauth/{provider}/authorize [get]
+func (h *OAuthHandler) Authorize(c *gin.Context) {
+	result, err := h.startOAuthUC.Execute(c.Request.Context(), c.Param("provider"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	h.setStateCookie(c, result.State, int(time.Until(result.ExpiresAt).Seconds()))
+	c.JSON(http.StatusOK, dto.OAuthAuthorizeResponse{
+		AuthorizationURL: result.AuthorizationURL,
+	})
+}
+
+// Callback completes a social login
+// @Summary Complete social login
+// @Description Exchange the code and state from the provider redirect for access/refresh tokens. The identity is linked to the account with the same verified email, or a new account is created. Users with two-factor authentication get a dto.MFAChallengeResponse instead.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param provider path string true "Provider name" Enums(github, google)
+// @Param request body dto.OAuthCallbackRequest true "Redirect parameters"
+// @Success 200 {object} dto.AuthResponse
+// @Failure 400 {object} map[string]interface{} "Invalid state, missing state cookie or unverified email"
+// @Failure 404 {object} map[string]interface{}
+// @Failure 429 {object} map[string]interface{}
+// @Failure 502 {object} map[string]interface{} "Provider exchange failed"
+// @Router /auth/oauth/{provider}/callback [post]
+func (h *OAuthHandler) Callback(c *gin.Context) {
+	var req dto.OAuthCallbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	// A missing cookie leaves BrowserState empty, which never matches
+	browserState, _ := c.Cookie(oauthStateCookie)
+	h.setStateCookie(c, "", -1)
+
+	result, err := h.oauthLoginUC.Execute(c.Request.Context(), user.OAuthLoginInput{
+		Provider:     c.Param("provider"),
+		Code:         req.Code,
+		State:        req.State,
+		IP:           c.ClientIP(),
+		BrowserState: browserState,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondLogin(c, h.jwtService, result)
+}
+
+// setStateCookie sets the HttpOnly state cookie, or deletes it when maxAge is negative
+func (h *OAuthHandler) setStateCookie(c *gin.Context, state string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oauthStateCookie, state, maxAge, oauthStateCookiePath, "", h.secureCookie, true)
+}
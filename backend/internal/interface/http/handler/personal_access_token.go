@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
+	"github.com/yourusername/viblog/internal/usecase/user"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// PersonalAccessTokenHandler handles the current user's personal access tokens
+type PersonalAccessTokenHandler struct {
+	listUC   *user.ListPersonalAccessTokensUseCase
+	createUC *user.CreatePersonalAccessTokenUseCase
+	revokeUC *user.RevokePersonalAccessTokenUseCase
+}
+
+// NewPersonalAccessTokenHandler creates a new PersonalAccessTokenHandler
+func NewPersonalAccessTokenHandler(
+	listUC *user.ListPersonalAccessTokensUseCase,
+	createUC *user.CreatePersonalAccessTokenUseCase,
+	revokeUC *user.RevokePersonalAccessTokenUseCase,
+) *PersonalAccessTokenHandler {
+	return &PersonalAccessTokenHandler{
+		listUC:   listUC,
+		createUC: createUC,
+		revokeUC: revokeUC,
+	}
+}
+
+// List lists the current user's tokens
+// @Summary List personal access tokens
+// @Description Get the current user's personal access tokens. Secrets are never returned.
+// @Tags me
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.PersonalAccessTokensListResponse
+// @Failure 401 {object} map[string]interface{}
+// @Router /me/tokens [get]
+func (h *PersonalAccessTokenHandler) List(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	tokens, err := h.listUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := dto.PersonalAccessTokensListResponse{
+		Tokens: make([]dto.PersonalAccessTokenResponse, len(tokens)),
+	}
+	for i := range tokens {
+		response.Tokens[i] = newPersonalAccessTokenResponse(&tokens[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Create creates a token
+// @Summary Create personal access token
+// @Description Create a token for scripts and CI, limited to scopes your role grants (e.g. posts:write). Use it as a Bearer token. The token is shown only once.
+// @Tags me
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreatePersonalAccessTokenRequest true "Token name, scopes and expiry"
+// @Success 201 {object} dto.CreatePersonalAccessTokenResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Router /me/tokens [post]
+func (h *PersonalAccessTokenHandler) Create(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	var req dto.CreatePersonalAccessTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	output, err := h.createUC.Execute(c.Request.Context(), user.CreatePersonalAccessTokenInput{
+		UserID:        userID,
+		Name:          req.Name,
+		Scopes:        req.Scopes,
+		ExpiresInDays: req.ExpiresInDays,
+		MFA:           c.GetBool(middleware.MFAKey),
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreatePersonalAccessTokenResponse{
+		PersonalAccessTokenResponse: newPersonalAccessTokenResponse(output.PersonalAccessToken),
+		Token:                       output.Token,
+	})
+}
+
+// Revoke revokes a token
+// @Summary Revoke personal access token
+// @Description Delete one of the current user's personal access tokens. It stops working immediately.
+// @Tags me
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Token ID"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /me/tokens/{id} [delete]
+func (h *PersonalAccessTokenHandler) Revoke(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	tokenID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	if err := h.revokeUC.Execute(c.Request.Context(), userID, uint(tokenID)); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Token revoked successfully"})
+}
+
+// newPersonalAccessTokenResponse converts a token to its response
+func newPersonalAccessTokenResponse(t *entity.PersonalAccessToken) dto.PersonalAccessTokenResponse {
+	scopes := t.ScopeList()
+	names := make([]string, len(scopes))
+	for i, s := range scopes {
+		names[i] = string(s)
+	}
+
+	return dto.PersonalAccessTokenResponse{
+		ID:         t.ID,
+		Name:       t.Name,
+		Prefix:     entity.PersonalAccessTokenPrefix + t.Prefix,
+		Scopes:     names,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		LastUsedIP: t.LastUsedIP,
+		CreatedAt:  t.CreatedAt,
+	}
+}
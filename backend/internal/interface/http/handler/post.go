@@ -1,25 +1,49 @@
 package handler
 
 import (
+	stderrors "errors"
+	"io"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
 	"github.com/yourusername/viblog/internal/interface/http/presenter"
 	postUseCase "github.com/yourusername/viblog/internal/usecase/post"
+	"github.com/yourusername/viblog/pkg/utils"
 )
 
 // PostHandler handles post-related HTTP requests
 type PostHandler struct {
-	listUseCase *postUseCase.ListUseCase
-	getUseCase  *postUseCase.GetUseCase
+	listUseCase           *postUseCase.ListUseCase
+	getUseCase            *postUseCase.GetUseCase
+	viewUseCase           *postUseCase.RecordViewUseCase
+	searchUseCase         *postUseCase.SearchUseCase
+	byTaxonomyUseCase     *postUseCase.ListByTaxonomyUseCase
+	listCategoriesUseCase *postUseCase.ListCategoriesUseCase
+	listTagsUseCase       *postUseCase.ListTagsUseCase
 }
 
 // NewPostHandler creates a new PostHandler
-func NewPostHandler(listUseCase *postUseCase.ListUseCase, getUseCase *postUseCase.GetUseCase) *PostHandler {
+func NewPostHandler(
+	listUseCase *postUseCase.ListUseCase,
+	getUseCase *postUseCase.GetUseCase,
+	viewUseCase *postUseCase.RecordViewUseCase,
+	searchUseCase *postUseCase.SearchUseCase,
+	byTaxonomyUseCase *postUseCase.ListByTaxonomyUseCase,
+	listCategoriesUseCase *postUseCase.ListCategoriesUseCase,
+	listTagsUseCase *postUseCase.ListTagsUseCase,
+) *PostHandler {
 	return &PostHandler{
-		listUseCase: listUseCase,
-		getUseCase:  getUseCase,
+		listUseCase:           listUseCase,
+		getUseCase:            getUseCase,
+		viewUseCase:           viewUseCase,
+		searchUseCase:         searchUseCase,
+		byTaxonomyUseCase:     byTaxonomyUseCase,
+		listCategoriesUseCase: listCategoriesUseCase,
+		listTagsUseCase:       listTagsUseCase,
 	}
 }
 
@@ -31,6 +55,7 @@ func NewPostHandler(listUseCase *postUseCase.ListUseCase, getUseCase *postUseCas
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
+// @Param lang query string false "Locale (overrides Accept-Language)"
 // @Success 200 {object} map[string]interface{}
 // @Router /posts [get]
 func (h *PostHandler) List(c *gin.Context) {
@@ -39,12 +64,17 @@ func (h *PostHandler) List(c *gin.Context) {
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
 
 	// Get posts
-	posts, total, err := h.listUseCase.Execute(c.Request.Context(), page, limit)
+	posts, total, err := h.listUseCase.Execute(c.Request.Context(), page, limit, middleware.GetLocale(c))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve posts"})
+		respondError(c, err)
 		return
 	}
 
+	h.respondPostList(c, posts, total, page, limit)
+}
+
+// respondPostList writes a page of posts with the current user's likes and bookmarks
+func (h *PostHandler) respondPostList(c *gin.Context, posts []*entity.Post, total int64, page, limit int) {
 	// Get user ID from context (if authenticated)
 	var userID *uint
 	if uid, exists := c.Get("user_id"); exists {
@@ -56,6 +86,7 @@ func (h *PostHandler) List(c *gin.Context) {
 	// Get liked and bookmarked status
 	var likedPosts, bookmarkedPosts map[uint]bool
 	if userID != nil {
+		var err error
 		likedPosts, bookmarkedPosts, err = h.listUseCase.GetLikedAndBookmarkedStatus(c.Request.Context(), posts, *userID)
 		if err != nil {
 			likedPosts = make(map[uint]bool)
@@ -79,6 +110,7 @@ func (h *PostHandler) List(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "Post ID"
+// @Param lang query string false "Locale (overrides Accept-Language)"
 // @Success 200 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Router /posts/{id} [get]
@@ -87,14 +119,14 @@ func (h *PostHandler) Get(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		respondError(c, invalidParamError("id"))
 		return
 	}
 
 	// Get post
-	post, err := h.getUseCase.Execute(c.Request.Context(), uint(id))
+	post, err := h.getUseCase.Execute(c.Request.Context(), uint(id), middleware.GetLocale(c))
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+		respondError(c, err)
 		return
 	}
 
@@ -114,15 +146,28 @@ func (h *PostHandler) Get(c *gin.Context) {
 
 // Search searches posts
 // @Summary Search posts
-// @Description Full-text search across posts
+// @Description Search published posts by title, content and excerpt
 // @Tags posts
 // @Accept json
 // @Produce json
 // @Param q query string true "Search query"
-// @Success 200 {object} map[string]interface{}
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param lang query string false "Locale (overrides Accept-Language)"
+// @Success 200 {object} dto.PostListResponse
+// @Failure 400 {object} map[string]interface{}
 // @Router /posts/search [get]
 func (h *PostHandler) Search(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{"message": "Search posts - TODO"})
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	posts, total, err := h.searchUseCase.Execute(c.Request.Context(), c.Query("q"), page, limit, middleware.GetLocale(c))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	h.respondPostList(c, posts, total, page, limit)
 }
 
 // Create creates a new post
@@ -243,16 +288,51 @@ func (h *PostHandler) Unbookmark(c *gin.Context) {
 
 // IncrementView increments post view count
 // @Summary Increment view count
-// @Description Increment post view count with IP-based duplicate prevention (24-hour window)
+// @Description Increment post view count with IP-based duplicate prevention (24-hour window). The referrer host, UTM parameters of the page URL, device, browser, OS and country are logged with each counted view. The body is optional; the referrer and page URL default to the Referer header, and referrers on the site itself count as direct visits
 // @Tags posts
 // @Accept json
 // @Produce json
 // @Param id path int true "Post ID"
-// @Success 200 {object} map[string]interface{} "View count incremented or already counted"
+// @Param request body dto.RecordViewRequest false "Traffic source"
+// @Success 200 {object} dto.RecordViewResponse "View count incremented or already counted"
+// @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Router /posts/{id}/view [post]
 func (h *PostHandler) IncrementView(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{"message": "Increment view - TODO"})
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	var req dto.RecordViewRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !stderrors.Is(err, io.EOF) {
+		respondError(c, bindError(err))
+		return
+	}
+
+	referer := utils.GetReferer(c.Request)
+	input := postUseCase.RecordViewInput{
+		PostID:    uint(id),
+		IP:        c.ClientIP(),
+		UserAgent: utils.GetUserAgent(c.Request),
+		Referrer:  req.Referrer,
+		PageURL:   req.URL,
+	}
+	if input.Referrer == "" {
+		input.Referrer = referer
+	}
+	if input.PageURL == "" {
+		input.PageURL = referer
+	}
+
+	counted, err := h.viewUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RecordViewResponse{Counted: counted})
 }
 
 // ListCategories lists all categories
@@ -261,10 +341,17 @@ func (h *PostHandler) IncrementView(c *gin.Context) {
 // @Tags categories
 // @Accept json
 // @Produce json
-// @Success 200 {object} map[string]interface{}
+// @Param lang query string false "Locale (overrides Accept-Language)"
+// @Success 200 {object} dto.CategoriesListResponse
 // @Router /categories [get]
 func (h *PostHandler) ListCategories(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{"message": "List categories - TODO"})
+	categories, err := h.listCategoriesUseCase.Execute(c.Request.Context(), middleware.GetLocale(c))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentCategoriesList(categories))
 }
 
 // GetPostsByCategory gets posts by category
@@ -276,11 +363,21 @@ func (h *PostHandler) ListCategories(c *gin.Context) {
 // @Param slug path string true "Category slug"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
-// @Success 200 {object} map[string]interface{}
+// @Param lang query string false "Locale (overrides Accept-Language)"
+// @Success 200 {object} dto.PostListResponse
 // @Failure 404 {object} map[string]interface{}
 // @Router /categories/{slug}/posts [get]
 func (h *PostHandler) GetPostsByCategory(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{"message": "Get posts by category - TODO"})
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	posts, total, err := h.byTaxonomyUseCase.ByCategory(c.Request.Context(), c.Param("slug"), page, limit, middleware.GetLocale(c))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	h.respondPostList(c, posts, total, page, limit)
 }
 
 // ListTags lists all tags
@@ -289,10 +386,17 @@ func (h *PostHandler) GetPostsByCategory(c *gin.Context) {
 // @Tags tags
 // @Accept json
 // @Produce json
-// @Success 200 {object} map[string]interface{}
+// @Param lang query string false "Locale (overrides Accept-Language)"
+// @Success 200 {object} dto.TagsListResponse
 // @Router /tags [get]
 func (h *PostHandler) ListTags(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{"message": "List tags - TODO"})
+	tags, err := h.listTagsUseCase.Execute(c.Request.Context(), middleware.GetLocale(c))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentTagsList(tags))
 }
 
 // GetPostsByTag gets posts by tag
@@ -304,9 +408,19 @@ func (h *PostHandler) ListTags(c *gin.Context) {
 // @Param slug path string true "Tag slug"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
-// @Success 200 {object} map[string]interface{}
+// @Param lang query string false "Locale (overrides Accept-Language)"
+// @Success 200 {object} dto.PostListResponse
 // @Failure 404 {object} map[string]interface{}
 // @Router /tags/{slug}/posts [get]
 func (h *PostHandler) GetPostsByTag(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{"message": "Get posts by tag - TODO"})
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	posts, total, err := h.byTaxonomyUseCase.ByTag(c.Request.Context(), c.Param("slug"), page, limit, middleware.GetLocale(c))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	h.respondPostList(c, posts, total, page, limit)
 }
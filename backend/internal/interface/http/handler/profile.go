@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/interface/http/presenter"
+	"github.com/yourusername/viblog/internal/usecase/user"
+)
+
+// ProfileHandler handles public user profiles
+type ProfileHandler struct {
+	getPublicProfileUC *user.GetPublicProfileUseCase
+}
+
+// NewProfileHandler creates a new ProfileHandler
+func NewProfileHandler(getPublicProfileUC *user.GetPublicProfileUseCase) *ProfileHandler {
+	return &ProfileHandler{
+		getPublicProfileUC: getPublicProfileUC,
+	}
+}
+
+// Get retrieves a public profile
+// @Summary Get public user profile
+// @Description Get a user's public profile by nickname: avatar, bio, join date, comment count and recent comments on published posts. Activity is null when the user hides it. Email addresses are never included. Banned users and accounts scheduled for deletion are not found.
+// @Tags users
+// @Produce json
+// @Param nickname path string true "Nickname"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Comments per page" default(20)
+// @Success 200 {object} dto.PublicProfileResponse
+// @Failure 404 {object} map[string]interface{}
+// @Router /users/{nickname} [get]
+func (h *ProfileHandler) Get(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	profile, err := h.getPublicProfileUC.Execute(c.Request.Context(), user.GetPublicProfileInput{
+		Nickname: c.Param("nickname"),
+		Page:     page,
+		Limit:    limit,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentPublicProfile(profile))
+}
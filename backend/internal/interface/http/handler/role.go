@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
+	"github.com/yourusername/viblog/internal/interface/http/presenter"
+	"github.com/yourusername/viblog/internal/usecase/admin"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// RoleHandler handles admin management of user roles
+type RoleHandler struct {
+	assignRoleUC *admin.AssignRoleUseCase
+}
+
+// NewRoleHandler creates a new RoleHandler
+func NewRoleHandler(assignRoleUC *admin.AssignRoleUseCase) *RoleHandler {
+	return &RoleHandler{
+		assignRoleUC: assignRoleUC,
+	}
+}
+
+// ListRoles lists the assignable roles
+// @Summary List roles
+// @Description Get the roles that can be assigned and the permissions each grants (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.RolesListResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /admin/roles [get]
+func (h *RoleHandler) ListRoles(c *gin.Context) {
+	c.JSON(http.StatusOK, presenter.PresentRolesList(entity.Roles()))
+}
+
+// AssignRole changes a user's role
+// @Summary Assign role
+// @Description Change a user's role. The user's existing sessions are revoked (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "User ID"
+// @Param request body dto.AssignRoleRequest true "Role"
+// @Success 200 {object} dto.AdminUserResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Router /admin/users/{id}/role [put]
+func (h *RoleHandler) AssignRole(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	var req dto.AssignRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	updated, err := h.assignRoleUC.Execute(c.Request.Context(), admin.AssignRoleInput{
+		ActorID: actorID,
+		UserID:  uint(userID),
+		Role:    entity.Role(req.Role),
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentUser(updated))
+}
@@ -0,0 +1,290 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/presenter"
+	"github.com/yourusername/viblog/internal/usecase/admin"
+)
+
+// TranslationHandler handles admin management of localized content
+type TranslationHandler struct {
+	listPostTranslationsUC      *admin.ListPostTranslationsUseCase
+	upsertPostTranslationUC     *admin.UpsertPostTranslationUseCase
+	deletePostTranslationUC     *admin.DeletePostTranslationUseCase
+	upsertCategoryTranslationUC *admin.UpsertCategoryTranslationUseCase
+	deleteCategoryTranslationUC *admin.DeleteCategoryTranslationUseCase
+	upsertTagTranslationUC      *admin.UpsertTagTranslationUseCase
+	deleteTagTranslationUC      *admin.DeleteTagTranslationUseCase
+}
+
+// NewTranslationHandler creates a new TranslationHandler
+func NewTranslationHandler(
+	listPostTranslationsUC *admin.ListPostTranslationsUseCase,
+	upsertPostTranslationUC *admin.UpsertPostTranslationUseCase,
+	deletePostTranslationUC *admin.DeletePostTranslationUseCase,
+	upsertCategoryTranslationUC *admin.UpsertCategoryTranslationUseCase,
+	deleteCategoryTranslationUC *admin.DeleteCategoryTranslationUseCase,
+	upsertTagTranslationUC *admin.UpsertTagTranslationUseCase,
+	deleteTagTranslationUC *admin.DeleteTagTranslationUseCase,
+) *TranslationHandler {
+	return &TranslationHandler{
+		listPostTranslationsUC:      listPostTranslationsUC,
+		upsertPostTranslationUC:     upsertPostTranslationUC,
+		deletePostTranslationUC:     deletePostTranslationUC,
+		upsertCategoryTranslationUC: upsertCategoryTranslationUC,
+		deleteCategoryTranslationUC: deleteCategoryTranslationUC,
+		upsertTagTranslationUC:      upsertTagTranslationUC,
+		deleteTagTranslationUC:      deleteTagTranslationUC,
+	}
+}
+
+// ListPostTranslations lists the translations of a post
+// @Summary List post translations
+// @Description Get every translation of a post (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Post ID"
+// @Success 200 {object} dto.PostTranslationsListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/posts/{id}/translations [get]
+func (h *TranslationHandler) ListPostTranslations(c *gin.Context) {
+	postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	translations, err := h.listPostTranslationsUC.Execute(c.Request.Context(), uint(postID))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentPostTranslationsList(translations))
+}
+
+// UpsertPostTranslation creates or replaces a post translation
+// @Summary Save post translation
+// @Description Create or replace the translation of a post for a locale (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Post ID"
+// @Param locale path string true "Locale"
+// @Param request body dto.UpsertPostTranslationRequest true "Post translation"
+// @Success 200 {object} dto.PostTranslationResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/posts/{id}/translations/{locale} [put]
+func (h *TranslationHandler) UpsertPostTranslation(c *gin.Context) {
+	postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	var req dto.UpsertPostTranslationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	input := admin.UpsertPostTranslationInput{
+		Title:           req.Title,
+		Excerpt:         req.Excerpt,
+		Content:         req.Content,
+		MetaTitle:       req.MetaTitle,
+		MetaDescription: req.MetaDescription,
+		MetaKeywords:    req.MetaKeywords,
+	}
+
+	translation, err := h.upsertPostTranslationUC.Execute(c.Request.Context(), uint(postID), c.Param("locale"), input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentPostTranslation(translation))
+}
+
+// DeletePostTranslation deletes a post translation
+// @Summary Delete post translation
+// @Description Delete the translation of a post for a locale (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Post ID"
+// @Param locale path string true "Locale"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /admin/posts/{id}/translations/{locale} [delete]
+func (h *TranslationHandler) DeletePostTranslation(c *gin.Context) {
+	postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	if err := h.deletePostTranslationUC.Execute(c.Request.Context(), uint(postID), c.Param("locale")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Translation deleted successfully"})
+}
+
+// UpsertCategoryTranslation creates or replaces a category translation
+// @Summary Save category translation
+// @Description Create or replace the translation of a category for a locale (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Category ID"
+// @Param locale path string true "Locale"
+// @Param request body dto.UpsertCategoryTranslationRequest true "Category translation"
+// @Success 200 {object} dto.CategoryTranslationResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/categories/{id}/translations/{locale} [put]
+func (h *TranslationHandler) UpsertCategoryTranslation(c *gin.Context) {
+	categoryID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	var req dto.UpsertCategoryTranslationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	input := admin.UpsertCategoryTranslationInput{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	translation, err := h.upsertCategoryTranslationUC.Execute(c.Request.Context(), uint(categoryID), c.Param("locale"), input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentCategoryTranslation(translation))
+}
+
+// DeleteCategoryTranslation deletes a category translation
+// @Summary Delete category translation
+// @Description Delete the translation of a category for a locale (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Category ID"
+// @Param locale path string true "Locale"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /admin/categories/{id}/translations/{locale} [delete]
+func (h *TranslationHandler) DeleteCategoryTranslation(c *gin.Context) {
+	categoryID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	if err := h.deleteCategoryTranslationUC.Execute(c.Request.Context(), uint(categoryID), c.Param("locale")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Translation deleted successfully"})
+}
+
+// UpsertTagTranslation creates or replaces a tag translation
+// @Summary Save tag translation
+// @Description Create or replace the translation of a tag for a locale (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Tag ID"
+// @Param locale path string true "Locale"
+// @Param request body dto.UpsertTagTranslationRequest true "Tag translation"
+// @Success 200 {object} dto.TagTranslationResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/tags/{id}/translations/{locale} [put]
+func (h *TranslationHandler) UpsertTagTranslation(c *gin.Context) {
+	tagID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	var req dto.UpsertTagTranslationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	translation, err := h.upsertTagTranslationUC.Execute(c.Request.Context(), uint(tagID), c.Param("locale"), admin.UpsertTagTranslationInput{
+		Name: req.Name,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentTagTranslation(translation))
+}
+
+// DeleteTagTranslation deletes a tag translation
+// @Summary Delete tag translation
+// @Description Delete the translation of a tag for a locale (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Tag ID"
+// @Param locale path string true "Locale"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /admin/tags/{id}/translations/{locale} [delete]
+func (h *TranslationHandler) DeleteTagTranslation(c *gin.Context) {
+	tagID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	if err := h.deleteTagTranslationUC.Execute(c.Request.Context(), uint(tagID), c.Param("locale")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Translation deleted successfully"})
+}
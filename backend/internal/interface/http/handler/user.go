@@ -4,8 +4,10 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/infrastructure/auth"
 	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
 	"github.com/yourusername/viblog/internal/usecase/user"
 	"github.com/yourusername/viblog/pkg/errors"
 )
@@ -16,6 +18,8 @@ type UserHandler struct {
 	loginUseCase         *user.LoginUseCase
 	getProfileUseCase    *user.GetProfileUseCase
 	updateProfileUseCase *user.UpdateProfileUseCase
+	validateSession      *user.ValidateSessionUseCase
+	requestVerification  *user.RequestEmailVerificationUseCase
 	jwtService           *auth.JWTService
 }
 
@@ -25,6 +29,8 @@ func NewUserHandler(
 	loginUseCase *user.LoginUseCase,
 	getProfileUseCase *user.GetProfileUseCase,
 	updateProfileUseCase *user.UpdateProfileUseCase,
+	validateSession *user.ValidateSessionUseCase,
+	requestVerification *user.RequestEmailVerificationUseCase,
 	jwtService *auth.JWTService,
 ) *UserHandler {
 	return &UserHandler{
@@ -32,6 +38,8 @@ func NewUserHandler(
 		loginUseCase:         loginUseCase,
 		getProfileUseCase:    getProfileUseCase,
 		updateProfileUseCase: updateProfileUseCase,
+		validateSession:      validateSession,
+		requestVerification:  requestVerification,
 		jwtService:           jwtService,
 	}
 }
@@ -50,7 +58,7 @@ func NewUserHandler(
 func (h *UserHandler) Register(c *gin.Context) {
 	var req dto.RegisterRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, bindError(err))
 		return
 	}
 
@@ -59,23 +67,36 @@ func (h *UserHandler) Register(c *gin.Context) {
 		Email:    req.Email,
 		Password: req.Password,
 		Nickname: req.Nickname,
+		IP:       c.ClientIP(),
 	})
 
 	if err != nil {
-		statusCode := errors.GetStatusCode(err)
-		c.JSON(statusCode, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
+	// Send the verification link. Registration succeeds even if delivery
+	// fails; the user can request another link later.
+	if err := h.requestVerification.Execute(c.Request.Context(), user.RequestEmailVerificationInput{
+		Email:  newUser.Email,
+		Locale: middleware.GetLocale(c),
+	}); err != nil {
+		_ = c.Error(err)
+	}
+
 	// Convert to response DTO
 	userResp := dto.UserResponse{
-		ID:        newUser.ID,
-		Email:     newUser.Email,
-		Nickname:  newUser.Nickname,
-		AvatarURL: newUser.AvatarURL,
-		Bio:       newUser.Bio,
-		IsAdmin:   newUser.IsAdmin,
-		CreatedAt: newUser.CreatedAt,
+		ID:            newUser.ID,
+		Email:         newUser.Email,
+		EmailVerified: newUser.IsEmailVerified(),
+		Nickname:      newUser.Nickname,
+		AvatarURL:     newUser.AvatarURL,
+		Bio:           newUser.Bio,
+		IsAdmin:       newUser.IsAdmin,
+		Role:          string(newUser.Role),
+		Permissions:   permissionNames(newUser.Role),
+		HideActivity:  newUser.HideActivity,
+		CreatedAt:     newUser.CreatedAt,
 	}
 
 	c.JSON(http.StatusCreated, userResp)
@@ -83,7 +104,7 @@ func (h *UserHandler) Register(c *gin.Context) {
 
 // Login handles user login
 // @Summary User login
-// @Description Authenticate user and return access/refresh tokens
+// @Description Authenticate user and return access/refresh tokens. Users with two-factor authentication get a dto.MFAChallengeResponse instead and complete the login at /auth/login/mfa.
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -91,56 +112,28 @@ func (h *UserHandler) Register(c *gin.Context) {
 // @Success 200 {object} dto.AuthResponse
 // @Failure 400 {object} object{error=string}
 // @Failure 401 {object} object{error=string} "Invalid credentials"
+// @Failure 429 {object} object{error=string} "Too many failed attempts"
 // @Router /auth/login [post]
 func (h *UserHandler) Login(c *gin.Context) {
 	var req dto.LoginRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, bindError(err))
 		return
 	}
 
 	// Execute login use case
-	authenticatedUser, err := h.loginUseCase.Execute(c.Request.Context(), user.LoginInput{
+	result, err := h.loginUseCase.Execute(c.Request.Context(), user.LoginInput{
 		Email:    req.Email,
 		Password: req.Password,
+		IP:       c.ClientIP(),
 	})
 
 	if err != nil {
-		statusCode := errors.GetStatusCode(err)
-		c.JSON(statusCode, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Generate tokens
-	accessToken, err := h.jwtService.GenerateAccessToken(authenticatedUser.ID, authenticatedUser.Email, authenticatedUser.IsAdmin)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate access token"})
+		respondError(c, err)
 		return
 	}
 
-	refreshToken, err := h.jwtService.GenerateRefreshToken(authenticatedUser.ID, authenticatedUser.Email)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate refresh token"})
-		return
-	}
-
-	// Convert to response DTO
-	authResp := dto.AuthResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		User: dto.UserResponse{
-			ID:          authenticatedUser.ID,
-			Email:       authenticatedUser.Email,
-			Nickname:    authenticatedUser.Nickname,
-			AvatarURL:   authenticatedUser.AvatarURL,
-			Bio:         authenticatedUser.Bio,
-			IsAdmin:     authenticatedUser.IsAdmin,
-			CreatedAt:   authenticatedUser.CreatedAt,
-			LastLoginAt: authenticatedUser.LastLoginAt,
-		},
-	}
-
-	c.JSON(http.StatusOK, authResp)
+	respondLogin(c, h.jwtService, result)
 }
 
 // RefreshToken handles token refresh
@@ -157,21 +150,28 @@ func (h *UserHandler) Login(c *gin.Context) {
 func (h *UserHandler) RefreshToken(c *gin.Context) {
 	var req dto.RefreshTokenRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, bindError(err))
 		return
 	}
 
 	// Validate refresh token
 	claims, err := h.jwtService.ValidateRefreshToken(req.RefreshToken)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		respondError(c, errors.ErrInvalidToken.WithError(err))
 		return
 	}
 
-	// Generate new access token
-	accessToken, err := h.jwtService.GenerateAccessToken(claims.UserID, claims.Email, claims.IsAdmin)
+	// Reject refresh tokens revoked by a password reset
+	sessionUser, err := h.validateSession.Execute(c.Request.Context(), claims.UserID, claims.TokenVersion)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate access token"})
+		respondError(c, err)
+		return
+	}
+
+	// Generate new access token from the current account state
+	accessToken, err := h.jwtService.GenerateAccessToken(sessionUser.ID, sessionUser.Email, sessionUser.Role, sessionUser.TokenVersion, claims.MFA)
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
@@ -210,7 +210,7 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
 	userID, exists := c.Get("userID")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		respondError(c, errors.ErrUnauthorized)
 		return
 	}
 
@@ -220,21 +220,24 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
 	})
 
 	if err != nil {
-		statusCode := errors.GetStatusCode(err)
-		c.JSON(statusCode, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
 	// Convert to response DTO
 	userResp := dto.UserResponse{
-		ID:          userProfile.ID,
-		Email:       userProfile.Email,
-		Nickname:    userProfile.Nickname,
-		AvatarURL:   userProfile.AvatarURL,
-		Bio:         userProfile.Bio,
-		IsAdmin:     userProfile.IsAdmin,
-		CreatedAt:   userProfile.CreatedAt,
-		LastLoginAt: userProfile.LastLoginAt,
+		ID:            userProfile.ID,
+		Email:         userProfile.Email,
+		EmailVerified: userProfile.IsEmailVerified(),
+		Nickname:      userProfile.Nickname,
+		AvatarURL:     userProfile.AvatarURL,
+		Bio:           userProfile.Bio,
+		IsAdmin:       userProfile.IsAdmin,
+		Role:          string(userProfile.Role),
+		Permissions:   permissionNames(userProfile.Role),
+		HideActivity:  userProfile.HideActivity,
+		CreatedAt:     userProfile.CreatedAt,
+		LastLoginAt:   userProfile.LastLoginAt,
 	}
 
 	c.JSON(http.StatusOK, userResp)
@@ -257,41 +260,114 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 	// Get user ID from context
 	userID, exists := c.Get("userID")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		respondError(c, errors.ErrUnauthorized)
 		return
 	}
 
 	var req dto.UpdateProfileRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, bindError(err))
 		return
 	}
 
 	// Execute update profile use case
 	updatedUser, err := h.updateProfileUseCase.Execute(c.Request.Context(), user.UpdateProfileInput{
-		UserID:    userID.(uint),
-		Nickname:  req.Nickname,
-		AvatarURL: req.AvatarURL,
-		Bio:       req.Bio,
+		UserID:       userID.(uint),
+		Nickname:     req.Nickname,
+		AvatarURL:    req.AvatarURL,
+		Bio:          req.Bio,
+		HideActivity: req.HideActivity,
 	})
 
 	if err != nil {
-		statusCode := errors.GetStatusCode(err)
-		c.JSON(statusCode, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
 	// Convert to response DTO
 	userResp := dto.UserResponse{
-		ID:          updatedUser.ID,
-		Email:       updatedUser.Email,
-		Nickname:    updatedUser.Nickname,
-		AvatarURL:   updatedUser.AvatarURL,
-		Bio:         updatedUser.Bio,
-		IsAdmin:     updatedUser.IsAdmin,
-		CreatedAt:   updatedUser.CreatedAt,
-		LastLoginAt: updatedUser.LastLoginAt,
+		ID:            updatedUser.ID,
+		Email:         updatedUser.Email,
+		EmailVerified: updatedUser.IsEmailVerified(),
+		Nickname:      updatedUser.Nickname,
+		AvatarURL:     updatedUser.AvatarURL,
+		Bio:           updatedUser.Bio,
+		IsAdmin:       updatedUser.IsAdmin,
+		Role:          string(updatedUser.Role),
+		Permissions:   permissionNames(updatedUser.Role),
+		HideActivity:  updatedUser.HideActivity,
+		CreatedAt:     updatedUser.CreatedAt,
+		LastLoginAt:   updatedUser.LastLoginAt,
 	}
 
 	c.JSON(http.StatusOK, userResp)
 }
+
+// respondLogin answers a successful password or social login with tokens, or
+// with an MFA challenge when the user still has to present a second factor
+func respondLogin(c *gin.Context, jwtService *auth.JWTService, result *user.LoginOutput) {
+	if result.MFARequired {
+		mfaToken, err := jwtService.GenerateMFAPendingToken(result.User.ID, result.User.Email, result.User.TokenVersion)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.MFAChallengeResponse{
+			MFARequired: true,
+			MFAToken:    mfaToken,
+		})
+		return
+	}
+
+	authResp, err := newAuthResponse(jwtService, result.User, false)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, authResp)
+}
+
+// newAuthResponse issues an access/refresh token pair for an authenticated
+// user. mfa records whether a second factor was presented.
+func newAuthResponse(jwtService *auth.JWTService, u *entity.User, mfa bool) (dto.AuthResponse, error) {
+	accessToken, err := jwtService.GenerateAccessToken(u.ID, u.Email, u.Role, u.TokenVersion, mfa)
+	if err != nil {
+		return dto.AuthResponse{}, err
+	}
+
+	refreshToken, err := jwtService.GenerateRefreshToken(u.ID, u.Email, u.TokenVersion, mfa)
+	if err != nil {
+		return dto.AuthResponse{}, err
+	}
+
+	return dto.AuthResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		User: dto.UserResponse{
+			ID:            u.ID,
+			Email:         u.Email,
+			EmailVerified: u.IsEmailVerified(),
+			Nickname:      u.Nickname,
+			AvatarURL:     u.AvatarURL,
+			Bio:           u.Bio,
+			IsAdmin:       u.IsAdmin,
+			Role:          string(u.Role),
+			Permissions:   permissionNames(u.Role),
+			HideActivity:  u.HideActivity,
+			CreatedAt:     u.CreatedAt,
+			LastLoginAt:   u.LastLoginAt,
+		},
+	}, nil
+}
+
+// permissionNames lists the permissions a role grants as plain strings
+func permissionNames(role entity.Role) []string {
+	perms := role.Permissions()
+	names := make([]string, len(perms))
+	for i, p := range perms {
+		names[i] = string(p)
+	}
+	return names
+}
@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
+	"github.com/yourusername/viblog/internal/interface/http/presenter"
+	"github.com/yourusername/viblog/internal/usecase/admin"
+	"github.com/yourusername/viblog/internal/usecase/user"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// UserModerationHandler handles admin actions on individual users
+type UserModerationHandler struct {
+	updateUserUC         *admin.UpdateUserUseCase
+	suspendUserUC        *admin.SuspendUserUseCase
+	banUserUC            *admin.BanUserUseCase
+	reinstateUserUC      *admin.ReinstateUserUseCase
+	forcePasswordResetUC *user.ForcePasswordResetUseCase
+}
+
+// NewUserModerationHandler creates a new UserModerationHandler
+func NewUserModerationHandler(
+	updateUserUC *admin.UpdateUserUseCase,
+	suspendUserUC *admin.SuspendUserUseCase,
+	banUserUC *admin.BanUserUseCase,
+	reinstateUserUC *admin.ReinstateUserUseCase,
+	forcePasswordResetUC *user.ForcePasswordResetUseCase,
+) *UserModerationHandler {
+	return &UserModerationHandler{
+		updateUserUC:         updateUserUC,
+		suspendUserUC:        suspendUserUC,
+		banUserUC:            banUserUC,
+		reinstateUserUC:      reinstateUserUC,
+		forcePasswordResetUC: forcePasswordResetUC,
+	}
+}
+
+// UpdateUser edits a user's nickname and avatar
+// @Summary Edit user
+// @Description Change a user's nickname or avatar, e.g. to remove offensive ones. An empty avatar_url removes the avatar (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "User ID"
+// @Param request body dto.AdminUpdateUserRequest true "Nickname and avatar"
+// @Success 200 {object} dto.AdminUserResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Router /admin/users/{id} [patch]
+func (h *UserModerationHandler) UpdateUser(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	var req dto.AdminUpdateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	updated, err := h.updateUserUC.Execute(c.Request.Context(), admin.UpdateUserInput{
+		UserID:    uint(userID),
+		Nickname:  req.Nickname,
+		AvatarURL: req.AvatarURL,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentUser(updated))
+}
+
+// SuspendUser suspends a user until a given time
+// @Summary Suspend user
+// @Description Block a user from signing in and from using existing sessions until the given time. Admins must be demoted first (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "User ID"
+// @Param request body dto.SuspendUserRequest true "Reason and expiry"
+// @Success 200 {object} dto.AdminUserResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/users/{id}/suspend [post]
+func (h *UserModerationHandler) SuspendUser(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	var req dto.SuspendUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	updated, err := h.suspendUserUC.Execute(c.Request.Context(), admin.SuspendUserInput{
+		ActorID: actorID,
+		UserID:  uint(userID),
+		Reason:  req.Reason,
+		Until:   req.Until,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentUser(updated))
+}
+
+// BanUser bans a user
+// @Summary Ban user
+// @Description Permanently block a user and revoke their sessions until an admin reinstates them. Admins must be demoted first (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "User ID"
+// @Param request body dto.BanUserRequest true "Reason"
+// @Success 200 {object} dto.AdminUserResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/users/{id}/ban [post]
+func (h *UserModerationHandler) BanUser(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		respondError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	var req dto.BanUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, bindError(err))
+		return
+	}
+
+	updated, err := h.banUserUC.Execute(c.Request.Context(), admin.BanUserInput{
+		ActorID: actorID,
+		UserID:  uint(userID),
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentUser(updated))
+}
+
+// ReinstateUser lifts a user's suspension and ban
+// @Summary Reinstate user
+// @Description Lift any suspension or ban of a user (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "User ID"
+// @Success 200 {object} dto.AdminUserResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/users/{id}/reinstate [post]
+func (h *UserModerationHandler) ReinstateUser(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	updated, err := h.reinstateUserUC.Execute(c.Request.Context(), uint(userID))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.PresentUser(updated))
+}
+
+// ForcePasswordReset makes a user choose a new password
+// @Summary Force password reset
+// @Description Invalidate a user's password and sessions and email them a reset link (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "User ID"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /admin/users/{id}/password-reset [post]
+func (h *UserModerationHandler) ForcePasswordReset(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		respondError(c, invalidParamError("id"))
+		return
+	}
+
+	if err := h.forcePasswordResetUC.Execute(c.Request.Context(), user.ForcePasswordResetInput{
+		UserID: uint(userID),
+		Locale: middleware.GetLocale(c),
+	}); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Password reset link queued"})
+}
@@ -0,0 +1,200 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"go.uber.org/zap"
+)
+
+// maxAuditBody caps how much of a request or response is kept for the audit log
+const maxAuditBody = 64 << 10
+
+// auditRedactedKeys are request fields never written to the audit log
+var auditRedactedKeys = []string{"password", "token", "secret"}
+
+// Auditor loads audit targets and appends to the audit log
+type Auditor interface {
+	// Snapshot loads the current state of a target, or nil when it does not exist
+	Snapshot(ctx context.Context, targetType entity.AuditTargetType, id uint) (interface{}, error)
+
+	// Record appends an entry to the audit log
+	Record(ctx context.Context, log *entity.AuditLog) error
+}
+
+// Audit records a successful request to the audit log as action on a target
+// of targetType. The target is read from the :id route parameter, or from
+// the id field of the response for creations, and snapshotted before and
+// after the handler. Failed requests are not recorded, and failing to record
+// never fails the request. It is a no-op when auditor is nil.
+func Audit(auditor Auditor, logger *zap.Logger, action string, targetType entity.AuditTargetType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if auditor == nil {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		var targetID *uint
+		if id, err := strconv.ParseUint(c.Param("id"), 10, 32); err == nil {
+			v := uint(id)
+			targetID = &v
+		}
+
+		var requestBody []byte
+		if c.Request.Body != nil {
+			requestBody, _ = io.ReadAll(c.Request.Body)
+			c.Request.Body = io.NopCloser(bytes.NewReader(requestBody))
+		}
+
+		var before interface{}
+		if targetID != nil {
+			var err error
+			if before, err = auditor.Snapshot(ctx, targetType, *targetID); err != nil {
+				logger.Warn("Failed to snapshot audit target", zap.String("action", action), zap.Error(err))
+			}
+		}
+
+		writer := &auditWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		c.Next()
+
+		status := c.Writer.Status()
+		actorID, ok := GetUserID(c)
+		if status >= http.StatusBadRequest || !ok {
+			return
+		}
+
+		if targetID == nil {
+			var created struct {
+				ID uint `json:"id"`
+			}
+			if json.Unmarshal(writer.body.Bytes(), &created) == nil && created.ID != 0 {
+				targetID = &created.ID
+			}
+		}
+
+		var after interface{}
+		if targetID != nil {
+			var err error
+			if after, err = auditor.Snapshot(ctx, targetType, *targetID); err != nil {
+				logger.Warn("Failed to snapshot audit target", zap.String("action", action), zap.Error(err))
+			}
+		}
+
+		log := &entity.AuditLog{
+			ActorID:    actorID,
+			Action:     action,
+			TargetType: targetType,
+			TargetID:   targetID,
+			Before:     auditJSON(before),
+			After:      auditJSON(after),
+			Method:     c.Request.Method,
+			Path:       truncateAuditPath(c.Request.URL.Path),
+			Status:     status,
+			IPAddress:  c.ClientIP(),
+			RequestID:  GetRequestID(c),
+		}
+		// Without a target to load, the request tells what was done
+		if log.Before == "" && log.After == "" {
+			log.After = redactedRequest(requestBody)
+		}
+
+		if err := auditor.Record(ctx, log); err != nil {
+			logger.Error("Failed to write audit log",
+				zap.String("action", action),
+				zap.String("request_id", log.RequestID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// auditWriter keeps the start of the response body
+type auditWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *auditWriter) Write(b []byte) (int, error) {
+	w.keep(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *auditWriter) WriteString(s string) (int, error) {
+	w.keep([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *auditWriter) keep(b []byte) {
+	if room := maxAuditBody - w.body.Len(); room > 0 {
+		w.body.Write(b[:min(len(b), room)])
+	}
+}
+
+// auditJSON encodes a snapshot, or returns "" when there is none
+func auditJSON(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// redactedRequest returns a JSON request body without credentials, or ""
+// when it is empty, too large or not JSON
+func redactedRequest(body []byte) string {
+	if len(body) == 0 || len(body) > maxAuditBody {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return ""
+	}
+	return auditJSON(redact(v))
+}
+
+// redact drops credential fields from decoded JSON
+func redact(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for key, value := range t {
+			lower := strings.ToLower(key)
+			dropped := false
+			for _, k := range auditRedactedKeys {
+				if strings.Contains(lower, k) {
+					delete(t, key)
+					dropped = true
+					break
+				}
+			}
+			if !dropped {
+				t[key] = redact(value)
+			}
+		}
+	case []interface{}:
+		for i := range t {
+			t[i] = redact(t[i])
+		}
+	}
+	return v
+}
+
+// truncateAuditPath fits a path into the path column of audit logs
+func truncateAuditPath(path string) string {
+	if len(path) > 255 {
+		return path[:255]
+	}
+	return path
+}
@@ -1,11 +1,13 @@
 package middleware
 
 import (
-	"net/http"
+	"context"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/infrastructure/auth"
+	"github.com/yourusername/viblog/pkg/errors"
 )
 
 const (
@@ -14,62 +16,106 @@ const (
 	UserIDKey           = "userID"
 	UserEmailKey        = "userEmail"
 	IsAdminKey          = "isAdmin"
+	RoleKey             = "role"
+	PermissionsKey      = "permissions"
+	MFAKey              = "mfa"
 )
 
-// AuthMiddleware validates JWT tokens from Authorization header
-func AuthMiddleware(jwtService *auth.JWTService) gin.HandlerFunc {
+// SessionValidator reports whether the session behind validated token claims
+// is still active, e.g. that it was not revoked by a password reset
+type SessionValidator func(ctx context.Context, claims *auth.TokenClaims) error
+
+// PATAuthenticator resolves a personal access token to its user and the
+// stored token, see user.AuthenticatePersonalAccessTokenUseCase
+type PATAuthenticator func(ctx context.Context, token, ip string) (*entity.User, *entity.PersonalAccessToken, error)
+
+// AuthMiddleware validates JWT tokens from Authorization header. When
+// authenticatePAT is set, personal access tokens are accepted as well; only
+// pass it for routes guarded by RequirePermission, which enforces their scopes.
+func AuthMiddleware(jwtService *auth.JWTService, validateSession SessionValidator, authenticatePAT PATAuthenticator) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader(AuthorizationHeader)
 		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "missing authorization header",
-			})
+			AbortWithAppError(c, errors.ErrUnauthorized.WithDetails(map[string]interface{}{
+				"reason": "missing authorization header",
+			}))
 			return
 		}
 
 		// Extract token from "Bearer <token>"
 		if !strings.HasPrefix(authHeader, BearerPrefix) {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "invalid authorization header format",
-			})
+			AbortWithAppError(c, errors.ErrUnauthorized.WithDetails(map[string]interface{}{
+				"reason": "invalid authorization header format",
+			}))
 			return
 		}
 
 		tokenString := strings.TrimPrefix(authHeader, BearerPrefix)
 
+		if strings.HasPrefix(tokenString, entity.PersonalAccessTokenPrefix) {
+			if authenticatePAT == nil {
+				AbortWithAppError(c, errors.ErrInvalidToken.WithDetails(map[string]interface{}{
+					"reason": "personal access tokens are not accepted for this route",
+				}))
+				return
+			}
+
+			user, pat, err := authenticatePAT(c.Request.Context(), tokenString, c.ClientIP())
+			if err != nil {
+				_ = c.Error(err)
+				c.Abort()
+				return
+			}
+
+			setClaims(c, patClaims(user, pat))
+			c.Next()
+			return
+		}
+
 		// Validate token using JWT service
 		claims, err := jwtService.ValidateAccessToken(tokenString)
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "invalid or expired token",
-			})
+			AbortWithAppError(c, errors.ErrInvalidToken)
 			return
 		}
 
-		// Set user context
-		c.Set(UserIDKey, claims.UserID)
-		c.Set(UserEmailKey, claims.Email)
-		c.Set(IsAdminKey, claims.IsAdmin)
+		if err := validateSession(c.Request.Context(), claims); err != nil {
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+
+		setClaims(c, claims)
 
 		c.Next()
 	}
 }
 
+// patClaims builds the claims of a personal access token. Its permissions
+// are limited to the token's scopes.
+func patClaims(user *entity.User, pat *entity.PersonalAccessToken) *auth.TokenClaims {
+	return &auth.TokenClaims{
+		UserID:       user.ID,
+		Email:        user.Email,
+		IsAdmin:      user.Role == entity.RoleAdmin,
+		TokenVersion: user.TokenVersion,
+		Role:         user.Role,
+		Permissions:  pat.EffectivePermissions(user.Role),
+		MFA:          pat.CreatedWithMFA,
+	}
+}
+
 // RequireAdmin requires admin role
 func RequireAdmin() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		isAdmin, exists := c.Get(IsAdminKey)
 		if !exists {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
-				"error": "user authentication required",
-			})
+			AbortWithAppError(c, errors.ErrUnauthorized)
 			return
 		}
 
 		if !isAdmin.(bool) {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
-				"error": "admin access required",
-			})
+			AbortWithAppError(c, errors.ErrAdminRequired)
 			return
 		}
 
@@ -77,8 +123,55 @@ func RequireAdmin() gin.HandlerFunc {
 	}
 }
 
+// RequirePermission requires the session's role to grant every listed permission
+func RequirePermission(required ...entity.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, exists := c.Get(PermissionsKey)
+		if !exists {
+			AbortWithAppError(c, errors.ErrUnauthorized)
+			return
+		}
+
+		granted, _ := value.([]entity.Permission)
+		for _, permission := range required {
+			if !hasPermission(granted, permission) {
+				AbortWithAppError(c, errors.ErrInsufficientPermission.WithDetails(map[string]interface{}{
+					"permission": string(permission),
+				}))
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// RequireMFA requires the session to have been established with a second
+// factor. It is a no-op when enabled is false so routes can be configured
+// from MFAConfig.RequireForAdmins.
+func RequireMFA(enabled bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if enabled && !c.GetBool(MFAKey) {
+			AbortWithAppError(c, errors.ErrMFARequired)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// hasPermission reports whether permission is in granted
+func hasPermission(granted []entity.Permission, permission entity.Permission) bool {
+	for _, p := range granted {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
 // OptionalAuth validates token if present but doesn't require it
-func OptionalAuth(jwtService *auth.JWTService) gin.HandlerFunc {
+func OptionalAuth(jwtService *auth.JWTService, validateSession SessionValidator) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader(AuthorizationHeader)
 		if authHeader == "" {
@@ -91,13 +184,31 @@ func OptionalAuth(jwtService *auth.JWTService) gin.HandlerFunc {
 			tokenString := strings.TrimPrefix(authHeader, BearerPrefix)
 			claims, err := jwtService.ValidateAccessToken(tokenString)
 
-			if err == nil && claims != nil {
-				c.Set(UserIDKey, claims.UserID)
-				c.Set(UserEmailKey, claims.Email)
-				c.Set(IsAdminKey, claims.IsAdmin)
+			if err == nil && claims != nil && validateSession(c.Request.Context(), claims) == nil {
+				setClaims(c, claims)
 			}
 		}
 
 		c.Next()
 	}
 }
+
+// GetUserID returns the authenticated user's ID set by AuthMiddleware or OptionalAuth
+func GetUserID(c *gin.Context) (uint, bool) {
+	userID, exists := c.Get(UserIDKey)
+	if !exists {
+		return 0, false
+	}
+	id, ok := userID.(uint)
+	return id, ok
+}
+
+// setClaims sets the user context from validated claims
+func setClaims(c *gin.Context, claims *auth.TokenClaims) {
+	c.Set(UserIDKey, claims.UserID)
+	c.Set(UserEmailKey, claims.Email)
+	c.Set(IsAdminKey, claims.IsAdmin)
+	c.Set(RoleKey, claims.Role)
+	c.Set(PermissionsKey, claims.Permissions)
+	c.Set(MFAKey, claims.MFA)
+}
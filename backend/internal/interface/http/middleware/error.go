@@ -1,9 +1,11 @@
 package middleware
 
 import (
+	stderrors "errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/pkg/errors"
 )
 
 // ErrorResponse represents a standardized error response
@@ -19,43 +21,43 @@ func ErrorHandler() gin.HandlerFunc {
 	return ErrorHandlerMiddleware()
 }
 
-// ErrorHandlerMiddleware handles errors in a standardized way
+// ErrorHandlerMiddleware renders the last error attached to the context.
+// AppErrors keep their status code and are localized to the request locale;
+// any other error becomes a 500 without exposing its message.
 func ErrorHandlerMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Next()
 
-		// Check if there are any errors
-		if len(c.Errors) > 0 {
-			err := c.Errors.Last()
-
-			// Default error response
-			response := ErrorResponse{
-				Error:   "Internal server error",
-				Message: err.Error(),
-			}
-
-			// Set status code based on error type
-			statusCode := http.StatusInternalServerError
-
-			// Check for custom error types (you can expand this)
-			switch err.Type {
-			case gin.ErrorTypeBind:
-				statusCode = http.StatusBadRequest
-				response.Error = "Invalid request"
-			case gin.ErrorTypePublic:
-				statusCode = http.StatusBadRequest
-			case gin.ErrorTypePrivate:
-				statusCode = http.StatusInternalServerError
-			}
-
-			// If status hasn't been written yet, write it
-			if c.Writer.Status() == http.StatusOK {
-				c.JSON(statusCode, response)
-			}
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
 		}
+
+		err := c.Errors.Last().Err
+
+		var appErr *errors.AppError
+		if !stderrors.As(err, &appErr) {
+			appErr = errors.ErrInternal.WithError(err)
+		}
+
+		c.JSON(appErr.StatusCode, NewAppErrorResponse(appErr, GetLocale(c)))
 	}
 }
 
+// NewAppErrorResponse builds the error envelope for an AppError in the given locale
+func NewAppErrorResponse(err *errors.AppError, locale string) ErrorResponse {
+	return ErrorResponse{
+		Error:   err.LocalizedMessage(locale),
+		Code:    string(err.Code),
+		Details: err.Details,
+	}
+}
+
+// AbortWithAppError aborts the request and leaves rendering to ErrorHandler
+func AbortWithAppError(c *gin.Context, err *errors.AppError) {
+	_ = c.Error(err)
+	c.Abort()
+}
+
 // ValidationErrorResponse represents validation error details
 type ValidationErrorResponse struct {
 	Error  string            `json:"error"`
@@ -65,11 +67,7 @@ type ValidationErrorResponse struct {
 // NotFoundHandler returns a 404 error response
 func NotFoundHandler() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.JSON(http.StatusNotFound, ErrorResponse{
-			Error:   "Not found",
-			Message: "The requested resource was not found",
-			Code:    "NOT_FOUND",
-		})
+		c.JSON(http.StatusNotFound, NewAppErrorResponse(errors.ErrNotFound, GetLocale(c)))
 	}
 }
 
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/pkg/utils"
+)
+
+const (
+	LocaleKey        = "locale"
+	LocaleQueryParam = "lang"
+)
+
+// Locale negotiates the response locale for each request.
+// The ?lang= query parameter takes priority over the Accept-Language header,
+// and defaultLocale is used when neither names a supported locale.
+func Locale(supportedLocales []string, defaultLocale string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		locale := strings.ToLower(c.Query(LocaleQueryParam))
+		if !utils.IsSupportedLanguage(locale, supportedLocales) {
+			locale = utils.GetPreferredLanguageWithDefault(c.Request, supportedLocales, defaultLocale)
+		}
+
+		c.Set(LocaleKey, locale)
+		c.Header("Content-Language", locale)
+		// Add to, rather than replace, Vary headers set by earlier middleware
+		c.Writer.Header().Add("Vary", "Accept-Language")
+
+		c.Next()
+	}
+}
+
+// GetLocale returns the locale negotiated for the request
+func GetLocale(c *gin.Context) string {
+	return c.GetString(LocaleKey)
+}
@@ -46,6 +46,10 @@ func LoggerMiddleware(logger *zap.Logger) gin.HandlerFunc {
 			zap.Duration("latency", latency),
 		}
 
+		if requestID := GetRequestID(c); requestID != "" {
+			fields = append(fields, zap.String("request_id", requestID))
+		}
+
 		if errorMessage != "" {
 			fields = append(fields, zap.String("error", errorMessage))
 		}
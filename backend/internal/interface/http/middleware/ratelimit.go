@@ -1,11 +1,11 @@
 package middleware
 
 import (
-	"net/http"
 	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/pkg/errors"
 )
 
 // RateLimiter implements in-memory token bucket rate limiting
@@ -103,9 +103,7 @@ func RateLimitMiddleware(limiter *RateLimiter) gin.HandlerFunc {
 		identifier := c.ClientIP()
 
 		if !limiter.Allow(identifier) {
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
-				"error": "rate limit exceeded",
-			})
+			AbortWithAppError(c, errors.ErrRateLimitExceeded)
 			return
 		}
 
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/pkg/utils"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+	RequestIDKey    = "requestID"
+
+	// maxRequestIDLength matches the request_id column of audit logs
+	maxRequestIDLength = 64
+)
+
+// RequestID tags each request with an ID, echoed in the X-Request-ID
+// response header so log lines and audit entries can be matched to it. A
+// well-formed ID set by a proxy in front is kept.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !isValidRequestID(id) {
+			id, _ = utils.GenerateSecureToken(16)
+		}
+
+		c.Set(RequestIDKey, id)
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// GetRequestID returns the ID of the request
+func GetRequestID(c *gin.Context) string {
+	return c.GetString(RequestIDKey)
+}
+
+// isValidRequestID reports whether id is short and only has characters that
+// are safe to log
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
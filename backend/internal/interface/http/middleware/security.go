@@ -4,11 +4,11 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"html"
-	"net/http"
 	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/yourusername/viblog/pkg/errors"
 )
 
 // XSSProtectionMiddleware sanitizes user input to prevent XSS attacks
@@ -125,9 +125,9 @@ func CSRFMiddleware(config CSRFConfig, store *CSRFTokenStore) gin.HandlerFunc {
 
 		// Verify token
 		if token == "" || !store.Verify(token) {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
-				"error": "invalid or missing CSRF token",
-			})
+			AbortWithAppError(c, errors.ErrForbidden.WithDetails(map[string]interface{}{
+				"reason": "invalid or missing CSRF token",
+			}))
 			return
 		}
 
@@ -2,6 +2,8 @@ package presenter
 
 import (
 	"math"
+	"net"
+	"time"
 
 	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/interface/http/dto"
@@ -10,12 +12,59 @@ import (
 
 // PresentDashboardStats converts dashboard stats to response DTO
 func PresentDashboardStats(stats *admin.DashboardStats) dto.AdminDashboardResponse {
-	return dto.AdminDashboardResponse{
+	response := dto.AdminDashboardResponse{
 		TotalUsers:     stats.TotalUsers,
 		TotalPosts:     stats.TotalPosts,
 		PublishedPosts: stats.PublishedPosts,
 		TotalComments:  stats.TotalComments,
+		Days:           stats.Days,
+		From:           stats.From.Format(time.RFC3339),
+		To:             stats.To.Format(time.RFC3339),
+		Timezone:       stats.Timezone,
+		Series: dto.DashboardSeriesResponse{
+			Views:     presentDailyPoints(stats.Series.Views),
+			Visitors:  presentDailyPoints(stats.Series.Visitors),
+			Likes:     presentDailyPoints(stats.Series.Likes),
+			Comments:  presentDailyPoints(stats.Series.Comments),
+			Bookmarks: presentDailyPoints(stats.Series.Bookmarks),
+			Signups:   presentDailyPoints(stats.Series.Signups),
+		},
+		TopPosts:       make([]dto.DashboardPostResponse, len(stats.TopPosts)),
+		TopTags:        make([]dto.DashboardTagResponse, len(stats.TopTags)),
+		RecentComments: make([]dto.AdminCommentResponse, len(stats.RecentComments)),
 	}
+	for i, post := range stats.TopPosts {
+		response.TopPosts[i] = dto.DashboardPostResponse{
+			ID:       post.PostID,
+			Title:    post.Title,
+			Slug:     post.Slug,
+			Views:    post.Views,
+			Likes:    post.Likes,
+			Comments: post.Comments,
+		}
+	}
+	for i, tag := range stats.TopTags {
+		response.TopTags[i] = dto.DashboardTagResponse{
+			ID:    tag.TagID,
+			Name:  tag.Name,
+			Slug:  tag.Slug,
+			Views: tag.Views,
+			Posts: tag.Posts,
+		}
+	}
+	for i := range stats.RecentComments {
+		response.RecentComments[i] = PresentComment(&stats.RecentComments[i])
+	}
+
+	return response
+}
+
+func presentDailyPoints(points []admin.DailyPoint) []dto.DashboardPointResponse {
+	responses := make([]dto.DashboardPointResponse, len(points))
+	for i, p := range points {
+		responses[i] = dto.DashboardPointResponse{Date: p.Date, Count: p.Count}
+	}
+	return responses
 }
 
 // PresentUser converts a user entity to admin user response
@@ -26,16 +75,30 @@ func PresentUser(user *entity.User) dto.AdminUserResponse {
 		lastLoginAt = &formatted
 	}
 
-	return dto.AdminUserResponse{
+	response := dto.AdminUserResponse{
 		ID:          user.ID,
 		Email:       user.Email,
 		Nickname:    user.Nickname,
 		AvatarURL:   user.AvatarURL,
 		Bio:         user.Bio,
 		IsAdmin:     user.IsAdmin,
+		Role:        string(user.Role),
 		CreatedAt:   user.CreatedAt.Format("2006-01-02T15:04:05Z"),
 		LastLoginAt: lastLoginAt,
+		Status:      string(user.Status(time.Now())),
+		BanReason:   user.BanReason,
 	}
+	if user.IsSuspended(time.Now()) {
+		suspendedUntil := user.SuspendedUntil.Format("2006-01-02T15:04:05Z")
+		response.SuspendedUntil = &suspendedUntil
+		response.SuspensionReason = user.SuspensionReason
+	}
+	if user.BannedAt != nil {
+		bannedAt := user.BannedAt.Format("2006-01-02T15:04:05Z")
+		response.BannedAt = &bannedAt
+	}
+
+	return response
 }
 
 // PresentUsersList converts a list of users to paginated response
@@ -68,7 +131,7 @@ func PresentComment(comment *entity.Comment) dto.AdminCommentResponse {
 		userName = &comment.User.Nickname
 	}
 
-	return dto.AdminCommentResponse{
+	response := dto.AdminCommentResponse{
 		ID:          comment.ID,
 		Content:     comment.Content,
 		PostID:      comment.PostID,
@@ -78,15 +141,34 @@ func PresentComment(comment *entity.Comment) dto.AdminCommentResponse {
 		AuthorName:  comment.AuthorName,
 		AuthorEmail: comment.AuthorEmail,
 		ParentID:    comment.ParentID,
+		Status:      string(comment.Status),
+		ModeratedBy: comment.ModeratedBy,
+		SpamScore:   comment.SpamScore,
 		LikeCount:   comment.LikeCount,
 		IsEdited:    comment.IsEdited,
 		CreatedAt:   comment.CreatedAt.Format("2006-01-02T15:04:05Z"),
 		UpdatedAt:   comment.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}
+	if comment.SpamReasons != "" {
+		response.SpamReasons = &comment.SpamReasons
+	}
+	if network := authorNetwork(comment.AuthorIPHash); network != "" {
+		response.AuthorIP = &network
+	}
+	if comment.ModeratedAt != nil {
+		moderatedAt := comment.ModeratedAt.Format("2006-01-02T15:04:05Z")
+		response.ModeratedAt = &moderatedAt
+	}
+	if comment.PinnedAt != nil {
+		pinnedAt := comment.PinnedAt.Format("2006-01-02T15:04:05Z")
+		response.PinnedAt = &pinnedAt
+	}
+
+	return response
 }
 
 // PresentCommentsList converts a list of comments to paginated response
-func PresentCommentsList(comments []entity.Comment, total int64, page, limit int) dto.AdminCommentsListResponse {
+func PresentCommentsList(comments []entity.Comment, counts map[entity.CommentStatus]int64, total int64, page, limit int) dto.AdminCommentsListResponse {
 	commentResponses := make([]dto.AdminCommentResponse, len(comments))
 	for i, comment := range comments {
 		commentResponses[i] = PresentComment(&comment)
@@ -94,8 +176,227 @@ func PresentCommentsList(comments []entity.Comment, total int64, page, limit int
 
 	totalPages := int(math.Ceil(float64(total) / float64(limit)))
 
+	// Report every status so empty queues show up as 0
+	statusCounts := map[string]int64{}
+	for _, status := range []entity.CommentStatus{
+		entity.CommentStatusPending,
+		entity.CommentStatusApproved,
+		entity.CommentStatusSpam,
+		entity.CommentStatusRejected,
+	} {
+		statusCounts[string(status)] = counts[status]
+	}
+
 	return dto.AdminCommentsListResponse{
-		Comments:   commentResponses,
+		Comments:     commentResponses,
+		StatusCounts: statusCounts,
+		Total:        total,
+		Page:         page,
+		Limit:        limit,
+		TotalPages:   totalPages,
+	}
+}
+
+// PresentAdminPost converts a post entity to admin post response
+func PresentAdminPost(post *entity.Post) dto.AdminPostResponse {
+	response := dto.AdminPostResponse{
+		ID:            post.ID,
+		Title:         post.Title,
+		Slug:          post.Slug,
+		Status:        post.Status,
+		AuthorID:      post.AuthorID,
+		Tags:          make([]dto.AdminPostTermResponse, len(post.Tags)),
+		Locales:       make([]string, len(post.Translations)),
+		ViewCount:     post.ViewCount,
+		LikeCount:     post.LikeCount,
+		CommentCount:  post.CommentCount,
+		BookmarkCount: post.BookmarkCount,
+		CreatedAt:     post.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:     post.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if post.PublishedAt != nil {
+		publishedAt := post.PublishedAt.Format("2006-01-02T15:04:05Z")
+		response.PublishedAt = &publishedAt
+	}
+	if post.Author != nil {
+		response.AuthorName = &post.Author.Nickname
+	}
+	if post.Category != nil {
+		response.Category = &dto.AdminPostTermResponse{
+			ID:   post.Category.ID,
+			Name: post.Category.Name,
+			Slug: post.Category.Slug,
+		}
+	}
+	for i, tag := range post.Tags {
+		response.Tags[i] = dto.AdminPostTermResponse{ID: tag.ID, Name: tag.Name, Slug: tag.Slug}
+	}
+	for i, translation := range post.Translations {
+		response.Locales[i] = translation.Locale
+	}
+
+	return response
+}
+
+// PresentAdminPostsList converts a list of posts to paginated response
+func PresentAdminPostsList(posts []*entity.Post, counts map[entity.PostStatus]int64, total int64, page, limit int) dto.AdminPostsListResponse {
+	postResponses := make([]dto.AdminPostResponse, len(posts))
+	for i, post := range posts {
+		postResponses[i] = PresentAdminPost(post)
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+
+	// Report every status so empty tabs show up as 0
+	statusCounts := map[string]int64{}
+	for _, status := range entity.PostStatuses() {
+		statusCounts[string(status)] = counts[status]
+	}
+
+	return dto.AdminPostsListResponse{
+		Posts:        postResponses,
+		StatusCounts: statusCounts,
+		Total:        total,
+		Page:         page,
+		Limit:        limit,
+		TotalPages:   totalPages,
+	}
+}
+
+// PresentCommentReport converts a comment report to its inbox response
+func PresentCommentReport(report *entity.CommentReport) dto.AdminCommentReportResponse {
+	response := dto.AdminCommentReportResponse{
+		ID:         report.ID,
+		Reason:     string(report.Reason),
+		Status:     string(report.Status),
+		ReporterID: report.ReporterID,
+		ResolvedBy: report.ResolvedBy,
+		CreatedAt:  report.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if report.Details != "" {
+		response.Details = &report.Details
+	}
+	if report.Reporter != nil {
+		response.ReporterName = &report.Reporter.Nickname
+	}
+	if report.Comment != nil {
+		comment := PresentComment(report.Comment)
+		response.Comment = &comment
+	}
+	if report.ResolvedAt != nil {
+		resolvedAt := report.ResolvedAt.Format("2006-01-02T15:04:05Z")
+		response.ResolvedAt = &resolvedAt
+	}
+
+	return response
+}
+
+// PresentCommentReportsList converts a list of reports to paginated response
+func PresentCommentReportsList(reports []entity.CommentReport, counts map[entity.ReportStatus]int64, total int64, page, limit int) dto.AdminCommentReportsListResponse {
+	reportResponses := make([]dto.AdminCommentReportResponse, len(reports))
+	for i := range reports {
+		reportResponses[i] = PresentCommentReport(&reports[i])
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+
+	// Report every status so an empty inbox shows up as 0
+	statusCounts := map[string]int64{}
+	for _, status := range []entity.ReportStatus{
+		entity.ReportStatusOpen,
+		entity.ReportStatusDismissed,
+		entity.ReportStatusResolved,
+	} {
+		statusCounts[string(status)] = counts[status]
+	}
+
+	return dto.AdminCommentReportsListResponse{
+		Reports:      reportResponses,
+		StatusCounts: statusCounts,
+		Total:        total,
+		Page:         page,
+		Limit:        limit,
+		TotalPages:   totalPages,
+	}
+}
+
+// PresentCommentHistory converts a comment and its revisions to a history response
+func PresentCommentHistory(comment *entity.Comment, revisions []entity.CommentRevision) dto.AdminCommentHistoryResponse {
+	revisionResponses := make([]dto.AdminCommentRevisionResponse, len(revisions))
+	for i := range revisions {
+		revision := &revisions[i]
+		response := dto.AdminCommentRevisionResponse{
+			ID:              revision.ID,
+			PreviousContent: revision.PreviousContent,
+			Content:         revision.Content,
+			EditorID:        revision.EditorID,
+			IsAnonymous:     revision.IsAnonymous(),
+			CreatedAt:       revision.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+		if revision.Editor != nil {
+			response.EditorName = &revision.Editor.Nickname
+		}
+		if network := authorNetwork(revision.EditorIPHash); network != "" {
+			response.EditorIP = &network
+		}
+		revisionResponses[i] = response
+	}
+
+	return dto.AdminCommentHistoryResponse{
+		Comment:   PresentComment(comment),
+		Revisions: revisionResponses,
+	}
+}
+
+// authorNetwork returns the network a comment's truncated IP stands for:
+// the /24 (IPv4) or /48 (IPv6) left by utils.HashIP
+func authorNetwork(ipHash string) string {
+	ip := net.ParseIP(ipHash)
+	if ip == nil {
+		return ""
+	}
+	if ip.To4() != nil {
+		return ip.String() + "/24"
+	}
+	return ip.String() + "/48"
+}
+
+// PresentBan converts a ban entity to its response
+func PresentBan(ban *entity.Ban, now time.Time) dto.BanResponse {
+	response := dto.BanResponse{
+		ID:        ban.ID,
+		Type:      string(ban.Type),
+		Value:     ban.Value,
+		Reason:    ban.Reason,
+		Active:    ban.IsActive(now),
+		CreatedBy: ban.CreatedBy,
+		HitCount:  ban.HitCount,
+		CreatedAt: ban.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if ban.ExpiresAt != nil {
+		expiresAt := ban.ExpiresAt.Format("2006-01-02T15:04:05Z")
+		response.ExpiresAt = &expiresAt
+	}
+	if ban.LastHitAt != nil {
+		lastHitAt := ban.LastHitAt.Format("2006-01-02T15:04:05Z")
+		response.LastHitAt = &lastHitAt
+	}
+
+	return response
+}
+
+// PresentBansList converts a list of bans to paginated response
+func PresentBansList(bans []entity.Ban, total int64, page, limit int) dto.BansListResponse {
+	now := time.Now()
+	banResponses := make([]dto.BanResponse, len(bans))
+	for i, ban := range bans {
+		banResponses[i] = PresentBan(&ban, now)
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+
+	return dto.BansListResponse{
+		Bans:       banResponses,
 		Total:      total,
 		Page:       page,
 		Limit:      limit,
@@ -153,3 +454,67 @@ func PresentTagsList(tags []entity.Tag) dto.TagsListResponse {
 		Total: len(tags),
 	}
 }
+
+// PresentLockout converts a login throttle to admin lockout response
+func PresentLockout(throttle *entity.LoginThrottle, now time.Time) dto.AdminLockoutResponse {
+	var lockedUntil *string
+	if throttle.LockedUntil != nil {
+		formatted := throttle.LockedUntil.Format("2006-01-02T15:04:05Z")
+		lockedUntil = &formatted
+	}
+
+	return dto.AdminLockoutResponse{
+		ID:            throttle.ID,
+		Scope:         string(throttle.Scope),
+		Identifier:    throttle.Identifier,
+		Failures:      throttle.Failures,
+		Locked:        throttle.IsLocked(now),
+		LastFailureAt: throttle.LastFailureAt.Format("2006-01-02T15:04:05Z"),
+		LockedUntil:   lockedUntil,
+	}
+}
+
+// PresentLockoutsList converts a list of login throttles to paginated response
+func PresentLockoutsList(throttles []entity.LoginThrottle, total int64, page, limit int) dto.AdminLockoutsListResponse {
+	now := time.Now()
+	lockoutResponses := make([]dto.AdminLockoutResponse, len(throttles))
+	for i, throttle := range throttles {
+		lockoutResponses[i] = PresentLockout(&throttle, now)
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+
+	return dto.AdminLockoutsListResponse{
+		Lockouts:   lockoutResponses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+}
+
+// PresentRole converts a role to its response
+func PresentRole(role entity.Role) dto.RoleResponse {
+	perms := role.Permissions()
+	names := make([]string, len(perms))
+	for i, p := range perms {
+		names[i] = string(p)
+	}
+
+	return dto.RoleResponse{
+		Name:        string(role),
+		Permissions: names,
+	}
+}
+
+// PresentRolesList converts roles to a list response
+func PresentRolesList(roles []entity.Role) dto.RolesListResponse {
+	responses := make([]dto.RoleResponse, len(roles))
+	for i, role := range roles {
+		responses[i] = PresentRole(role)
+	}
+
+	return dto.RolesListResponse{
+		Roles: responses,
+	}
+}
@@ -0,0 +1,33 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/usecase/admin"
+)
+
+// PresentTrafficBreakdown converts a traffic breakdown to its response
+func PresentTrafficBreakdown(traffic *admin.TrafficBreakdown) dto.TrafficBreakdownResponse {
+	response := dto.TrafficBreakdownResponse{
+		PostID:     traffic.PostID,
+		Days:       traffic.Days,
+		From:       traffic.From.Format(time.RFC3339),
+		To:         traffic.To.Format(time.RFC3339),
+		Timezone:   traffic.Timezone,
+		Dimensions: make([]dto.DimensionBreakdownResponse, len(traffic.Dimensions)),
+	}
+	for i, d := range traffic.Dimensions {
+		values := make([]dto.ViewCountResponse, len(d.Values))
+		for j, v := range d.Values {
+			values[j] = dto.ViewCountResponse{Value: v.Value, Views: v.Views}
+		}
+		response.Dimensions[i] = dto.DimensionBreakdownResponse{
+			Dimension: string(d.Dimension),
+			Total:     d.Total,
+			Values:    values,
+			Other:     d.Other,
+		}
+	}
+	return response
+}
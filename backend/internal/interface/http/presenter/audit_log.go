@@ -0,0 +1,56 @@
+package presenter
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+)
+
+// PresentAuditLog converts an audit log entry to its response
+func PresentAuditLog(log *entity.AuditLog) dto.AuditLogResponse {
+	response := dto.AuditLogResponse{
+		ID:         log.ID,
+		ActorID:    log.ActorID,
+		Action:     log.Action,
+		TargetType: string(log.TargetType),
+		TargetID:   log.TargetID,
+		Method:     log.Method,
+		Path:       log.Path,
+		Status:     log.Status,
+		IPAddress:  log.IPAddress,
+		RequestID:  log.RequestID,
+		CreatedAt:  log.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if log.Actor != nil {
+		response.ActorName = log.Actor.Nickname
+		response.ActorEmail = log.Actor.Email
+	}
+	if log.Before != "" {
+		response.Before = json.RawMessage(log.Before)
+	}
+	if log.After != "" {
+		response.After = json.RawMessage(log.After)
+	}
+
+	return response
+}
+
+// PresentAuditLogList converts audit log entries to paginated response
+func PresentAuditLogList(logs []entity.AuditLog, total int64, page, limit int) dto.AuditLogListResponse {
+	entries := make([]dto.AuditLogResponse, len(logs))
+	for i := range logs {
+		entries[i] = PresentAuditLog(&logs[i])
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+
+	return dto.AuditLogListResponse{
+		Entries:    entries,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+}
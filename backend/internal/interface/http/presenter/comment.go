@@ -0,0 +1,61 @@
+package presenter
+
+import (
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/pkg/markdown"
+)
+
+// ToCommentResponse converts a comment entity to its public response
+func ToCommentResponse(comment *entity.Comment) dto.CommentResponse {
+	author := dto.CommentAuthorResponse{
+		UserID:       comment.UserID,
+		IsAnonymous:  comment.IsAnonymous(),
+		IsPostAuthor: comment.IsByPostAuthor(),
+		IsAdmin:      comment.IsByAdmin(),
+	}
+	if comment.User != nil {
+		author.Name = comment.User.Nickname
+		author.AvatarURL = comment.User.AvatarURL
+	} else if comment.AuthorName != nil {
+		author.Name = *comment.AuthorName
+	}
+
+	return dto.CommentResponse{
+		ID:          comment.ID,
+		PostID:      comment.PostID,
+		ParentID:    comment.ParentID,
+		Content:     comment.Content,
+		ContentHTML: commentHTML(comment),
+		Author:      author,
+		Status:      string(comment.Status),
+		LikeCount:   comment.LikeCount,
+		IsEdited:    comment.IsEdited,
+		IsPinned:    comment.IsPinned(),
+		ReplyCount:  comment.ReplyCount,
+		CreatedAt:   comment.CreatedAt,
+		UpdatedAt:   comment.UpdatedAt,
+	}
+}
+
+// commentHTML returns the comment's sanitized HTML. Comments written before
+// Markdown support have none stored, so it is rendered on the fly.
+func commentHTML(comment *entity.Comment) string {
+	if comment.ContentHTML != "" {
+		return comment.ContentHTML
+	}
+	return markdown.Render(comment.Content, nil)
+}
+
+// ToCommentsListResponse converts comments to a list response
+func ToCommentsListResponse(comments []entity.Comment) dto.CommentsListResponse {
+	responses := make([]dto.CommentResponse, len(comments))
+	for i := range comments {
+		responses[i] = ToCommentResponse(&comments[i])
+	}
+
+	return dto.CommentsListResponse{
+		Comments: responses,
+		Total:    len(responses),
+	}
+}
@@ -10,24 +10,26 @@ import (
 // ToPostResponse converts a post entity to a post response DTO
 func ToPostResponse(post *entity.Post, isLiked, isBookmarked bool) dto.PostResponse {
 	response := dto.PostResponse{
-		ID:              post.ID,
-		CreatedAt:       post.CreatedAt,
-		UpdatedAt:       post.UpdatedAt,
-		Title:           post.Title,
-		Slug:            post.Slug,
-		Content:         post.Content,
-		FeaturedImage:   post.FeaturedImage,
-		Status:          post.Status,
-		PublishedAt:     post.PublishedAt,
-		ViewCount:       post.ViewCount,
-		LikeCount:       post.LikeCount,
-		CommentCount:    post.CommentCount,
-		BookmarkCount:   post.BookmarkCount,
-		MetaTitle:       post.MetaTitle,
-		MetaDescription: post.MetaDescription,
-		MetaKeywords:    post.MetaKeywords,
-		IsLiked:         isLiked,
-		IsBookmarked:    isBookmarked,
+		ID:               post.ID,
+		CreatedAt:        post.CreatedAt,
+		UpdatedAt:        post.UpdatedAt,
+		Title:            post.Title,
+		Slug:             post.Slug,
+		Content:          post.Content,
+		FeaturedImage:    post.FeaturedImage,
+		Status:           post.Status,
+		PublishedAt:      post.PublishedAt,
+		ViewCount:        post.ViewCount,
+		LikeCount:        post.LikeCount,
+		CommentCount:     post.CommentCount,
+		BookmarkCount:    post.BookmarkCount,
+		MetaTitle:        post.MetaTitle,
+		MetaDescription:  post.MetaDescription,
+		MetaKeywords:     post.MetaKeywords,
+		IsLiked:          isLiked,
+		IsBookmarked:     isBookmarked,
+		Locale:           post.Locale,
+		AvailableLocales: post.AvailableLocales,
 	}
 
 	// Set excerpt
@@ -0,0 +1,53 @@
+package presenter
+
+import (
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+)
+
+// PresentPostTranslation converts a post translation entity to response DTO
+func PresentPostTranslation(translation *entity.PostTranslation) dto.PostTranslationResponse {
+	return dto.PostTranslationResponse{
+		PostID:          translation.PostID,
+		Locale:          translation.Locale,
+		Title:           translation.Title,
+		Excerpt:         translation.Excerpt,
+		Content:         translation.Content,
+		MetaTitle:       translation.MetaTitle,
+		MetaDescription: translation.MetaDescription,
+		MetaKeywords:    translation.MetaKeywords,
+		UpdatedAt:       translation.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// PresentPostTranslationsList converts a list of post translations to response DTO
+func PresentPostTranslationsList(translations []entity.PostTranslation) dto.PostTranslationsListResponse {
+	translationResponses := make([]dto.PostTranslationResponse, len(translations))
+	for i, translation := range translations {
+		translationResponses[i] = PresentPostTranslation(&translation)
+	}
+
+	return dto.PostTranslationsListResponse{
+		Translations: translationResponses,
+		Total:        len(translations),
+	}
+}
+
+// PresentCategoryTranslation converts a category translation entity to response DTO
+func PresentCategoryTranslation(translation *entity.CategoryTranslation) dto.CategoryTranslationResponse {
+	return dto.CategoryTranslationResponse{
+		CategoryID:  translation.CategoryID,
+		Locale:      translation.Locale,
+		Name:        translation.Name,
+		Description: translation.Description,
+	}
+}
+
+// PresentTagTranslation converts a tag translation entity to response DTO
+func PresentTagTranslation(translation *entity.TagTranslation) dto.TagTranslationResponse {
+	return dto.TagTranslationResponse{
+		TagID:  translation.TagID,
+		Locale: translation.Locale,
+		Name:   translation.Name,
+	}
+}
@@ -1 +1,52 @@
 package presenter
+
+import (
+	"math"
+
+	"github.com/yourusername/viblog/internal/interface/http/dto"
+	"github.com/yourusername/viblog/internal/usecase/user"
+)
+
+// PresentPublicProfile converts a public profile to response DTO. Only public
+// fields are copied; the email address never leaves the server.
+func PresentPublicProfile(profile *user.PublicProfile) dto.PublicProfileResponse {
+	u := profile.User
+	response := dto.PublicProfileResponse{
+		Nickname:       u.Nickname,
+		AvatarURL:      u.AvatarURL,
+		Bio:            u.Bio,
+		JoinedAt:       u.CreatedAt,
+		ActivityHidden: u.HideActivity,
+	}
+	if u.HideActivity {
+		return response
+	}
+
+	comments := make([]dto.PublicCommentResponse, len(profile.Comments))
+	for i := range profile.Comments {
+		comment := &profile.Comments[i]
+		comments[i] = dto.PublicCommentResponse{
+			ID:          comment.ID,
+			Content:     comment.Content,
+			ContentHTML: commentHTML(comment),
+			IsEdited:    comment.IsEdited,
+			CreatedAt:   comment.CreatedAt,
+			Post:        dto.PublicPostResponse{ID: comment.PostID},
+		}
+		if comment.Post != nil {
+			comments[i].Post.Title = comment.Post.Title
+			comments[i].Post.Slug = comment.Post.Slug
+		}
+	}
+
+	count := profile.CommentCount
+	response.CommentCount = &count
+	response.Comments = comments
+	response.Pagination = &dto.PaginationResponse{
+		Page:       profile.Page,
+		Limit:      profile.Limit,
+		Total:      count,
+		TotalPages: int(math.Ceil(float64(count) / float64(profile.Limit))),
+	}
+	return response
+}
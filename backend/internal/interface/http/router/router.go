@@ -5,6 +5,7 @@ import (
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/infrastructure/auth"
 	"github.com/yourusername/viblog/internal/interface/http/handler"
 	"github.com/yourusername/viblog/internal/interface/http/middleware"
@@ -13,17 +14,36 @@ import (
 
 // Router holds the HTTP router and its dependencies
 type Router struct {
-	engine     *gin.Engine
-	cfg        *config.Config
-	logger     *zap.Logger
-	jwtService *auth.JWTService
+	engine          *gin.Engine
+	cfg             *config.Config
+	logger          *zap.Logger
+	jwtService      *auth.JWTService
+	validateSession middleware.SessionValidator
+	authenticatePAT middleware.PATAuthenticator
+	auditor         middleware.Auditor
 
 	// Handlers
-	userHandler         *handler.UserHandler
-	postHandler         *handler.PostHandler
-	commentHandler      *handler.CommentHandler
-	adminHandler        *handler.AdminHandler
-	notificationHandler *handler.NotificationHandler
+	userHandler              *handler.UserHandler
+	postHandler              *handler.PostHandler
+	commentHandler           *handler.CommentHandler
+	adminHandler             *handler.AdminHandler
+	notificationHandler      *handler.NotificationHandler
+	translationHandler       *handler.TranslationHandler
+	accountHandler           *handler.AccountHandler
+	oauthHandler             *handler.OAuthHandler
+	mfaHandler               *handler.MFAHandler
+	lockoutHandler           *handler.LockoutHandler
+	roleHandler              *handler.RoleHandler
+	tokenHandler             *handler.PersonalAccessTokenHandler
+	accountDataHandler       *handler.AccountDataHandler
+	profileHandler           *handler.ProfileHandler
+	userModerationHandler    *handler.UserModerationHandler
+	commentModerationHandler *handler.CommentModerationHandler
+	banHandler               *handler.BanHandler
+	commentReportHandler     *handler.CommentReportHandler
+	subscriptionHandler      *handler.CommentSubscriptionHandler
+	analyticsHandler         *handler.AnalyticsHandler
+	auditLogHandler          *handler.AuditLogHandler
 }
 
 // New creates a new HTTP router
@@ -31,11 +51,30 @@ func New(
 	cfg *config.Config,
 	logger *zap.Logger,
 	jwtService *auth.JWTService,
+	validateSession middleware.SessionValidator,
+	authenticatePAT middleware.PATAuthenticator,
+	auditor middleware.Auditor,
 	userHandler *handler.UserHandler,
 	postHandler *handler.PostHandler,
 	commentHandler *handler.CommentHandler,
 	adminHandler *handler.AdminHandler,
 	notificationHandler *handler.NotificationHandler,
+	translationHandler *handler.TranslationHandler,
+	accountHandler *handler.AccountHandler,
+	oauthHandler *handler.OAuthHandler,
+	mfaHandler *handler.MFAHandler,
+	lockoutHandler *handler.LockoutHandler,
+	roleHandler *handler.RoleHandler,
+	tokenHandler *handler.PersonalAccessTokenHandler,
+	accountDataHandler *handler.AccountDataHandler,
+	profileHandler *handler.ProfileHandler,
+	userModerationHandler *handler.UserModerationHandler,
+	commentModerationHandler *handler.CommentModerationHandler,
+	banHandler *handler.BanHandler,
+	commentReportHandler *handler.CommentReportHandler,
+	subscriptionHandler *handler.CommentSubscriptionHandler,
+	analyticsHandler *handler.AnalyticsHandler,
+	auditLogHandler *handler.AuditLogHandler,
 ) *Router {
 	// Set Gin mode based on environment
 	if cfg.Server.Env == "production" {
@@ -45,15 +84,34 @@ func New(
 	engine := gin.New()
 
 	return &Router{
-		engine:              engine,
-		cfg:                 cfg,
-		logger:              logger,
-		jwtService:          jwtService,
-		userHandler:         userHandler,
-		postHandler:         postHandler,
-		commentHandler:      commentHandler,
-		adminHandler:        adminHandler,
-		notificationHandler: notificationHandler,
+		engine:                   engine,
+		cfg:                      cfg,
+		logger:                   logger,
+		jwtService:               jwtService,
+		validateSession:          validateSession,
+		authenticatePAT:          authenticatePAT,
+		auditor:                  auditor,
+		userHandler:              userHandler,
+		postHandler:              postHandler,
+		commentHandler:           commentHandler,
+		adminHandler:             adminHandler,
+		notificationHandler:      notificationHandler,
+		translationHandler:       translationHandler,
+		accountHandler:           accountHandler,
+		oauthHandler:             oauthHandler,
+		mfaHandler:               mfaHandler,
+		lockoutHandler:           lockoutHandler,
+		roleHandler:              roleHandler,
+		tokenHandler:             tokenHandler,
+		accountDataHandler:       accountDataHandler,
+		profileHandler:           profileHandler,
+		userModerationHandler:    userModerationHandler,
+		commentModerationHandler: commentModerationHandler,
+		banHandler:               banHandler,
+		commentReportHandler:     commentReportHandler,
+		subscriptionHandler:      subscriptionHandler,
+		analyticsHandler:         analyticsHandler,
+		auditLogHandler:          auditLogHandler,
 	}
 }
 
@@ -68,14 +126,20 @@ func (r *Router) Setup() *gin.Engine {
 	}
 
 	// Global middleware
+	r.engine.Use(middleware.RequestID())
 	r.engine.Use(middleware.Logger(r.logger))
 	r.engine.Use(middleware.Recovery(r.logger))
 	r.engine.Use(middleware.CORS(corsConfig))
 	r.engine.Use(middleware.ErrorHandler())
+	r.engine.Use(middleware.Locale(r.cfg.I18n.SupportedLocales, r.cfg.I18n.DefaultLocale))
+	r.engine.NoRoute(middleware.NotFoundHandler())
 
 	// Health check endpoint
 	r.engine.GET("/health", r.healthCheck)
 
+	// Public keys for verifying tokens signed with RS256/EdDSA
+	r.engine.GET("/.well-known/jwks.json", r.jwks)
+
 	// Swagger documentation
 	r.engine.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -83,6 +147,8 @@ func (r *Router) Setup() *gin.Engine {
 	v1 := r.engine.Group("/api/v1")
 	{
 		r.setupAuthRoutes(v1)
+		r.setupMeRoutes(v1)
+		r.setupUserRoutes(v1)
 		r.setupPostRoutes(v1)
 		r.setupCommentRoutes(v1)
 		r.setupNotificationRoutes(v1)
@@ -98,20 +164,76 @@ func (r *Router) setupAuthRoutes(rg *gin.RouterGroup) {
 	{
 		// Public routes
 		auth.POST("/register", r.userHandler.Register)
-		auth.POST("/login", r.userHandler.Login)
+		auth.POST("/login", middleware.RateLimit(r.cfg.RateLimit.APIRequests, r.cfg.RateLimit.APIWindow), r.userHandler.Login)
 		auth.POST("/refresh", r.userHandler.RefreshToken)
 
+		// Account recovery and second-factor routes. Recovery responds identically
+		// whether or not the email exists.
+		account := auth.Group("")
+		account.Use(middleware.RateLimit(
+			r.cfg.RateLimit.AccountRequests,
+			r.cfg.RateLimit.AccountWindow,
+		))
+		{
+			account.POST("/verify-email/request", r.accountHandler.RequestEmailVerification)
+			account.POST("/verify-email/confirm", r.accountHandler.ConfirmEmailVerification)
+			account.POST("/password/forgot", r.accountHandler.RequestPasswordReset)
+			account.POST("/password/reset", r.accountHandler.ResetPassword)
+			account.POST("/login/mfa", r.mfaHandler.VerifyLogin)
+		}
+
+		// Social login. Starting a login holds server memory until it expires,
+		// so it is limited like account recovery.
+		oauth := auth.Group("/oauth")
+		{
+			oauthLimit := middleware.RateLimit(r.cfg.RateLimit.AccountRequests, r.cfg.RateLimit.AccountWindow)
+			oauth.GET("/providers", r.oauthHandler.ListProviders)
+			oauth.GET("/:provider/authorize", oauthLimit, r.oauthHandler.Authorize)
+			oauth.POST("/:provider/callback", oauthLimit, r.oauthHandler.Callback)
+		}
+
 		// Protected routes
 		protected := auth.Group("")
-		protected.Use(middleware.AuthMiddleware(r.jwtService))
+		protected.Use(middleware.AuthMiddleware(r.jwtService, r.validateSession, nil))
 		{
 			protected.POST("/logout", r.userHandler.Logout)
 			protected.GET("/me", r.userHandler.GetProfile)
 			protected.PUT("/me", r.userHandler.UpdateProfile)
+
+			// Two-factor authentication
+			protected.GET("/mfa", r.mfaHandler.GetStatus)
+			protected.POST("/mfa/totp/enroll", r.mfaHandler.Enroll)
+			protected.POST("/mfa/totp/confirm", r.mfaHandler.Confirm)
+			protected.POST("/mfa/totp/disable", r.mfaHandler.Disable)
+			protected.POST("/mfa/recovery-codes", r.mfaHandler.RegenerateRecoveryCodes)
 		}
 	}
 }
 
+// setupMeRoutes configures routes for managing the current user's account
+func (r *Router) setupMeRoutes(rg *gin.RouterGroup) {
+	me := rg.Group("/me")
+	me.Use(middleware.AuthMiddleware(r.jwtService, r.validateSession, nil))
+	{
+		// Personal access tokens
+		me.GET("/tokens", r.tokenHandler.List)
+		me.POST("/tokens", r.tokenHandler.Create)
+		me.DELETE("/tokens/:id", r.tokenHandler.Revoke)
+
+		// Data export and account deletion
+		me.GET("/export", middleware.RateLimit(r.cfg.RateLimit.AccountRequests, r.cfg.RateLimit.AccountWindow), r.accountDataHandler.Export)
+		me.DELETE("", r.accountDataHandler.Delete)
+	}
+}
+
+// setupUserRoutes configures public user profile routes
+func (r *Router) setupUserRoutes(rg *gin.RouterGroup) {
+	users := rg.Group("/users")
+	{
+		users.GET("/:nickname", r.profileHandler.Get)
+	}
+}
+
 // setupPostRoutes configures post-related routes
 func (r *Router) setupPostRoutes(rg *gin.RouterGroup) {
 	posts := rg.Group("/posts")
@@ -122,19 +244,20 @@ func (r *Router) setupPostRoutes(rg *gin.RouterGroup) {
 		posts.GET("/search", r.postHandler.Search)
 		posts.POST("/:id/view", r.postHandler.IncrementView) // View count tracking
 
-		// Protected routes (admin only for write operations)
+		// Protected routes (staff with posts:write for write operations). Personal
+		// access tokens with the posts:write scope are accepted for publishing from CI.
 		protected := posts.Group("")
-		protected.Use(middleware.AuthMiddleware(r.jwtService))
-		protected.Use(middleware.RequireAdmin())
+		protected.Use(middleware.AuthMiddleware(r.jwtService, r.validateSession, r.authenticatePAT))
+		protected.Use(r.requirePermission(entity.PermissionPostsWrite)...)
 		{
-			protected.POST("", r.postHandler.Create)
-			protected.PUT("/:id", r.postHandler.Update)
-			protected.DELETE("/:id", r.postHandler.Delete)
+			protected.POST("", r.audit("post.create", entity.AuditTargetPost), r.postHandler.Create)
+			protected.PUT("/:id", r.audit("post.update", entity.AuditTargetPost), r.postHandler.Update)
+			protected.DELETE("/:id", r.audit("post.delete", entity.AuditTargetPost), r.postHandler.Delete)
 		}
 
 		// Protected routes (authenticated users)
 		userProtected := posts.Group("")
-		userProtected.Use(middleware.AuthMiddleware(r.jwtService))
+		userProtected.Use(middleware.AuthMiddleware(r.jwtService, r.validateSession, nil))
 		{
 			userProtected.POST("/:id/like", r.postHandler.Like)
 			userProtected.DELETE("/:id/like", r.postHandler.Unlike)
@@ -164,6 +287,7 @@ func (r *Router) setupCommentRoutes(rg *gin.RouterGroup) {
 	{
 		// Public routes - list comments for a post
 		comments.GET("/post/:postId", r.commentHandler.List)
+		comments.GET("/form-token", r.commentHandler.FormToken)
 
 		// Rate-limited routes (both anonymous and authenticated)
 		rateLimited := comments.Group("")
@@ -173,17 +297,24 @@ func (r *Router) setupCommentRoutes(rg *gin.RouterGroup) {
 		))
 		{
 			// Optional auth (allows both anonymous and authenticated)
-			rateLimited.POST("/post/:postId", middleware.OptionalAuth(r.jwtService), r.commentHandler.Create)
-			rateLimited.PUT("/:id", middleware.OptionalAuth(r.jwtService), r.commentHandler.Update)
-			rateLimited.DELETE("/:id", middleware.OptionalAuth(r.jwtService), r.commentHandler.Delete)
+			rateLimited.POST("/post/:postId", middleware.OptionalAuth(r.jwtService, r.validateSession), r.commentHandler.Create)
+			rateLimited.PUT("/:id", middleware.OptionalAuth(r.jwtService, r.validateSession), r.commentHandler.Update)
+			rateLimited.DELETE("/:id", middleware.OptionalAuth(r.jwtService, r.validateSession), r.commentHandler.Delete)
 
 			// Reply routes (nested comments)
 			rateLimited.GET("/:id/replies", r.commentHandler.ListReplies)
-			rateLimited.POST("/:id/replies", middleware.OptionalAuth(r.jwtService), r.commentHandler.CreateReply)
+			rateLimited.POST("/:id/replies", middleware.OptionalAuth(r.jwtService, r.validateSession), r.commentHandler.CreateReply)
+
+			// Reports
+			rateLimited.POST("/:id/report", middleware.OptionalAuth(r.jwtService, r.validateSession), r.commentHandler.Report)
+
+			// Reply notification email links
+			rateLimited.POST("/subscriptions/confirm", r.subscriptionHandler.Confirm)
+			rateLimited.POST("/subscriptions/unsubscribe", r.subscriptionHandler.Unsubscribe)
 
 			// Authenticated only
 			authenticated := rateLimited.Group("")
-			authenticated.Use(middleware.AuthMiddleware(r.jwtService))
+			authenticated.Use(middleware.AuthMiddleware(r.jwtService, r.validateSession, nil))
 			{
 				authenticated.POST("/:id/like", r.commentHandler.Like)
 				authenticated.DELETE("/:id/like", r.commentHandler.Unlike)
@@ -195,7 +326,7 @@ func (r *Router) setupCommentRoutes(rg *gin.RouterGroup) {
 // setupNotificationRoutes configures notification-related routes
 func (r *Router) setupNotificationRoutes(rg *gin.RouterGroup) {
 	notifications := rg.Group("/notifications")
-	notifications.Use(middleware.AuthMiddleware(r.jwtService))
+	notifications.Use(middleware.AuthMiddleware(r.jwtService, r.validateSession, nil))
 	{
 		notifications.GET("", r.notificationHandler.List)
 		notifications.GET("/unread", r.notificationHandler.ListUnread)
@@ -204,37 +335,119 @@ func (r *Router) setupNotificationRoutes(rg *gin.RouterGroup) {
 	}
 }
 
-// setupAdminRoutes configures admin-related routes
+// setupAdminRoutes configures admin-related routes. Each group requires the
+// permission its routes act on, so staff roles only reach their own area and
+// personal access tokens only the areas they are scoped to. Every route that
+// changes state is recorded to the audit log.
 func (r *Router) setupAdminRoutes(rg *gin.RouterGroup) {
 	admin := rg.Group("/admin")
-	admin.Use(middleware.AuthMiddleware(r.jwtService))
-	admin.Use(middleware.RequireAdmin())
+	admin.Use(middleware.AuthMiddleware(r.jwtService, r.validateSession, r.authenticatePAT))
+
+	// Dashboard
+	dashboard := admin.Group("", r.requirePermission(entity.PermissionDashboardView)...)
 	{
-		// Dashboard
-		admin.GET("/dashboard", r.adminHandler.GetDashboard)
+		dashboard.GET("/dashboard", r.adminHandler.GetDashboard)
+		dashboard.POST("/analytics/rollup", r.audit("analytics.rollup", entity.AuditTargetAnalytics), r.analyticsHandler.Rollup)
+		dashboard.GET("/analytics/traffic", r.analyticsHandler.GetTraffic)
+		dashboard.GET("/analytics/posts/:id/traffic", r.analyticsHandler.GetPostTraffic)
+	}
 
+	users := admin.Group("", r.requirePermission(entity.PermissionUsersManage)...)
+	{
 		// User management
-		admin.GET("/users", r.adminHandler.ListUsers)
-		admin.DELETE("/users/:id", r.adminHandler.DeleteUser)
+		users.GET("/users", r.adminHandler.ListUsers)
+		users.PATCH("/users/:id", r.audit("user.update", entity.AuditTargetUser), r.userModerationHandler.UpdateUser)
+		users.DELETE("/users/:id", r.audit("user.delete", entity.AuditTargetUser), r.adminHandler.DeleteUser)
+
+		// Moderation
+		users.POST("/users/:id/suspend", r.audit("user.suspend", entity.AuditTargetUser), r.userModerationHandler.SuspendUser)
+		users.POST("/users/:id/ban", r.audit("user.ban", entity.AuditTargetUser), r.userModerationHandler.BanUser)
+		users.POST("/users/:id/reinstate", r.audit("user.reinstate", entity.AuditTargetUser), r.userModerationHandler.ReinstateUser)
+		users.POST("/users/:id/password-reset", r.audit("user.password_reset", entity.AuditTargetUser), r.userModerationHandler.ForcePasswordReset)
 
-		// Comment moderation
-		admin.GET("/comments", r.adminHandler.ListComments)
-		admin.DELETE("/comments/:id", r.adminHandler.DeleteComment)
+		// Roles
+		users.GET("/roles", r.roleHandler.ListRoles)
+		users.PUT("/users/:id/role", r.audit("user.role.assign", entity.AuditTargetUser), r.roleHandler.AssignRole)
 
+		// Login lockouts
+		users.GET("/lockouts", r.lockoutHandler.ListLockouts)
+		users.DELETE("/lockouts/:id", r.audit("lockout.clear", entity.AuditTargetLockout), r.lockoutHandler.ClearLockout)
+
+		// IP, email and user bans
+		users.GET("/bans", r.banHandler.ListBans)
+		users.POST("/bans", r.audit("ban.create", entity.AuditTargetBan), r.banHandler.CreateBan)
+		users.DELETE("/bans/:id", r.audit("ban.delete", entity.AuditTargetBan), r.banHandler.DeleteBan)
+	}
+
+	// Comment moderation
+	comments := admin.Group("", r.requirePermission(entity.PermissionCommentsModerate)...)
+	{
+		comments.GET("/comments", r.adminHandler.ListComments)
+		comments.POST("/comments/moderate", r.audit("comment.moderate", entity.AuditTargetComment), r.commentModerationHandler.Moderate)
+		comments.DELETE("/comments/:id", r.audit("comment.delete", entity.AuditTargetComment), r.adminHandler.DeleteComment)
+		comments.GET("/comments/:id/history", r.commentModerationHandler.History)
+		comments.POST("/comments/:id/pin", r.audit("comment.pin", entity.AuditTargetComment), r.commentModerationHandler.Pin)
+		comments.DELETE("/comments/:id/pin", r.audit("comment.unpin", entity.AuditTargetComment), r.commentModerationHandler.Unpin)
+
+		// Reports inbox
+		comments.GET("/reports", r.commentReportHandler.ListReports)
+		comments.POST("/reports/:id/resolve", r.audit("comment_report.resolve", entity.AuditTargetCommentReport), r.commentReportHandler.ResolveReport)
+	}
+
+	taxonomy := admin.Group("", r.requirePermission(entity.PermissionTaxonomyManage)...)
+	{
 		// Category management
-		admin.GET("/categories", r.adminHandler.ListCategories)
-		admin.POST("/categories", r.adminHandler.CreateCategory)
-		admin.PUT("/categories/:id", r.adminHandler.UpdateCategory)
-		admin.DELETE("/categories/:id", r.adminHandler.DeleteCategory)
+		taxonomy.GET("/categories", r.adminHandler.ListCategories)
+		taxonomy.POST("/categories", r.audit("category.create", entity.AuditTargetCategory), r.adminHandler.CreateCategory)
+		taxonomy.PUT("/categories/:id", r.audit("category.update", entity.AuditTargetCategory), r.adminHandler.UpdateCategory)
+		taxonomy.DELETE("/categories/:id", r.audit("category.delete", entity.AuditTargetCategory), r.adminHandler.DeleteCategory)
 
 		// Tag management
-		admin.GET("/tags", r.adminHandler.ListTags)
-		admin.POST("/tags", r.adminHandler.CreateTag)
-		admin.PUT("/tags/:id", r.adminHandler.UpdateTag)
-		admin.DELETE("/tags/:id", r.adminHandler.DeleteTag)
+		taxonomy.GET("/tags", r.adminHandler.ListTags)
+		taxonomy.POST("/tags", r.audit("tag.create", entity.AuditTargetTag), r.adminHandler.CreateTag)
+		taxonomy.PUT("/tags/:id", r.audit("tag.update", entity.AuditTargetTag), r.adminHandler.UpdateTag)
+		taxonomy.DELETE("/tags/:id", r.audit("tag.delete", entity.AuditTargetTag), r.adminHandler.DeleteTag)
+
+		// Translations
+		taxonomy.PUT("/categories/:id/translations/:locale", r.audit("category.translation.upsert", entity.AuditTargetCategory), r.translationHandler.UpsertCategoryTranslation)
+		taxonomy.DELETE("/categories/:id/translations/:locale", r.audit("category.translation.delete", entity.AuditTargetCategory), r.translationHandler.DeleteCategoryTranslation)
+		taxonomy.PUT("/tags/:id/translations/:locale", r.audit("tag.translation.upsert", entity.AuditTargetTag), r.translationHandler.UpsertTagTranslation)
+		taxonomy.DELETE("/tags/:id/translations/:locale", r.audit("tag.translation.delete", entity.AuditTargetTag), r.translationHandler.DeleteTagTranslation)
+	}
+
+	// Post management
+	posts := admin.Group("", r.requirePermission(entity.PermissionPostsWrite)...)
+	{
+		posts.GET("/posts", r.adminHandler.ListPosts)
+
+		// Translations
+		posts.GET("/posts/:id/translations", r.translationHandler.ListPostTranslations)
+		posts.PUT("/posts/:id/translations/:locale", r.audit("post.translation.upsert", entity.AuditTargetPost), r.translationHandler.UpsertPostTranslation)
+		posts.DELETE("/posts/:id/translations/:locale", r.audit("post.translation.delete", entity.AuditTargetPost), r.translationHandler.DeletePostTranslation)
+	}
+
+	// Audit log
+	audit := admin.Group("", r.requirePermission(entity.PermissionAuditView)...)
+	{
+		audit.GET("/audit-log", r.auditLogHandler.List)
 	}
 }
 
+// requirePermission returns the middleware guarding staff routes: the
+// permissions themselves, then a second factor when MFA.RequireForAdmins is set
+func (r *Router) requirePermission(permissions ...entity.Permission) []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		middleware.RequirePermission(permissions...),
+		middleware.RequireMFA(r.cfg.MFA.RequireForAdmins),
+	}
+}
+
+// audit returns the middleware recording a state-changing staff route to
+// the audit log
+func (r *Router) audit(action string, targetType entity.AuditTargetType) gin.HandlerFunc {
+	return middleware.Audit(r.auditor, r.logger, action, targetType)
+}
+
 // healthCheck is a simple health check endpoint
 func (r *Router) healthCheck(c *gin.Context) {
 	c.JSON(200, gin.H{
@@ -242,3 +455,10 @@ func (r *Router) healthCheck(c *gin.Context) {
 		"env":    r.cfg.Server.Env,
 	})
 }
+
+// jwks publishes the token verification keys. A new key is listed here for a
+// while before it signs, so clients may cache the set for a few minutes.
+func (r *Router) jwks(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(200, r.jwtService.JWKS())
+}
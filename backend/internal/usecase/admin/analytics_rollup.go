@@ -0,0 +1,188 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// maxRollupDays limits how many days one on-demand rollup covers
+const maxRollupDays = 366
+
+// RollupResult describes a finished rollup
+type RollupResult struct {
+	From           string // First day rolled up, YYYY-MM-DD
+	To             string // Last day rolled up
+	Days           int
+	PrunedViewLogs int64
+}
+
+// RollUpAnalyticsUseCase rolls views, unique visitors, likes and comments up
+// into daily per-post totals in the server time zone, and views into their
+// breakdown by traffic source and client. Rolling up a day again replaces
+// its totals, so runs can be repeated and cover past days. Views and
+// visitors never go below what was rolled up before, so days whose view
+// logs were pruned keep their numbers.
+type RollUpAnalyticsUseCase struct {
+	analyticsRepo repository.AnalyticsRepository
+	loc           *time.Location
+	retention     time.Duration
+}
+
+// NewRollUpAnalyticsUseCase creates a new RollUpAnalyticsUseCase
+func NewRollUpAnalyticsUseCase(
+	analyticsRepo repository.AnalyticsRepository,
+	server config.ServerConfig,
+	cfg config.AnalyticsConfig,
+) *RollUpAnalyticsUseCase {
+	loc, err := time.LoadLocation(server.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	return &RollUpAnalyticsUseCase{
+		analyticsRepo: analyticsRepo,
+		loc:           loc,
+		retention:     cfg.ViewLogRetention,
+	}
+}
+
+// Execute rolls up every day from from through to, both YYYY-MM-DD, for
+// backfills. Days after today are rejected.
+func (uc *RollUpAnalyticsUseCase) Execute(ctx context.Context, from, to string, now time.Time) (*RollupResult, error) {
+	first, err := time.ParseInLocation("2006-01-02", from, uc.loc)
+	if err != nil {
+		return nil, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"field": "from",
+		})
+	}
+	last, err := time.ParseInLocation("2006-01-02", to, uc.loc)
+	if err != nil || last.Before(first) || last.After(uc.today(now)) {
+		return nil, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"field": "to",
+		})
+	}
+	if last.Sub(first) >= maxRollupDays*24*time.Hour {
+		return nil, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"field":    "from",
+			"max_days": maxRollupDays,
+		})
+	}
+
+	return uc.rollUp(ctx, first, last)
+}
+
+// CatchUp rolls up from the last rolled-up day, or the day of the oldest
+// view log when nothing was rolled up yet, through today, then prunes view
+// logs older than the retention window. The last day is rolled up again
+// because it may have been partial.
+func (uc *RollUpAnalyticsUseCase) CatchUp(ctx context.Context, now time.Time) (*RollupResult, error) {
+	today := uc.today(now)
+	first := today
+
+	latest, err := uc.analyticsRepo.LatestRolledUpDay(ctx)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if latest != "" {
+		if day, err := time.ParseInLocation("2006-01-02", latest, uc.loc); err == nil && day.Before(today) {
+			first = day
+		}
+	} else {
+		earliest, err := uc.analyticsRepo.EarliestViewAt(ctx)
+		if err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+		if earliest != nil && earliest.Before(today) {
+			first = uc.today(*earliest)
+		}
+	}
+
+	result, err := uc.rollUp(ctx, first, today)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.PrunedViewLogs, err = uc.analyticsRepo.DeleteViewLogsBefore(ctx, now.Add(-uc.retention)); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	return result, nil
+}
+
+// rollUp rolls up each day from first through last, both local midnights
+func (uc *RollUpAnalyticsUseCase) rollUp(ctx context.Context, first, last time.Time) (*RollupResult, error) {
+	result := &RollupResult{
+		From: first.Format("2006-01-02"),
+		To:   last.Format("2006-01-02"),
+	}
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if err := uc.rollUpDay(ctx, day); err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+		result.Days++
+	}
+	return result, nil
+}
+
+// rollUpDay replaces the totals of the day starting at the given local midnight
+func (uc *RollUpAnalyticsUseCase) rollUpDay(ctx context.Context, start time.Time) error {
+	day := start.Format("2006-01-02")
+
+	stats, err := uc.analyticsRepo.AggregatePostActivity(ctx, start, start.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+	previous, err := uc.analyticsRepo.FindDailyStats(ctx, day)
+	if err != nil {
+		return err
+	}
+
+	// The view breakdown cannot be merged with one rolled up before, so it
+	// is only replaced while the day's view logs are complete
+	var loggedViews, rolledUpViews int64
+	for _, st := range stats {
+		loggedViews += st.Views
+	}
+	for _, p := range previous {
+		rolledUpViews += p.Views
+	}
+	if loggedViews >= rolledUpViews {
+		breakdown, err := uc.analyticsRepo.AggregateViewBreakdown(ctx, start, start.AddDate(0, 0, 1))
+		if err != nil {
+			return err
+		}
+		if err := uc.analyticsRepo.ReplaceDailyBreakdown(ctx, day, breakdown); err != nil {
+			return err
+		}
+	}
+
+	// Keep views whose logs were pruned since the last rollup
+	byPost := make(map[uint]int, len(stats))
+	for i := range stats {
+		byPost[stats[i].PostID] = i
+	}
+	for _, p := range previous {
+		i, ok := byPost[p.PostID]
+		if !ok {
+			if p.Views == 0 {
+				continue
+			}
+			stats = append(stats, entity.PostDailyStat{PostID: p.PostID})
+			i = len(stats) - 1
+		}
+		stats[i].Views = max(stats[i].Views, p.Views)
+		stats[i].UniqueVisitors = max(stats[i].UniqueVisitors, p.UniqueVisitors)
+	}
+
+	return uc.analyticsRepo.ReplaceDailyStats(ctx, day, stats)
+}
+
+// today returns the local midnight starting the day of t
+func (uc *RollUpAnalyticsUseCase) today(t time.Time) time.Time {
+	local := t.In(uc.loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, uc.loc)
+}
@@ -0,0 +1,108 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+const (
+	// DefaultTrafficLimit is the number of top values shown per dimension
+	// when none is given
+	DefaultTrafficLimit = 10
+	// maxTrafficLimit caps the top values shown per dimension
+	maxTrafficLimit = 50
+)
+
+// DimensionBreakdown ranks the values of one dimension by views
+type DimensionBreakdown struct {
+	Dimension entity.ViewDimension
+	Total     int64 // Views of all values
+	Values    []repository.ViewCount
+	Other     int64 // Views of the values beyond the top ones
+}
+
+// TrafficBreakdown holds where views came from over a period
+type TrafficBreakdown struct {
+	PostID     *uint // nil for the whole site
+	Days       int
+	From       time.Time
+	To         time.Time
+	Timezone   string
+	Dimensions []DimensionBreakdown
+}
+
+// GetTrafficBreakdownUseCase reports views by referrer, UTM parameter,
+// device, browser, OS and country, from the daily rollups
+type GetTrafficBreakdownUseCase struct {
+	postRepo      repository.PostRepository
+	analyticsRepo repository.AnalyticsRepository
+	loc           *time.Location
+}
+
+// NewGetTrafficBreakdownUseCase creates a new GetTrafficBreakdownUseCase
+func NewGetTrafficBreakdownUseCase(
+	postRepo repository.PostRepository,
+	analyticsRepo repository.AnalyticsRepository,
+	server config.ServerConfig,
+) *GetTrafficBreakdownUseCase {
+	loc, err := time.LoadLocation(server.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	return &GetTrafficBreakdownUseCase{
+		postRepo:      postRepo,
+		analyticsRepo: analyticsRepo,
+		loc:           loc,
+	}
+}
+
+// Execute breaks down the views of one post, or of all posts when postID is
+// nil, over the last 7, 30 or 90 days including today, keeping the top limit
+// values of each dimension
+func (uc *GetTrafficBreakdownUseCase) Execute(ctx context.Context, postID *uint, days, limit int, now time.Time) (*TrafficBreakdown, error) {
+	if !dashboardRanges[days] {
+		return nil, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"field": "days",
+		})
+	}
+	if limit < 1 || limit > maxTrafficLimit {
+		return nil, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"field": "limit",
+			"max":   maxTrafficLimit,
+		})
+	}
+
+	if postID != nil {
+		post, err := uc.postRepo.GetByID(ctx, *postID)
+		if err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+		if post == nil {
+			return nil, errors.ErrPostNotFound
+		}
+	}
+
+	traffic := &TrafficBreakdown{PostID: postID, Days: days, Timezone: uc.loc.String()}
+	traffic.From, traffic.To = dashboardPeriod(now, uc.loc, days)
+
+	for _, dimension := range entity.ViewDimensions {
+		values, total, err := uc.analyticsRepo.ViewBreakdown(ctx, dimension, postID, traffic.From, traffic.To, uc.loc, limit)
+		if err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+
+		breakdown := DimensionBreakdown{Dimension: dimension, Total: total, Values: values, Other: total}
+		for _, v := range values {
+			breakdown.Other -= v.Views
+		}
+		traffic.Dimensions = append(traffic.Dimensions, breakdown)
+	}
+
+	return traffic, nil
+}
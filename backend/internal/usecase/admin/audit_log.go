@@ -0,0 +1,330 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// AuditRecorder appends privileged actions to the audit log and loads the
+// records they act on for before and after snapshots
+type AuditRecorder struct {
+	auditRepo    repository.AuditLogRepository
+	userRepo     repository.UserRepository
+	postRepo     repository.PostRepository
+	commentRepo  repository.CommentRepository
+	reportRepo   repository.CommentReportRepository
+	categoryRepo repository.CategoryRepository
+	tagRepo      repository.TagRepository
+	banRepo      repository.BanRepository
+	throttleRepo repository.LoginThrottleRepository
+}
+
+// NewAuditRecorder creates a new AuditRecorder
+func NewAuditRecorder(
+	auditRepo repository.AuditLogRepository,
+	userRepo repository.UserRepository,
+	postRepo repository.PostRepository,
+	commentRepo repository.CommentRepository,
+	reportRepo repository.CommentReportRepository,
+	categoryRepo repository.CategoryRepository,
+	tagRepo repository.TagRepository,
+	banRepo repository.BanRepository,
+	throttleRepo repository.LoginThrottleRepository,
+) *AuditRecorder {
+	return &AuditRecorder{
+		auditRepo:    auditRepo,
+		userRepo:     userRepo,
+		postRepo:     postRepo,
+		commentRepo:  commentRepo,
+		reportRepo:   reportRepo,
+		categoryRepo: categoryRepo,
+		tagRepo:      tagRepo,
+		banRepo:      banRepo,
+		throttleRepo: throttleRepo,
+	}
+}
+
+// Snapshot loads the current state of a target, or returns nil when it does
+// not exist or its type has no single record. Users, comments, reports and
+// lockouts are reduced to fields that do not identify a person, so that
+// anonymizing an account leaves nothing about them in the log.
+func (r *AuditRecorder) Snapshot(ctx context.Context, targetType entity.AuditTargetType, id uint) (interface{}, error) {
+	switch targetType {
+	case entity.AuditTargetUser:
+		return snapshotWith(newUserSnapshot)(r.userRepo.FindByID(ctx, id))
+	case entity.AuditTargetPost:
+		return snapshotWith(newPostSnapshot)(r.postRepo.GetByID(ctx, id))
+	case entity.AuditTargetComment:
+		return snapshotWith(newCommentSnapshot)(r.commentRepo.FindByID(ctx, id))
+	case entity.AuditTargetCommentReport:
+		return snapshotWith(newReportSnapshot)(r.reportRepo.FindByID(ctx, id))
+	case entity.AuditTargetCategory:
+		return snapshot(r.categoryRepo.FindByID(ctx, id))
+	case entity.AuditTargetTag:
+		return snapshot(r.tagRepo.FindByID(ctx, id))
+	case entity.AuditTargetBan:
+		return snapshot(r.banRepo.FindByID(ctx, id))
+	case entity.AuditTargetLockout:
+		return snapshotWith(newLockoutSnapshot)(r.throttleRepo.FindByID(ctx, id))
+	}
+	return nil, nil
+}
+
+// Record appends an entry to the audit log
+func (r *AuditRecorder) Record(ctx context.Context, log *entity.AuditLog) error {
+	if err := r.auditRepo.Create(ctx, log); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	return nil
+}
+
+// snapshot returns a loaded record, keeping a missing one an untyped nil
+func snapshot[T any](record *T, err error) (interface{}, error) {
+	if err != nil || record == nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// snapshotWith is snapshot for records that are converted before logging
+func snapshotWith[T, S any](convert func(*T) S) func(*T, error) (interface{}, error) {
+	return func(record *T, err error) (interface{}, error) {
+		if err != nil || record == nil {
+			return nil, err
+		}
+		return convert(record), nil
+	}
+}
+
+// userSnapshot is what the audit log keeps of a user: their role and
+// account state, without the email, nickname, profile or moderation reasons
+type userSnapshot struct {
+	ID                  uint        `json:"id"`
+	UpdatedAt           time.Time   `json:"updated_at"`
+	Role                entity.Role `json:"role"`
+	EmailVerified       bool        `json:"email_verified"`
+	MFAEnabled          bool        `json:"mfa_enabled"`
+	HideActivity        bool        `json:"hide_activity"`
+	SuspendedUntil      *time.Time  `json:"suspended_until,omitempty"`
+	BannedAt            *time.Time  `json:"banned_at,omitempty"`
+	DeletionScheduledAt *time.Time  `json:"deletion_scheduled_at,omitempty"`
+}
+
+func newUserSnapshot(u *entity.User) userSnapshot {
+	return userSnapshot{
+		ID:                  u.ID,
+		UpdatedAt:           u.UpdatedAt,
+		Role:                u.Role,
+		EmailVerified:       u.IsEmailVerified(),
+		MFAEnabled:          u.TOTPEnabledAt != nil,
+		HideActivity:        u.HideActivity,
+		SuspendedUntil:      u.SuspendedUntil,
+		BannedAt:            u.BannedAt,
+		DeletionScheduledAt: u.DeletionScheduledAt,
+	}
+}
+
+// postSnapshot is what the audit log keeps of a post. The author is kept by
+// ID only.
+type postSnapshot struct {
+	ID          uint       `json:"id"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	Title       string     `json:"title"`
+	Slug        string     `json:"slug"`
+	Content     string     `json:"content"`
+	Excerpt     string     `json:"excerpt"`
+	Status      string     `json:"status"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	AuthorID    uint       `json:"author_id"`
+	CategoryID  *uint      `json:"category_id,omitempty"`
+	TagIDs      []uint     `json:"tag_ids,omitempty"`
+}
+
+func newPostSnapshot(p *entity.Post) postSnapshot {
+	snap := postSnapshot{
+		ID:          p.ID,
+		UpdatedAt:   p.UpdatedAt,
+		Title:       p.Title,
+		Slug:        p.Slug,
+		Content:     p.Content,
+		Excerpt:     p.Excerpt,
+		Status:      p.Status,
+		PublishedAt: p.PublishedAt,
+		AuthorID:    p.AuthorID,
+		CategoryID:  p.CategoryID,
+	}
+	for _, tag := range p.Tags {
+		snap.TagIDs = append(snap.TagIDs, tag.ID)
+	}
+	return snap
+}
+
+// commentSnapshot is what the audit log keeps of a comment: its content and
+// moderation state, without the author's name, email or network
+type commentSnapshot struct {
+	ID          uint                 `json:"id"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+	PostID      uint                 `json:"post_id"`
+	ParentID    *uint                `json:"parent_id,omitempty"`
+	UserID      *uint                `json:"user_id,omitempty"`
+	Content     string               `json:"content"`
+	Status      entity.CommentStatus `json:"status"`
+	ModeratedAt *time.Time           `json:"moderated_at,omitempty"`
+	ModeratedBy *uint                `json:"moderated_by,omitempty"`
+	SpamScore   float64              `json:"spam_score"`
+	SpamReasons string               `json:"spam_reasons,omitempty"`
+	PinnedAt    *time.Time           `json:"pinned_at,omitempty"`
+	IsEdited    bool                 `json:"is_edited"`
+}
+
+func newCommentSnapshot(c *entity.Comment) commentSnapshot {
+	return commentSnapshot{
+		ID:          c.ID,
+		UpdatedAt:   c.UpdatedAt,
+		PostID:      c.PostID,
+		ParentID:    c.ParentID,
+		UserID:      c.UserID,
+		Content:     c.Content,
+		Status:      c.Status,
+		ModeratedAt: c.ModeratedAt,
+		ModeratedBy: c.ModeratedBy,
+		SpamScore:   c.SpamScore,
+		SpamReasons: c.SpamReasons,
+		PinnedAt:    c.PinnedAt,
+		IsEdited:    c.IsEdited,
+	}
+}
+
+// reportSnapshot is what the audit log keeps of a report, without the reporter
+type reportSnapshot struct {
+	ID         uint                `json:"id"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+	CommentID  uint                `json:"comment_id"`
+	Reason     entity.ReportReason `json:"reason"`
+	Details    string              `json:"details,omitempty"`
+	Status     entity.ReportStatus `json:"status"`
+	ResolvedAt *time.Time          `json:"resolved_at,omitempty"`
+	ResolvedBy *uint               `json:"resolved_by,omitempty"`
+}
+
+func newReportSnapshot(r *entity.CommentReport) reportSnapshot {
+	return reportSnapshot{
+		ID:         r.ID,
+		UpdatedAt:  r.UpdatedAt,
+		CommentID:  r.CommentID,
+		Reason:     r.Reason,
+		Details:    r.Details,
+		Status:     r.Status,
+		ResolvedAt: r.ResolvedAt,
+		ResolvedBy: r.ResolvedBy,
+	}
+}
+
+// lockoutSnapshot is what the audit log keeps of a login throttle. The
+// identifier is an email or IP address and is left out.
+type lockoutSnapshot struct {
+	ID            uint                      `json:"id"`
+	Scope         entity.LoginThrottleScope `json:"scope"`
+	Failures      int                       `json:"failures"`
+	LastFailureAt time.Time                 `json:"last_failure_at"`
+	LockedUntil   *time.Time                `json:"locked_until,omitempty"`
+}
+
+func newLockoutSnapshot(t *entity.LoginThrottle) lockoutSnapshot {
+	return lockoutSnapshot{
+		ID:            t.ID,
+		Scope:         t.Scope,
+		Failures:      t.Failures,
+		LastFailureAt: t.LastFailureAt,
+		LockedUntil:   t.LockedUntil,
+	}
+}
+
+// ListAuditLogInput represents the filters for listing the audit log
+type ListAuditLogInput struct {
+	ActorID    uint
+	Action     string // Exact action, or a prefix ending in "." such as "user."
+	TargetType entity.AuditTargetType
+	TargetID   uint
+	RequestID  string
+	From       *time.Time
+	To         *time.Time
+}
+
+// ListAuditLogUseCase handles listing the audit log with pagination
+type ListAuditLogUseCase struct {
+	auditRepo repository.AuditLogRepository
+}
+
+// NewListAuditLogUseCase creates a new ListAuditLogUseCase
+func NewListAuditLogUseCase(auditRepo repository.AuditLogRepository) *ListAuditLogUseCase {
+	return &ListAuditLogUseCase{
+		auditRepo: auditRepo,
+	}
+}
+
+// Execute retrieves audit log entries matching the input, newest first
+func (uc *ListAuditLogUseCase) Execute(ctx context.Context, input ListAuditLogInput, page, limit int) ([]entity.AuditLog, int64, error) {
+	// Validate pagination parameters
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	if input.From != nil && input.To != nil && !input.From.Before(*input.To) {
+		return nil, 0, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"param": "to",
+		})
+	}
+
+	logs, total, err := uc.auditRepo.FindAll(ctx, repository.AuditLogFilter{
+		ActorID:    input.ActorID,
+		Action:     strings.TrimSpace(input.Action),
+		TargetType: input.TargetType,
+		TargetID:   input.TargetID,
+		RequestID:  strings.TrimSpace(input.RequestID),
+		From:       input.From,
+		To:         input.To,
+	}, page, limit)
+	if err != nil {
+		return nil, 0, errors.ErrDatabaseError.WithError(err)
+	}
+	return logs, total, nil
+}
+
+// PruneAuditLogUseCase deletes audit log entries past the retention period
+type PruneAuditLogUseCase struct {
+	auditRepo repository.AuditLogRepository
+	retention time.Duration
+}
+
+// NewPruneAuditLogUseCase creates a new PruneAuditLogUseCase
+func NewPruneAuditLogUseCase(auditRepo repository.AuditLogRepository, cfg config.AuditConfig) *PruneAuditLogUseCase {
+	return &PruneAuditLogUseCase{
+		auditRepo: auditRepo,
+		retention: cfg.Retention,
+	}
+}
+
+// Execute deletes the entries older than the retention period at the given
+// time and returns how many were deleted. Nothing is deleted when entries
+// are kept forever.
+func (uc *PruneAuditLogUseCase) Execute(ctx context.Context, now time.Time) (int64, error) {
+	if uc.retention <= 0 {
+		return 0, nil
+	}
+
+	deleted, err := uc.auditRepo.DeleteBefore(ctx, now.Add(-uc.retention))
+	if err != nil {
+		return 0, errors.ErrDatabaseError.WithError(err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,212 @@
+package admin
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/usecase/ban"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/validator"
+)
+
+// ListBansInput represents the filters for listing bans
+type ListBansInput struct {
+	Type       entity.BanType
+	ActiveOnly bool
+}
+
+// ListBansUseCase handles listing bans with pagination
+type ListBansUseCase struct {
+	banRepo repository.BanRepository
+}
+
+// NewListBansUseCase creates a new ListBansUseCase
+func NewListBansUseCase(banRepo repository.BanRepository) *ListBansUseCase {
+	return &ListBansUseCase{
+		banRepo: banRepo,
+	}
+}
+
+// Execute retrieves bans matching the input, newest first
+func (uc *ListBansUseCase) Execute(ctx context.Context, input ListBansInput, page, limit int) ([]entity.Ban, int64, error) {
+	// Validate pagination parameters
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	if input.Type != "" && !input.Type.IsValid() {
+		return nil, 0, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"param": "type",
+		})
+	}
+
+	bans, total, err := uc.banRepo.FindAll(ctx, repository.BanFilter{
+		Type:       input.Type,
+		ActiveOnly: input.ActiveOnly,
+		At:         time.Now(),
+	}, page, limit)
+	if err != nil {
+		return nil, 0, errors.ErrDatabaseError.WithError(err)
+	}
+	return bans, total, nil
+}
+
+// CreateBanInput represents input for adding a ban
+type CreateBanInput struct {
+	ActorID   uint
+	Type      entity.BanType
+	Value     string // IP or CIDR, email address, or user ID
+	Reason    string
+	ExpiresAt *time.Time // nil for a permanent ban
+}
+
+// CreateBanUseCase handles adding bans
+type CreateBanUseCase struct {
+	banRepo  repository.BanRepository
+	userRepo repository.UserRepository
+}
+
+// NewCreateBanUseCase creates a new CreateBanUseCase
+func NewCreateBanUseCase(banRepo repository.BanRepository, userRepo repository.UserRepository) *CreateBanUseCase {
+	return &CreateBanUseCase{
+		banRepo:  banRepo,
+		userRepo: userRepo,
+	}
+}
+
+// Execute adds a ban. IPs are stored as networks and emails only as hashes.
+// Banning a user also signs them out everywhere.
+func (uc *CreateBanUseCase) Execute(ctx context.Context, input CreateBanInput) (*entity.Ban, error) {
+	now := time.Now()
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(now) {
+		return nil, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"field": "expires_at",
+		})
+	}
+
+	var bannedUser *entity.User
+	var value string
+	switch input.Type {
+	case entity.BanTypeIP:
+		network, ok := ban.ParseNetwork(input.Value)
+		if !ok {
+			return nil, invalidBanValue()
+		}
+		value = network
+	case entity.BanTypeEmail:
+		email := strings.TrimSpace(input.Value)
+		if !validator.IsValidEmail(email) {
+			return nil, errors.ErrInvalidEmail
+		}
+		value = ban.HashEmail(email)
+	case entity.BanTypeUser:
+		userID, err := strconv.ParseUint(strings.TrimSpace(input.Value), 10, 32)
+		if err != nil {
+			return nil, invalidBanValue()
+		}
+		bannedUser, err = findModeratedUser(ctx, uc.userRepo, input.ActorID, uint(userID))
+		if err != nil {
+			return nil, err
+		}
+		value = ban.UserValue(bannedUser.ID)
+	default:
+		return nil, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"field": "type",
+		})
+	}
+
+	existing, err := uc.banRepo.FindActive(ctx, input.Type, []string{value}, now)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if len(existing) > 0 {
+		return nil, errors.ErrBanExists.WithDetails(map[string]interface{}{
+			"id": existing[0].ID,
+		})
+	}
+
+	actorID := input.ActorID
+	created := &entity.Ban{
+		Type:      input.Type,
+		Value:     value,
+		Reason:    strings.TrimSpace(input.Reason),
+		ExpiresAt: input.ExpiresAt,
+		CreatedBy: &actorID,
+	}
+	if err := uc.banRepo.Create(ctx, created); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	if bannedUser != nil {
+		bannedUser.TokenVersion++
+		if err := uc.userRepo.Update(ctx, bannedUser); err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+	}
+
+	return created, nil
+}
+
+// invalidBanValue is returned for values that do not fit the ban type
+func invalidBanValue() error {
+	return errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+		"field": "value",
+	})
+}
+
+// DeleteBanUseCase handles lifting bans
+type DeleteBanUseCase struct {
+	banRepo repository.BanRepository
+}
+
+// NewDeleteBanUseCase creates a new DeleteBanUseCase
+func NewDeleteBanUseCase(banRepo repository.BanRepository) *DeleteBanUseCase {
+	return &DeleteBanUseCase{
+		banRepo: banRepo,
+	}
+}
+
+// Execute lifts a ban by ID
+func (uc *DeleteBanUseCase) Execute(ctx context.Context, banID uint) error {
+	existing, err := uc.banRepo.FindByID(ctx, banID)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if existing == nil {
+		return errors.ErrBanNotFound
+	}
+
+	if err := uc.banRepo.Delete(ctx, banID); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	return nil
+}
+
+// PruneExpiredBansUseCase deletes expired bans so banned IPs and email
+// hashes are not kept longer than needed
+type PruneExpiredBansUseCase struct {
+	banRepo repository.BanRepository
+}
+
+// NewPruneExpiredBansUseCase creates a new PruneExpiredBansUseCase
+func NewPruneExpiredBansUseCase(banRepo repository.BanRepository) *PruneExpiredBansUseCase {
+	return &PruneExpiredBansUseCase{
+		banRepo: banRepo,
+	}
+}
+
+// Execute deletes the bans expired at the given time and returns how many were deleted
+func (uc *PruneExpiredBansUseCase) Execute(ctx context.Context, now time.Time) (int64, error) {
+	deleted, err := uc.banRepo.DeleteExpired(ctx, now)
+	if err != nil {
+		return 0, errors.ErrDatabaseError.WithError(err)
+	}
+	return deleted, nil
+}
@@ -2,19 +2,12 @@ package admin
 
 import (
 	"context"
-	"errors"
 	"strings"
 
 	"github.com/gosimple/slug"
 	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/domain/repository"
-)
-
-var (
-	ErrCategoryNotFound     = errors.New("category not found")
-	ErrCategoryNameRequired = errors.New("category name is required")
-	ErrCategorySlugExists   = errors.New("category slug already exists")
-	ErrCategoryNameExists   = errors.New("category name already exists")
+	"github.com/yourusername/viblog/pkg/errors"
 )
 
 // ListCategoriesUseCase handles listing all categories
@@ -56,7 +49,7 @@ func NewCreateCategoryUseCase(categoryRepo repository.CategoryRepository) *Creat
 func (uc *CreateCategoryUseCase) Execute(ctx context.Context, input CreateCategoryInput) (*entity.Category, error) {
 	// Validate input
 	if strings.TrimSpace(input.Name) == "" {
-		return nil, ErrCategoryNameRequired
+		return nil, errors.ErrValidation.WithDetails(map[string]interface{}{"field": "name"})
 	}
 
 	// Check if category name already exists
@@ -65,7 +58,7 @@ func (uc *CreateCategoryUseCase) Execute(ctx context.Context, input CreateCatego
 		return nil, err
 	}
 	if exists {
-		return nil, ErrCategoryNameExists
+		return nil, errors.ErrCategoryExists.WithDetails(map[string]interface{}{"field": "name"})
 	}
 
 	// Generate slug from name
@@ -77,7 +70,7 @@ func (uc *CreateCategoryUseCase) Execute(ctx context.Context, input CreateCatego
 		return nil, err
 	}
 	if slugExists {
-		return nil, ErrCategorySlugExists
+		return nil, errors.ErrCategoryExists.WithDetails(map[string]interface{}{"field": "slug"})
 	}
 
 	// Create category
@@ -117,7 +110,10 @@ func (uc *UpdateCategoryUseCase) Execute(ctx context.Context, categoryID uint, i
 	// Find the category
 	category, err := uc.categoryRepo.FindByID(ctx, categoryID)
 	if err != nil {
-		return nil, ErrCategoryNotFound
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if category == nil {
+		return nil, errors.ErrCategoryNotFound
 	}
 
 	// Update fields if provided
@@ -128,7 +124,7 @@ func (uc *UpdateCategoryUseCase) Execute(ctx context.Context, categoryID uint, i
 			return nil, err
 		}
 		if exists && *input.Name != category.Name {
-			return nil, ErrCategoryNameExists
+			return nil, errors.ErrCategoryExists.WithDetails(map[string]interface{}{"field": "name"})
 		}
 
 		category.Name = *input.Name
@@ -162,9 +158,12 @@ func NewDeleteCategoryUseCase(categoryRepo repository.CategoryRepository) *Delet
 // Execute deletes a category
 func (uc *DeleteCategoryUseCase) Execute(ctx context.Context, categoryID uint) error {
 	// Check if category exists
-	_, err := uc.categoryRepo.FindByID(ctx, categoryID)
+	category, err := uc.categoryRepo.FindByID(ctx, categoryID)
 	if err != nil {
-		return ErrCategoryNotFound
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if category == nil {
+		return errors.ErrCategoryNotFound
 	}
 
 	// Delete the category
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// GetCommentHistoryUseCase handles showing how a comment was edited
+type GetCommentHistoryUseCase struct {
+	commentRepo repository.CommentRepository
+}
+
+// NewGetCommentHistoryUseCase creates a new GetCommentHistoryUseCase
+func NewGetCommentHistoryUseCase(commentRepo repository.CommentRepository) *GetCommentHistoryUseCase {
+	return &GetCommentHistoryUseCase{
+		commentRepo: commentRepo,
+	}
+}
+
+// Execute retrieves a comment and its revisions, oldest first
+func (uc *GetCommentHistoryUseCase) Execute(ctx context.Context, commentID uint) (*entity.Comment, []entity.CommentRevision, error) {
+	comment, err := uc.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if comment == nil {
+		return nil, nil, errors.ErrCommentNotFound
+	}
+
+	revisions, err := uc.commentRepo.FindRevisions(ctx, commentID)
+	if err != nil {
+		return nil, nil, errors.ErrDatabaseError.WithError(err)
+	}
+	return comment, revisions, nil
+}
@@ -0,0 +1,143 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	commentUseCase "github.com/yourusername/viblog/internal/usecase/comment"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// ModerateCommentsInput represents a bulk moderation decision
+type ModerateCommentsInput struct {
+	ModeratorID uint
+	CommentIDs  []uint
+	Status      entity.CommentStatus // approved, spam or rejected
+}
+
+// ModerateCommentsUseCase handles approving, rejecting and marking comments as spam
+type ModerateCommentsUseCase struct {
+	commentRepo      repository.CommentRepository
+	postRepo         repository.PostRepository
+	notificationRepo repository.NotificationRepository
+	mentions         *commentUseCase.MentionNotifier
+	replies          *commentUseCase.ReplyNotifier
+}
+
+// NewModerateCommentsUseCase creates a new ModerateCommentsUseCase
+func NewModerateCommentsUseCase(
+	commentRepo repository.CommentRepository,
+	postRepo repository.PostRepository,
+	notificationRepo repository.NotificationRepository,
+	mentions *commentUseCase.MentionNotifier,
+	replies *commentUseCase.ReplyNotifier,
+) *ModerateCommentsUseCase {
+	return &ModerateCommentsUseCase{
+		commentRepo:      commentRepo,
+		postRepo:         postRepo,
+		notificationRepo: notificationRepo,
+		mentions:         mentions,
+		replies:          replies,
+	}
+}
+
+// Execute sets the status of every given comment and returns the comments
+// that changed. Post comment counts follow the approved comments, and
+// signed-in commenters, the users they mention and the subscribers of the
+// comment a reply answers are notified when a comment is approved.
+func (uc *ModerateCommentsUseCase) Execute(ctx context.Context, input ModerateCommentsInput) ([]entity.Comment, error) {
+	if input.Status == entity.CommentStatusPending || !input.Status.IsValid() {
+		return nil, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"field": "status",
+		})
+	}
+
+	comments, err := uc.commentRepo.FindByIDs(ctx, input.CommentIDs)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	// Apply nothing unless every comment exists
+	found := make(map[uint]bool, len(comments))
+	for _, comment := range comments {
+		found[comment.ID] = true
+	}
+	var missing []uint
+	for _, id := range input.CommentIDs {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, errors.ErrCommentNotFound.WithDetails(map[string]interface{}{
+			"ids": missing,
+		})
+	}
+
+	now := time.Now()
+	changed := make([]entity.Comment, 0, len(comments))
+	for i := range comments {
+		comment := &comments[i]
+		if comment.Status == input.Status {
+			continue
+		}
+		wasApproved := comment.IsApproved()
+
+		comment.Status = input.Status
+		comment.ModeratedAt = &now
+		comment.ModeratedBy = &input.ModeratorID
+		if err := uc.commentRepo.UpdateModeration(ctx, comment); err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+
+		switch {
+		case comment.IsApproved() && !wasApproved:
+			if err := uc.postRepo.IncrementCommentCount(ctx, comment.PostID); err != nil {
+				return nil, errors.ErrDatabaseError.WithError(err)
+			}
+			if err := uc.notifyApproved(ctx, comment, input.ModeratorID); err != nil {
+				return nil, errors.ErrDatabaseError.WithError(err)
+			}
+			if err := uc.mentions.Notify(ctx, comment); err != nil {
+				return nil, err
+			}
+			if err := uc.replies.Notify(ctx, comment); err != nil {
+				return nil, err
+			}
+		case wasApproved && !comment.IsApproved():
+			if err := uc.postRepo.DecrementCommentCount(ctx, comment.PostID); err != nil {
+				return nil, errors.ErrDatabaseError.WithError(err)
+			}
+		}
+
+		changed = append(changed, *comment)
+	}
+
+	return changed, nil
+}
+
+// notifyApproved tells a signed-in commenter their comment is now visible
+func (uc *ModerateCommentsUseCase) notifyApproved(ctx context.Context, comment *entity.Comment, moderatorID uint) error {
+	if comment.UserID == nil || *comment.UserID == moderatorID {
+		return nil
+	}
+
+	message := "Your comment is now visible."
+	if comment.Post != nil {
+		message = fmt.Sprintf("Your comment on \"%s\" is now visible.", comment.Post.Title)
+	}
+
+	return uc.notificationRepo.Create(ctx, &entity.Notification{
+		UserID:    *comment.UserID,
+		Type:      entity.NotificationTypeCommentApproved,
+		Title:     "Comment approved",
+		Message:   message,
+		PostID:    &comment.PostID,
+		CommentID: &comment.ID,
+		ActorID:   &moderatorID,
+		Link:      fmt.Sprintf("/posts/%d#comment-%d", comment.PostID, comment.ID),
+	})
+}
@@ -0,0 +1,173 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// Ways an admin can resolve the reports of a comment
+const (
+	ReportActionDismiss = "dismiss" // Keep the comment and restore it if it was hidden
+	ReportActionDelete  = "delete"  // Delete the comment
+)
+
+// ListCommentReportsInput represents the filters for the reports inbox
+type ListCommentReportsInput struct {
+	Status    entity.ReportStatus
+	Reason    entity.ReportReason
+	CommentID uint
+}
+
+// ListCommentReportsUseCase handles listing comment reports with pagination
+type ListCommentReportsUseCase struct {
+	reportRepo repository.CommentReportRepository
+}
+
+// NewListCommentReportsUseCase creates a new ListCommentReportsUseCase
+func NewListCommentReportsUseCase(reportRepo repository.CommentReportRepository) *ListCommentReportsUseCase {
+	return &ListCommentReportsUseCase{
+		reportRepo: reportRepo,
+	}
+}
+
+// Execute retrieves reports matching the input, oldest first
+func (uc *ListCommentReportsUseCase) Execute(ctx context.Context, input ListCommentReportsInput, page, limit int) ([]entity.CommentReport, int64, error) {
+	// Validate pagination parameters
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	if input.Status != "" && !input.Status.IsValid() {
+		return nil, 0, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"param": "status",
+		})
+	}
+	if input.Reason != "" && !input.Reason.IsValid() {
+		return nil, 0, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"param": "reason",
+		})
+	}
+
+	reports, total, err := uc.reportRepo.FindAll(ctx, repository.CommentReportFilter{
+		Status:    input.Status,
+		Reason:    input.Reason,
+		CommentID: input.CommentID,
+	}, page, limit)
+	if err != nil {
+		return nil, 0, errors.ErrDatabaseError.WithError(err)
+	}
+	return reports, total, nil
+}
+
+// StatusCounts counts reports per status, e.g. for an inbox badge
+func (uc *ListCommentReportsUseCase) StatusCounts(ctx context.Context) (map[entity.ReportStatus]int64, error) {
+	counts, err := uc.reportRepo.CountByStatus(ctx)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	return counts, nil
+}
+
+// ResolveCommentReportInput represents an admin decision on a report
+type ResolveCommentReportInput struct {
+	ModeratorID uint
+	ReportID    uint
+	Action      string // ReportActionDismiss or ReportActionDelete
+}
+
+// ResolveCommentReportUseCase handles dismissing reports or deleting the reported comment
+type ResolveCommentReportUseCase struct {
+	reportRepo      repository.CommentReportRepository
+	commentRepo     repository.CommentRepository
+	postRepo        repository.PostRepository
+	deleteCommentUC *DeleteCommentUseCase
+}
+
+// NewResolveCommentReportUseCase creates a new ResolveCommentReportUseCase
+func NewResolveCommentReportUseCase(
+	reportRepo repository.CommentReportRepository,
+	commentRepo repository.CommentRepository,
+	postRepo repository.PostRepository,
+	deleteCommentUC *DeleteCommentUseCase,
+) *ResolveCommentReportUseCase {
+	return &ResolveCommentReportUseCase{
+		reportRepo:      reportRepo,
+		commentRepo:     commentRepo,
+		postRepo:        postRepo,
+		deleteCommentUC: deleteCommentUC,
+	}
+}
+
+// Execute applies the decision to the reported comment and closes every open
+// report of that comment, returning how many were closed. Dismissing
+// restores a comment the reports had hidden.
+func (uc *ResolveCommentReportUseCase) Execute(ctx context.Context, input ResolveCommentReportInput) (int64, error) {
+	report, err := uc.reportRepo.FindByID(ctx, input.ReportID)
+	if err != nil {
+		return 0, errors.ErrDatabaseError.WithError(err)
+	}
+	if report == nil {
+		return 0, errors.ErrReportNotFound
+	}
+	if !report.IsOpen() {
+		return 0, errors.ErrInvalidOperation.WithDetails(map[string]interface{}{
+			"status": report.Status,
+		})
+	}
+
+	var status entity.ReportStatus
+	switch input.Action {
+	case ReportActionDismiss:
+		if err := uc.restore(ctx, report.CommentID, input.ModeratorID); err != nil {
+			return 0, err
+		}
+		status = entity.ReportStatusDismissed
+	case ReportActionDelete:
+		// The comment may already be gone; the reports are still closed
+		if err := uc.deleteCommentUC.Execute(ctx, report.CommentID); err != nil && !errors.Is(err, errors.ErrCommentNotFound) {
+			return 0, err
+		}
+		status = entity.ReportStatusResolved
+	default:
+		return 0, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"field": "action",
+		})
+	}
+
+	closed, err := uc.reportRepo.ResolveOpenByComment(ctx, report.CommentID, status, input.ModeratorID, time.Now())
+	if err != nil {
+		return 0, errors.ErrDatabaseError.WithError(err)
+	}
+	return closed, nil
+}
+
+// restore approves a comment hidden by reports. Only approved comments can
+// be reported, so a pending comment with open reports was hidden by them.
+func (uc *ResolveCommentReportUseCase) restore(ctx context.Context, commentID, moderatorID uint) error {
+	comment, err := uc.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if comment == nil || comment.Status != entity.CommentStatusPending {
+		return nil
+	}
+
+	now := time.Now()
+	comment.Status = entity.CommentStatusApproved
+	comment.ModeratedAt = &now
+	comment.ModeratedBy = &moderatorID
+	if err := uc.commentRepo.UpdateModeration(ctx, comment); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if err := uc.postRepo.IncrementCommentCount(ctx, comment.PostID); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	return nil
+}
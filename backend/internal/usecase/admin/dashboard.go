@@ -2,68 +2,176 @@ package admin
 
 import (
 	"context"
+	"time"
 
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
 )
 
+const (
+	// DefaultDashboardDays is the dashboard period when none is given
+	DefaultDashboardDays = 30
+	// dashboardTopLimit is the number of top posts and tags shown
+	dashboardTopLimit = 5
+	// dashboardRecentComments is the number of latest comments shown
+	dashboardRecentComments = 10
+)
+
+// dashboardRanges are the selectable dashboard periods in days
+var dashboardRanges = map[int]bool{7: true, 30: true, 90: true}
+
+// DailyPoint is a metric's value on one day
+type DailyPoint struct {
+	Date  string `json:"date"` // YYYY-MM-DD in the server time zone
+	Count int64  `json:"count"`
+}
+
+// DashboardSeries holds the daily time series of the dashboard period
+type DashboardSeries struct {
+	Views     []DailyPoint `json:"views"`
+	Visitors  []DailyPoint `json:"visitors"`
+	Likes     []DailyPoint `json:"likes"`
+	Comments  []DailyPoint `json:"comments"`
+	Bookmarks []DailyPoint `json:"bookmarks"`
+	Signups   []DailyPoint `json:"signups"`
+}
+
 // DashboardStats represents admin dashboard statistics
 type DashboardStats struct {
 	TotalUsers     int64 `json:"total_users"`
 	TotalPosts     int64 `json:"total_posts"`
 	PublishedPosts int64 `json:"published_posts"`
 	TotalComments  int64 `json:"total_comments"`
+
+	// Period the series and rankings cover, from its first local day up to
+	// the end of today
+	Days     int       `json:"days"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Timezone string    `json:"timezone"`
+
+	Series         DashboardSeries           `json:"series"`
+	TopPosts       []repository.PostActivity `json:"top_posts"`
+	TopTags        []repository.TagActivity  `json:"top_tags"`
+	RecentComments []entity.Comment          `json:"recent_comments"` // Latest comments and replies of any status
 }
 
 // GetDashboardUseCase handles retrieving dashboard statistics
 type GetDashboardUseCase struct {
-	userRepo    repository.UserRepository
-	postRepo    repository.PostRepository
-	commentRepo repository.CommentRepository
+	userRepo      repository.UserRepository
+	postRepo      repository.PostRepository
+	commentRepo   repository.CommentRepository
+	analyticsRepo repository.AnalyticsRepository
+	loc           *time.Location
 }
 
-// NewGetDashboardUseCase creates a new GetDashboardUseCase
+// NewGetDashboardUseCase creates a new GetDashboardUseCase reporting days in
+// the server time zone, or UTC when it is unknown
 func NewGetDashboardUseCase(
 	userRepo repository.UserRepository,
 	postRepo repository.PostRepository,
 	commentRepo repository.CommentRepository,
+	analyticsRepo repository.AnalyticsRepository,
+	server config.ServerConfig,
 ) *GetDashboardUseCase {
+	loc, err := time.LoadLocation(server.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
 	return &GetDashboardUseCase{
-		userRepo:    userRepo,
-		postRepo:    postRepo,
-		commentRepo: commentRepo,
+		userRepo:      userRepo,
+		postRepo:      postRepo,
+		commentRepo:   commentRepo,
+		analyticsRepo: analyticsRepo,
+		loc:           loc,
 	}
 }
 
-// Execute retrieves dashboard statistics
-func (uc *GetDashboardUseCase) Execute(ctx context.Context) (*DashboardStats, error) {
-	// Get total user count
-	totalUsers, err := uc.userRepo.GetTotalCount(ctx)
-	if err != nil {
-		return nil, err
+// Execute retrieves the site totals, and the daily series and rankings of
+// the last 7, 30 or 90 days including today. Views, visitors, likes and
+// comments come from the daily rollups, so today's numbers trail by up to
+// one rollup interval.
+func (uc *GetDashboardUseCase) Execute(ctx context.Context, days int, now time.Time) (*DashboardStats, error) {
+	if !dashboardRanges[days] {
+		return nil, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"field": "days",
+		})
 	}
 
-	// Get total post count
-	totalPosts, err := uc.postRepo.GetTotalCount(ctx)
-	if err != nil {
-		return nil, err
+	stats := &DashboardStats{Days: days, Timezone: uc.loc.String()}
+	var err error
+
+	if stats.TotalUsers, err = uc.userRepo.GetTotalCount(ctx); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if stats.TotalPosts, err = uc.postRepo.GetTotalCount(ctx); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if stats.PublishedPosts, err = uc.postRepo.GetPublishedCount(ctx); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if stats.TotalComments, err = uc.commentRepo.GetTotalCount(ctx); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
 	}
 
-	// Get published post count
-	publishedPosts, err := uc.postRepo.GetPublishedCount(ctx)
-	if err != nil {
-		return nil, err
+	stats.From, stats.To = dashboardPeriod(now, uc.loc, days)
+
+	series := []struct {
+		metric repository.AnalyticsMetric
+		points *[]DailyPoint
+	}{
+		{repository.MetricViews, &stats.Series.Views},
+		{repository.MetricVisitors, &stats.Series.Visitors},
+		{repository.MetricLikes, &stats.Series.Likes},
+		{repository.MetricComments, &stats.Series.Comments},
+		{repository.MetricBookmarks, &stats.Series.Bookmarks},
+		{repository.MetricSignups, &stats.Series.Signups},
+	}
+	for _, s := range series {
+		counts, err := uc.analyticsRepo.DailyCounts(ctx, s.metric, stats.From, stats.To, uc.loc)
+		if err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+		*s.points = fillDays(counts, stats.From, days)
 	}
 
-	// Get total comment count
-	totalComments, err := uc.commentRepo.GetTotalCount(ctx)
-	if err != nil {
-		return nil, err
+	if stats.TopPosts, err = uc.analyticsRepo.TopPosts(ctx, stats.From, stats.To, uc.loc, dashboardTopLimit); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if stats.TopTags, err = uc.analyticsRepo.TopTags(ctx, stats.From, stats.To, uc.loc, dashboardTopLimit); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if stats.RecentComments, _, err = uc.commentRepo.FindAll(ctx, repository.CommentFilter{}, 1, dashboardRecentComments); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
 	}
 
-	return &DashboardStats{
-		TotalUsers:     totalUsers,
-		TotalPosts:     totalPosts,
-		PublishedPosts: publishedPosts,
-		TotalComments:  totalComments,
-	}, nil
+	return stats, nil
+}
+
+// dashboardPeriod returns the local midnights starting the period of the last
+// days days including today, and ending it
+func dashboardPeriod(now time.Time, loc *time.Location, days int) (time.Time, time.Time) {
+	local := now.In(loc)
+	from := time.Date(local.Year(), local.Month(), local.Day()-days+1, 0, 0, 0, 0, loc)
+	to := time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, loc)
+	return from, to
+}
+
+// fillDays returns one point per day starting at from, with zero for the
+// days that have no count
+func fillDays(counts []repository.DailyCount, from time.Time, days int) []DailyPoint {
+	byDay := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		byDay[c.Day] = c.Count
+	}
+
+	points := make([]DailyPoint, days)
+	for i := range points {
+		date := from.AddDate(0, 0, i).Format("2006-01-02")
+		points[i] = DailyPoint{Date: date, Count: byDay[date]}
+	}
+	return points
 }
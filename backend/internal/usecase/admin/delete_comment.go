@@ -2,13 +2,9 @@ package admin
 
 import (
 	"context"
-	"errors"
 
 	"github.com/yourusername/viblog/internal/domain/repository"
-)
-
-var (
-	ErrCommentNotFound = errors.New("comment not found")
+	"github.com/yourusername/viblog/pkg/errors"
 )
 
 // DeleteCommentUseCase handles deleting a comment
@@ -33,7 +29,10 @@ func (uc *DeleteCommentUseCase) Execute(ctx context.Context, commentID uint) err
 	// Find the comment first
 	comment, err := uc.commentRepo.FindByID(ctx, commentID)
 	if err != nil {
-		return ErrCommentNotFound
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if comment == nil {
+		return errors.ErrCommentNotFound
 	}
 
 	// Delete the comment
@@ -41,9 +40,11 @@ func (uc *DeleteCommentUseCase) Execute(ctx context.Context, commentID uint) err
 		return err
 	}
 
-	// Decrement the post's comment count
-	if err := uc.postRepo.DecrementCommentCount(ctx, comment.PostID); err != nil {
-		return err
+	// Decrement the post's comment count; held comments were never counted
+	if comment.IsApproved() {
+		if err := uc.postRepo.DecrementCommentCount(ctx, comment.PostID); err != nil {
+			return err
+		}
 	}
 
 	return nil
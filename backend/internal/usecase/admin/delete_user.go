@@ -2,41 +2,45 @@ package admin
 
 import (
 	"context"
-	"errors"
 
 	"github.com/yourusername/viblog/internal/domain/repository"
-)
-
-var (
-	ErrUserNotFound       = errors.New("user not found")
-	ErrCannotDeleteAdmin  = errors.New("cannot delete admin user")
+	"github.com/yourusername/viblog/pkg/errors"
 )
 
 // DeleteUserUseCase handles deleting a user
 type DeleteUserUseCase struct {
-	userRepo repository.UserRepository
+	userRepo     repository.UserRepository
+	userDataRepo repository.UserDataRepository
 }
 
 // NewDeleteUserUseCase creates a new DeleteUserUseCase
-func NewDeleteUserUseCase(userRepo repository.UserRepository) *DeleteUserUseCase {
+func NewDeleteUserUseCase(userRepo repository.UserRepository, userDataRepo repository.UserDataRepository) *DeleteUserUseCase {
 	return &DeleteUserUseCase{
-		userRepo: userRepo,
+		userRepo:     userRepo,
+		userDataRepo: userDataRepo,
 	}
 }
 
-// Execute deletes a user by ID
+// Execute deletes a user by ID. The account is anonymized right away: its
+// comments stay without an author and its personal data is removed.
 func (uc *DeleteUserUseCase) Execute(ctx context.Context, userID uint) error {
 	// Find the user first
 	user, err := uc.userRepo.FindByID(ctx, userID)
 	if err != nil {
-		return ErrUserNotFound
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return errors.ErrUserNotFound
 	}
 
 	// Prevent deletion of admin users
 	if user.IsAdmin {
-		return ErrCannotDeleteAdmin
+		return errors.ErrCannotDeleteAdmin
 	}
 
 	// Delete the user
-	return uc.userRepo.Delete(ctx, userID)
+	if err := uc.userDataRepo.Anonymize(ctx, user); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	return nil
 }
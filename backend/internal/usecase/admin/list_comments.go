@@ -2,11 +2,20 @@ package admin
 
 import (
 	"context"
+	"strings"
 
 	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
 )
 
+// ListCommentsInput represents the search and filters for listing comments
+type ListCommentsInput struct {
+	Status entity.CommentStatus
+	PostID uint
+	Search string // Substring of the content or anonymous author name
+}
+
 // ListCommentsUseCase handles listing all comments with pagination
 type ListCommentsUseCase struct {
 	commentRepo repository.CommentRepository
@@ -19,8 +28,8 @@ func NewListCommentsUseCase(commentRepo repository.CommentRepository) *ListComme
 	}
 }
 
-// Execute retrieves all comments with pagination
-func (uc *ListCommentsUseCase) Execute(ctx context.Context, page, limit int) ([]entity.Comment, int64, error) {
+// Execute retrieves comments matching the input with pagination
+func (uc *ListCommentsUseCase) Execute(ctx context.Context, input ListCommentsInput, page, limit int) ([]entity.Comment, int64, error) {
 	// Validate pagination parameters
 	if page < 1 {
 		page = 1
@@ -29,5 +38,28 @@ func (uc *ListCommentsUseCase) Execute(ctx context.Context, page, limit int) ([]
 		limit = 20
 	}
 
-	return uc.commentRepo.FindAll(ctx, page, limit)
+	if input.Status != "" && !input.Status.IsValid() {
+		return nil, 0, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"param": "status",
+		})
+	}
+
+	comments, total, err := uc.commentRepo.FindAll(ctx, repository.CommentFilter{
+		Status: input.Status,
+		PostID: input.PostID,
+		Search: strings.TrimSpace(input.Search),
+	}, page, limit)
+	if err != nil {
+		return nil, 0, errors.ErrDatabaseError.WithError(err)
+	}
+	return comments, total, nil
+}
+
+// StatusCounts counts comments per moderation status, e.g. for queue badges
+func (uc *ListCommentsUseCase) StatusCounts(ctx context.Context) (map[entity.CommentStatus]int64, error) {
+	counts, err := uc.commentRepo.CountByStatus(ctx)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	return counts, nil
 }
@@ -0,0 +1,93 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// ListPostsInput represents the search, filters and order for listing posts
+type ListPostsInput struct {
+	Status     entity.PostStatus
+	CategoryID uint
+	TagID      uint
+	AuthorID   uint
+	Search     string     // Substring of the title
+	From       *time.Time // Created at or after
+	To         *time.Time // Created before
+	Sort       repository.PostSort
+	Ascending  bool
+}
+
+// ListPostsUseCase handles listing posts of every status, including drafts
+// and scheduled posts, with pagination
+type ListPostsUseCase struct {
+	postRepo repository.PostRepository
+}
+
+// NewListPostsUseCase creates a new ListPostsUseCase
+func NewListPostsUseCase(postRepo repository.PostRepository) *ListPostsUseCase {
+	return &ListPostsUseCase{
+		postRepo: postRepo,
+	}
+}
+
+// Execute retrieves posts matching the input with pagination, newest first
+// unless another sort is given
+func (uc *ListPostsUseCase) Execute(ctx context.Context, input ListPostsInput, page, limit int) ([]*entity.Post, int64, error) {
+	// Validate pagination parameters
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	if input.Status != "" && !input.Status.IsValid() {
+		return nil, 0, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"param": "status",
+		})
+	}
+	if input.Sort == "" {
+		input.Sort = repository.PostSortCreated
+	}
+	if !input.Sort.IsValid() {
+		return nil, 0, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"param": "sort",
+		})
+	}
+	if input.From != nil && input.To != nil && !input.From.Before(*input.To) {
+		return nil, 0, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"param": "to",
+		})
+	}
+
+	posts, total, err := uc.postRepo.FindAll(ctx, repository.PostFilter{
+		Status:     input.Status,
+		CategoryID: input.CategoryID,
+		TagID:      input.TagID,
+		AuthorID:   input.AuthorID,
+		Search:     strings.TrimSpace(input.Search),
+		From:       input.From,
+		To:         input.To,
+		Sort:       input.Sort,
+		Ascending:  input.Ascending,
+	}, page, limit)
+	if err != nil {
+		return nil, 0, errors.ErrDatabaseError.WithError(err)
+	}
+	return posts, total, nil
+}
+
+// StatusCounts counts posts per publication status, e.g. for tab badges
+func (uc *ListPostsUseCase) StatusCounts(ctx context.Context) (map[entity.PostStatus]int64, error) {
+	counts, err := uc.postRepo.CountByStatus(ctx)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	return counts, nil
+}
@@ -2,11 +2,21 @@ package admin
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
 )
 
+// ListUsersInput represents the search and filters for listing users
+type ListUsersInput struct {
+	Search string // Substring of the email or nickname
+	Role   entity.Role
+	Status entity.UserStatus
+}
+
 // ListUsersUseCase handles listing all users with pagination
 type ListUsersUseCase struct {
 	userRepo repository.UserRepository
@@ -19,8 +29,8 @@ func NewListUsersUseCase(userRepo repository.UserRepository) *ListUsersUseCase {
 	}
 }
 
-// Execute retrieves all users with pagination
-func (uc *ListUsersUseCase) Execute(ctx context.Context, page, limit int) ([]entity.User, int64, error) {
+// Execute retrieves users matching the input with pagination
+func (uc *ListUsersUseCase) Execute(ctx context.Context, input ListUsersInput, page, limit int) ([]entity.User, int64, error) {
 	// Validate pagination parameters
 	if page < 1 {
 		page = 1
@@ -29,5 +39,24 @@ func (uc *ListUsersUseCase) Execute(ctx context.Context, page, limit int) ([]ent
 		limit = 20
 	}
 
-	return uc.userRepo.FindAll(ctx, page, limit)
+	if input.Role != "" && !input.Role.IsValid() {
+		return nil, 0, errors.ErrInvalidRole
+	}
+	if input.Status != "" && !input.Status.IsValid() {
+		return nil, 0, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"param": "status",
+		})
+	}
+
+	users, total, err := uc.userRepo.FindAll(ctx, repository.UserFilter{
+		Search: strings.TrimSpace(input.Search),
+		Role:   input.Role,
+		Status: input.Status,
+		At:     time.Now(),
+	}, page, limit)
+	if err != nil {
+		return nil, 0, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return users, total, nil
 }
@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// ListLockoutsUseCase handles listing failed login throttles
+type ListLockoutsUseCase struct {
+	throttleRepo repository.LoginThrottleRepository
+}
+
+// NewListLockoutsUseCase creates a new ListLockoutsUseCase
+func NewListLockoutsUseCase(throttleRepo repository.LoginThrottleRepository) *ListLockoutsUseCase {
+	return &ListLockoutsUseCase{
+		throttleRepo: throttleRepo,
+	}
+}
+
+// Execute retrieves throttled accounts and IPs with pagination. With
+// lockedOnly set only those currently locked are returned.
+func (uc *ListLockoutsUseCase) Execute(ctx context.Context, lockedOnly bool, page, limit int) ([]entity.LoginThrottle, int64, error) {
+	// Validate pagination parameters
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	throttles, total, err := uc.throttleRepo.FindAll(ctx, lockedOnly, time.Now(), page, limit)
+	if err != nil {
+		return nil, 0, errors.ErrDatabaseError.WithError(err)
+	}
+	return throttles, total, nil
+}
+
+// ClearLockoutUseCase handles lifting a lockout
+type ClearLockoutUseCase struct {
+	throttleRepo repository.LoginThrottleRepository
+}
+
+// NewClearLockoutUseCase creates a new ClearLockoutUseCase
+func NewClearLockoutUseCase(throttleRepo repository.LoginThrottleRepository) *ClearLockoutUseCase {
+	return &ClearLockoutUseCase{
+		throttleRepo: throttleRepo,
+	}
+}
+
+// Execute unlocks an account or IP and forgets its failures
+func (uc *ClearLockoutUseCase) Execute(ctx context.Context, id uint) error {
+	throttle, err := uc.throttleRepo.FindByID(ctx, id)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if throttle == nil {
+		return errors.ErrNotFound
+	}
+
+	if err := uc.throttleRepo.Delete(ctx, id); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	return nil
+}
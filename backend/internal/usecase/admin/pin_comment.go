@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// PinCommentUseCase handles pinning a comment to the top of its post
+type PinCommentUseCase struct {
+	commentRepo repository.CommentRepository
+}
+
+// NewPinCommentUseCase creates a new PinCommentUseCase
+func NewPinCommentUseCase(commentRepo repository.CommentRepository) *PinCommentUseCase {
+	return &PinCommentUseCase{
+		commentRepo: commentRepo,
+	}
+}
+
+// Execute pins or unpins a comment. Only approved top-level comments can be
+// pinned, and pinning one unpins the post's previously pinned comment.
+func (uc *PinCommentUseCase) Execute(ctx context.Context, commentID uint, pinned bool) (*entity.Comment, error) {
+	comment, err := uc.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if comment == nil {
+		return nil, errors.ErrCommentNotFound
+	}
+
+	if !pinned {
+		if err := uc.commentRepo.Unpin(ctx, comment.ID); err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+		comment.PinnedAt = nil
+		return comment, nil
+	}
+
+	if comment.IsReply() {
+		return nil, errors.ErrInvalidOperation.WithDetails(map[string]interface{}{
+			"reason": "only top-level comments can be pinned",
+		})
+	}
+	if !comment.IsApproved() {
+		return nil, errors.ErrInvalidOperation.WithDetails(map[string]interface{}{
+			"status": comment.Status,
+		})
+	}
+
+	if err := uc.commentRepo.Pin(ctx, comment, time.Now()); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	return comment, nil
+}
@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// AssignRoleInput represents input for changing a user's role
+type AssignRoleInput struct {
+	ActorID uint // Admin performing the change
+	UserID  uint
+	Role    entity.Role
+}
+
+// AssignRoleUseCase handles changing a user's role
+type AssignRoleUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewAssignRoleUseCase creates a new AssignRoleUseCase
+func NewAssignRoleUseCase(userRepo repository.UserRepository) *AssignRoleUseCase {
+	return &AssignRoleUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute assigns a role to a user. Sessions issued before the change are
+// revoked because tokens carry a snapshot of the role's permissions.
+func (uc *AssignRoleUseCase) Execute(ctx context.Context, input AssignRoleInput) (*entity.User, error) {
+	if !input.Role.IsValid() {
+		return nil, errors.ErrInvalidRole
+	}
+
+	// An admin demoting themselves could lock everyone out of user management
+	if input.ActorID == input.UserID {
+		return nil, errors.ErrInvalidOperation.WithDetails(map[string]interface{}{
+			"reason": "cannot change your own role",
+		})
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return nil, errors.ErrUserNotFound
+	}
+
+	if user.Role == input.Role {
+		return user, nil
+	}
+
+	if user.Role == entity.RoleAdmin {
+		admins, err := uc.userRepo.CountByRole(ctx, entity.RoleAdmin)
+		if err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+		if admins <= 1 {
+			return nil, errors.ErrLastAdmin
+		}
+	}
+
+	user.SetRole(input.Role)
+	user.TokenVersion++
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return user, nil
+}
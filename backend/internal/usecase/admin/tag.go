@@ -2,19 +2,12 @@ package admin
 
 import (
 	"context"
-	"errors"
 	"strings"
 
 	"github.com/gosimple/slug"
 	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/domain/repository"
-)
-
-var (
-	ErrTagNotFound     = errors.New("tag not found")
-	ErrTagNameRequired = errors.New("tag name is required")
-	ErrTagSlugExists   = errors.New("tag slug already exists")
-	ErrTagNameExists   = errors.New("tag name already exists")
+	"github.com/yourusername/viblog/pkg/errors"
 )
 
 // ListTagsUseCase handles listing all tags
@@ -55,7 +48,7 @@ func NewCreateTagUseCase(tagRepo repository.TagRepository) *CreateTagUseCase {
 func (uc *CreateTagUseCase) Execute(ctx context.Context, input CreateTagInput) (*entity.Tag, error) {
 	// Validate input
 	if strings.TrimSpace(input.Name) == "" {
-		return nil, ErrTagNameRequired
+		return nil, errors.ErrValidation.WithDetails(map[string]interface{}{"field": "name"})
 	}
 
 	// Check if tag name already exists
@@ -64,7 +57,7 @@ func (uc *CreateTagUseCase) Execute(ctx context.Context, input CreateTagInput) (
 		return nil, err
 	}
 	if exists {
-		return nil, ErrTagNameExists
+		return nil, errors.ErrTagExists.WithDetails(map[string]interface{}{"field": "name"})
 	}
 
 	// Generate slug from name
@@ -76,7 +69,7 @@ func (uc *CreateTagUseCase) Execute(ctx context.Context, input CreateTagInput) (
 		return nil, err
 	}
 	if slugExists {
-		return nil, ErrTagSlugExists
+		return nil, errors.ErrTagExists.WithDetails(map[string]interface{}{"field": "slug"})
 	}
 
 	// Create tag
@@ -114,7 +107,10 @@ func (uc *UpdateTagUseCase) Execute(ctx context.Context, tagID uint, input Updat
 	// Find the tag
 	tag, err := uc.tagRepo.FindByID(ctx, tagID)
 	if err != nil {
-		return nil, ErrTagNotFound
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if tag == nil {
+		return nil, errors.ErrTagNotFound
 	}
 
 	// Update fields if provided
@@ -125,7 +121,7 @@ func (uc *UpdateTagUseCase) Execute(ctx context.Context, tagID uint, input Updat
 			return nil, err
 		}
 		if exists && *input.Name != tag.Name {
-			return nil, ErrTagNameExists
+			return nil, errors.ErrTagExists.WithDetails(map[string]interface{}{"field": "name"})
 		}
 
 		tag.Name = *input.Name
@@ -155,9 +151,12 @@ func NewDeleteTagUseCase(tagRepo repository.TagRepository) *DeleteTagUseCase {
 // Execute deletes a tag
 func (uc *DeleteTagUseCase) Execute(ctx context.Context, tagID uint) error {
 	// Check if tag exists
-	_, err := uc.tagRepo.FindByID(ctx, tagID)
+	tag, err := uc.tagRepo.FindByID(ctx, tagID)
 	if err != nil {
-		return ErrTagNotFound
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if tag == nil {
+		return errors.ErrTagNotFound
 	}
 
 	// Delete the tag
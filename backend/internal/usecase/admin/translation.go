@@ -0,0 +1,282 @@
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// validateTranslationLocale checks that a locale can hold a translation
+func validateTranslationLocale(locale string, i18n config.I18nConfig) error {
+	if locale == i18n.DefaultLocale {
+		return errors.ErrDefaultLocaleTranslation
+	}
+	for _, supported := range i18n.SupportedLocales {
+		if locale == supported {
+			return nil
+		}
+	}
+	return errors.ErrUnsupportedLocale.WithDetails(map[string]interface{}{"locale": locale})
+}
+
+// ListPostTranslationsUseCase handles listing the translations of a post
+type ListPostTranslationsUseCase struct {
+	postRepo        repository.PostRepository
+	translationRepo repository.TranslationRepository
+}
+
+// NewListPostTranslationsUseCase creates a new ListPostTranslationsUseCase
+func NewListPostTranslationsUseCase(
+	postRepo repository.PostRepository,
+	translationRepo repository.TranslationRepository,
+) *ListPostTranslationsUseCase {
+	return &ListPostTranslationsUseCase{
+		postRepo:        postRepo,
+		translationRepo: translationRepo,
+	}
+}
+
+// Execute retrieves all translations of a post
+func (uc *ListPostTranslationsUseCase) Execute(ctx context.Context, postID uint) ([]entity.PostTranslation, error) {
+	post, err := uc.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if post == nil {
+		return nil, errors.ErrPostNotFound
+	}
+
+	return uc.translationRepo.FindPostTranslations(ctx, postID)
+}
+
+// UpsertPostTranslationInput represents input for saving a post translation
+type UpsertPostTranslationInput struct {
+	Title           string
+	Excerpt         string
+	Content         string
+	MetaTitle       *string
+	MetaDescription *string
+	MetaKeywords    *string
+}
+
+// UpsertPostTranslationUseCase handles creating or replacing a post translation
+type UpsertPostTranslationUseCase struct {
+	postRepo        repository.PostRepository
+	translationRepo repository.TranslationRepository
+	i18n            config.I18nConfig
+}
+
+// NewUpsertPostTranslationUseCase creates a new UpsertPostTranslationUseCase
+func NewUpsertPostTranslationUseCase(
+	postRepo repository.PostRepository,
+	translationRepo repository.TranslationRepository,
+	i18n config.I18nConfig,
+) *UpsertPostTranslationUseCase {
+	return &UpsertPostTranslationUseCase{
+		postRepo:        postRepo,
+		translationRepo: translationRepo,
+		i18n:            i18n,
+	}
+}
+
+// Execute creates or replaces the translation of a post for a locale
+func (uc *UpsertPostTranslationUseCase) Execute(ctx context.Context, postID uint, locale string, input UpsertPostTranslationInput) (*entity.PostTranslation, error) {
+	if err := validateTranslationLocale(locale, uc.i18n); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Title) == "" {
+		return nil, errors.ErrValidation.WithDetails(map[string]interface{}{"field": "title"})
+	}
+
+	post, err := uc.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if post == nil {
+		return nil, errors.ErrPostNotFound
+	}
+
+	translation := &entity.PostTranslation{
+		PostID:          postID,
+		Locale:          locale,
+		Title:           input.Title,
+		Excerpt:         input.Excerpt,
+		Content:         input.Content,
+		MetaTitle:       input.MetaTitle,
+		MetaDescription: input.MetaDescription,
+		MetaKeywords:    input.MetaKeywords,
+	}
+
+	if err := uc.translationRepo.UpsertPostTranslation(ctx, translation); err != nil {
+		return nil, err
+	}
+
+	return translation, nil
+}
+
+// DeletePostTranslationUseCase handles deleting a post translation
+type DeletePostTranslationUseCase struct {
+	translationRepo repository.TranslationRepository
+}
+
+// NewDeletePostTranslationUseCase creates a new DeletePostTranslationUseCase
+func NewDeletePostTranslationUseCase(translationRepo repository.TranslationRepository) *DeletePostTranslationUseCase {
+	return &DeletePostTranslationUseCase{
+		translationRepo: translationRepo,
+	}
+}
+
+// Execute deletes the translation of a post for a locale
+func (uc *DeletePostTranslationUseCase) Execute(ctx context.Context, postID uint, locale string) error {
+	return uc.translationRepo.DeletePostTranslation(ctx, postID, locale)
+}
+
+// UpsertCategoryTranslationInput represents input for saving a category translation
+type UpsertCategoryTranslationInput struct {
+	Name        string
+	Description *string
+}
+
+// UpsertCategoryTranslationUseCase handles creating or replacing a category translation
+type UpsertCategoryTranslationUseCase struct {
+	categoryRepo    repository.CategoryRepository
+	translationRepo repository.TranslationRepository
+	i18n            config.I18nConfig
+}
+
+// NewUpsertCategoryTranslationUseCase creates a new UpsertCategoryTranslationUseCase
+func NewUpsertCategoryTranslationUseCase(
+	categoryRepo repository.CategoryRepository,
+	translationRepo repository.TranslationRepository,
+	i18n config.I18nConfig,
+) *UpsertCategoryTranslationUseCase {
+	return &UpsertCategoryTranslationUseCase{
+		categoryRepo:    categoryRepo,
+		translationRepo: translationRepo,
+		i18n:            i18n,
+	}
+}
+
+// Execute creates or replaces the translation of a category for a locale
+func (uc *UpsertCategoryTranslationUseCase) Execute(ctx context.Context, categoryID uint, locale string, input UpsertCategoryTranslationInput) (*entity.CategoryTranslation, error) {
+	if err := validateTranslationLocale(locale, uc.i18n); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, errors.ErrValidation.WithDetails(map[string]interface{}{"field": "name"})
+	}
+
+	category, err := uc.categoryRepo.FindByID(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, errors.ErrCategoryNotFound
+	}
+
+	translation := &entity.CategoryTranslation{
+		CategoryID:  categoryID,
+		Locale:      locale,
+		Name:        input.Name,
+		Description: input.Description,
+	}
+
+	if err := uc.translationRepo.UpsertCategoryTranslation(ctx, translation); err != nil {
+		return nil, err
+	}
+
+	return translation, nil
+}
+
+// DeleteCategoryTranslationUseCase handles deleting a category translation
+type DeleteCategoryTranslationUseCase struct {
+	translationRepo repository.TranslationRepository
+}
+
+// NewDeleteCategoryTranslationUseCase creates a new DeleteCategoryTranslationUseCase
+func NewDeleteCategoryTranslationUseCase(translationRepo repository.TranslationRepository) *DeleteCategoryTranslationUseCase {
+	return &DeleteCategoryTranslationUseCase{
+		translationRepo: translationRepo,
+	}
+}
+
+// Execute deletes the translation of a category for a locale
+func (uc *DeleteCategoryTranslationUseCase) Execute(ctx context.Context, categoryID uint, locale string) error {
+	return uc.translationRepo.DeleteCategoryTranslation(ctx, categoryID, locale)
+}
+
+// UpsertTagTranslationInput represents input for saving a tag translation
+type UpsertTagTranslationInput struct {
+	Name string
+}
+
+// UpsertTagTranslationUseCase handles creating or replacing a tag translation
+type UpsertTagTranslationUseCase struct {
+	tagRepo         repository.TagRepository
+	translationRepo repository.TranslationRepository
+	i18n            config.I18nConfig
+}
+
+// NewUpsertTagTranslationUseCase creates a new UpsertTagTranslationUseCase
+func NewUpsertTagTranslationUseCase(
+	tagRepo repository.TagRepository,
+	translationRepo repository.TranslationRepository,
+	i18n config.I18nConfig,
+) *UpsertTagTranslationUseCase {
+	return &UpsertTagTranslationUseCase{
+		tagRepo:         tagRepo,
+		translationRepo: translationRepo,
+		i18n:            i18n,
+	}
+}
+
+// Execute creates or replaces the translation of a tag for a locale
+func (uc *UpsertTagTranslationUseCase) Execute(ctx context.Context, tagID uint, locale string, input UpsertTagTranslationInput) (*entity.TagTranslation, error) {
+	if err := validateTranslationLocale(locale, uc.i18n); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, errors.ErrValidation.WithDetails(map[string]interface{}{"field": "name"})
+	}
+
+	tag, err := uc.tagRepo.FindByID(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil {
+		return nil, errors.ErrTagNotFound
+	}
+
+	translation := &entity.TagTranslation{
+		TagID:  tagID,
+		Locale: locale,
+		Name:   input.Name,
+	}
+
+	if err := uc.translationRepo.UpsertTagTranslation(ctx, translation); err != nil {
+		return nil, err
+	}
+
+	return translation, nil
+}
+
+// DeleteTagTranslationUseCase handles deleting a tag translation
+type DeleteTagTranslationUseCase struct {
+	translationRepo repository.TranslationRepository
+}
+
+// NewDeleteTagTranslationUseCase creates a new DeleteTagTranslationUseCase
+func NewDeleteTagTranslationUseCase(translationRepo repository.TranslationRepository) *DeleteTagTranslationUseCase {
+	return &DeleteTagTranslationUseCase{
+		translationRepo: translationRepo,
+	}
+}
+
+// Execute deletes the translation of a tag for a locale
+func (uc *DeleteTagTranslationUseCase) Execute(ctx context.Context, tagID uint, locale string) error {
+	return uc.translationRepo.DeleteTagTranslation(ctx, tagID, locale)
+}
@@ -0,0 +1,217 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/validator"
+)
+
+// findModeratedUser loads the target of a moderation action. Admins cannot
+// act on themselves, and other admins must be demoted first.
+func findModeratedUser(ctx context.Context, userRepo repository.UserRepository, actorID, userID uint) (*entity.User, error) {
+	if actorID == userID {
+		return nil, errors.ErrInvalidOperation.WithDetails(map[string]interface{}{
+			"reason": "cannot moderate your own account",
+		})
+	}
+
+	user, err := userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return nil, errors.ErrUserNotFound
+	}
+	if user.Role == entity.RoleAdmin {
+		return nil, errors.ErrInvalidOperation.WithDetails(map[string]interface{}{
+			"reason": "demote the admin before moderating them",
+		})
+	}
+
+	return user, nil
+}
+
+// SuspendUserInput represents input for suspending a user
+type SuspendUserInput struct {
+	ActorID uint
+	UserID  uint
+	Reason  string
+	Until   time.Time
+}
+
+// SuspendUserUseCase handles temporarily suspending a user
+type SuspendUserUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewSuspendUserUseCase creates a new SuspendUserUseCase
+func NewSuspendUserUseCase(userRepo repository.UserRepository) *SuspendUserUseCase {
+	return &SuspendUserUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute suspends a user until the given time. The user cannot sign in or
+// use existing sessions until then; sessions resume once it expires.
+func (uc *SuspendUserUseCase) Execute(ctx context.Context, input SuspendUserInput) (*entity.User, error) {
+	if !input.Until.After(time.Now()) {
+		return nil, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"param": "until",
+		})
+	}
+
+	user, err := findModeratedUser(ctx, uc.userRepo, input.ActorID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	reason := strings.TrimSpace(input.Reason)
+	until := input.Until
+	user.SuspendedUntil = &until
+	user.SuspensionReason = &reason
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return user, nil
+}
+
+// BanUserInput represents input for banning a user
+type BanUserInput struct {
+	ActorID uint
+	UserID  uint
+	Reason  string
+}
+
+// BanUserUseCase handles permanently banning a user
+type BanUserUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewBanUserUseCase creates a new BanUserUseCase
+func NewBanUserUseCase(userRepo repository.UserRepository) *BanUserUseCase {
+	return &BanUserUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute bans a user until an admin reinstates them. Every session is
+// revoked, so reinstating requires signing in again.
+func (uc *BanUserUseCase) Execute(ctx context.Context, input BanUserInput) (*entity.User, error) {
+	user, err := findModeratedUser(ctx, uc.userRepo, input.ActorID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	reason := strings.TrimSpace(input.Reason)
+	user.BannedAt = &now
+	user.BanReason = &reason
+	user.TokenVersion++
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return user, nil
+}
+
+// ReinstateUserUseCase handles lifting a user's suspension and ban
+type ReinstateUserUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewReinstateUserUseCase creates a new ReinstateUserUseCase
+func NewReinstateUserUseCase(userRepo repository.UserRepository) *ReinstateUserUseCase {
+	return &ReinstateUserUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute clears any suspension or ban of the user
+func (uc *ReinstateUserUseCase) Execute(ctx context.Context, userID uint) (*entity.User, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return nil, errors.ErrUserNotFound
+	}
+
+	user.SuspendedUntil = nil
+	user.SuspensionReason = nil
+	user.BannedAt = nil
+	user.BanReason = nil
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return user, nil
+}
+
+// UpdateUserInput represents an admin edit of a user's public profile.
+// Nil fields are left unchanged; an empty AvatarURL removes the avatar.
+type UpdateUserInput struct {
+	UserID    uint
+	Nickname  *string
+	AvatarURL *string
+}
+
+// UpdateUserUseCase handles admins editing a user's nickname and avatar
+type UpdateUserUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewUpdateUserUseCase creates a new UpdateUserUseCase
+func NewUpdateUserUseCase(userRepo repository.UserRepository) *UpdateUserUseCase {
+	return &UpdateUserUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute updates the user's nickname and avatar, e.g. to remove offensive ones
+func (uc *UpdateUserUseCase) Execute(ctx context.Context, input UpdateUserInput) (*entity.User, error) {
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return nil, errors.ErrUserNotFound
+	}
+
+	if input.Nickname != nil && *input.Nickname != user.Nickname {
+		if !validator.IsValidNickname(*input.Nickname) {
+			return nil, errors.ErrInvalidNickname
+		}
+		existing, err := uc.userRepo.FindByNickname(ctx, *input.Nickname)
+		if err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+		if existing != nil && existing.ID != user.ID {
+			return nil, errors.ErrNicknameExists
+		}
+		user.Nickname = *input.Nickname
+	}
+
+	if input.AvatarURL != nil {
+		if *input.AvatarURL == "" {
+			user.AvatarURL = nil
+		} else {
+			if !validator.IsValidURL(*input.AvatarURL) {
+				return nil, errors.ErrInvalidURL
+			}
+			avatarURL := *input.AvatarURL
+			user.AvatarURL = &avatarURL
+		}
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,131 @@
+package ban
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/utils"
+)
+
+// Subject is who is attempting a write. Zero fields are not checked.
+type Subject struct {
+	IP     string
+	Email  string
+	UserID *uint
+}
+
+// Guard rejects banned users, email addresses and IP networks
+type Guard struct {
+	banRepo repository.BanRepository
+}
+
+// NewGuard creates a new Guard
+func NewGuard(banRepo repository.BanRepository) *Guard {
+	return &Guard{
+		banRepo: banRepo,
+	}
+}
+
+// Check returns ErrBanned when an active ban matches the subject, with the
+// ban's reason and expiry as details. The matching ban's hit count is updated.
+func (g *Guard) Check(ctx context.Context, subject Subject) error {
+	now := time.Now()
+
+	ban, err := g.find(ctx, subject, now)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if ban == nil {
+		return nil
+	}
+
+	if err := g.banRepo.RecordHit(ctx, ban.ID, now); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+
+	details := map[string]interface{}{
+		"reason": ban.Reason,
+	}
+	if ban.ExpiresAt != nil {
+		details["expires_at"] = ban.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return errors.ErrBanned.WithDetails(details)
+}
+
+// find returns the first active ban matching the subject
+func (g *Guard) find(ctx context.Context, subject Subject, now time.Time) (*entity.Ban, error) {
+	if subject.UserID != nil {
+		bans, err := g.banRepo.FindActive(ctx, entity.BanTypeUser, []string{UserValue(*subject.UserID)}, now)
+		if err != nil || len(bans) > 0 {
+			return first(bans), err
+		}
+	}
+
+	if subject.Email != "" {
+		bans, err := g.banRepo.FindActive(ctx, entity.BanTypeEmail, []string{HashEmail(subject.Email)}, now)
+		if err != nil || len(bans) > 0 {
+			return first(bans), err
+		}
+	}
+
+	if ip := net.ParseIP(utils.NormalizeIP(subject.IP)); ip != nil {
+		// Networks cannot be matched with an index, but active IP bans are few
+		bans, err := g.banRepo.FindActive(ctx, entity.BanTypeIP, nil, now)
+		if err != nil {
+			return nil, err
+		}
+		for i := range bans {
+			if _, network, err := net.ParseCIDR(bans[i].Value); err == nil && network.Contains(ip) {
+				return &bans[i], nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// first returns the first ban of a list, or nil
+func first(bans []entity.Ban) *entity.Ban {
+	if len(bans) == 0 {
+		return nil
+	}
+	return &bans[0]
+}
+
+// HashEmail returns the value an email ban is stored under
+func HashEmail(email string) string {
+	return utils.HashToken(strings.ToLower(strings.TrimSpace(email)))
+}
+
+// UserValue returns the value a user ban is stored under
+func UserValue(userID uint) string {
+	return strconv.FormatUint(uint64(userID), 10)
+}
+
+// ParseNetwork returns the CIDR an IP ban is stored under. A single address
+// becomes a /32 (IPv4) or /128 (IPv6) network.
+func ParseNetwork(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "/") {
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return "", false
+		}
+		return network.String(), true
+	}
+
+	ip := net.ParseIP(utils.NormalizeIP(value))
+	if ip == nil {
+		return "", false
+	}
+	if ip.To4() != nil {
+		return ip.String() + "/32", true
+	}
+	return ip.String() + "/128", true
+}
@@ -0,0 +1,278 @@
+package comment
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/infrastructure/spam"
+	"github.com/yourusername/viblog/internal/usecase/ban"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/password"
+	"github.com/yourusername/viblog/pkg/utils"
+	"github.com/yourusername/viblog/pkg/validator"
+)
+
+// Reasons a new comment is held for moderation
+const (
+	HoldReasonAll       = "hold_all"
+	HoldReasonAnonymous = "anonymous"
+	HoldReasonLinks     = "links"
+	HoldReasonSpamCheck = "spam_check"
+)
+
+// CreateInput represents the input for creating a comment or reply
+type CreateInput struct {
+	PostID   uint // May be left 0 for replies; the parent's post is used
+	ParentID *uint
+	UserID   *uint // nil for anonymous comments
+	Content  string
+
+	// Anonymous comments only
+	AuthorName     string
+	AuthorEmail    string
+	AuthorPassword string // Lets the author edit or delete the comment later
+	NotifyReplies  bool   // Email AuthorEmail about replies once confirmed
+	Locale         string // Language of the notification emails
+
+	// Spam check signals
+	IP        string
+	UserAgent string
+	Honeypot  string
+	FormToken string
+}
+
+// CreateUseCase handles creating comments
+type CreateUseCase struct {
+	commentRepo repository.CommentRepository
+	postRepo    repository.PostRepository
+	userRepo    repository.UserRepository
+	banGuard    *ban.Guard
+	spamChecker spam.Checker
+	formTokens  *spam.FormTokens
+	mentions    *MentionNotifier
+	replies     *ReplyNotifier
+	cfg         config.CommentConfig
+}
+
+// NewCreateUseCase creates a new CreateUseCase
+func NewCreateUseCase(
+	commentRepo repository.CommentRepository,
+	postRepo repository.PostRepository,
+	userRepo repository.UserRepository,
+	banGuard *ban.Guard,
+	spamChecker spam.Checker,
+	formTokens *spam.FormTokens,
+	mentions *MentionNotifier,
+	replies *ReplyNotifier,
+	cfg config.CommentConfig,
+) *CreateUseCase {
+	return &CreateUseCase{
+		commentRepo: commentRepo,
+		postRepo:    postRepo,
+		userRepo:    userRepo,
+		banGuard:    banGuard,
+		spamChecker: spamChecker,
+		formTokens:  formTokens,
+		mentions:    mentions,
+		replies:     replies,
+		cfg:         cfg,
+	}
+}
+
+// IssueFormToken returns a token to submit with the comment form, used to
+// tell bots that post instantly from people
+func (uc *CreateUseCase) IssueFormToken(now time.Time) string {
+	return uc.formTokens.Issue(now)
+}
+
+// Execute creates a comment on a published post. Banned users, addresses
+// and networks are turned away. Comments the spam check
+// flags are filed as spam, and comments it doubts or that match a hold rule
+// are saved as pending; both only count towards the post, and notify the
+// users they mention and the subscribers of the comment they reply to, once
+// approved. Anonymous authors asking for reply emails are sent a
+// confirmation link unless the comment is spam.
+func (uc *CreateUseCase) Execute(ctx context.Context, input CreateInput) (*entity.Comment, error) {
+	content := strings.TrimSpace(input.Content)
+	if content == "" {
+		return nil, errors.ErrValidation.WithDetails(map[string]interface{}{
+			"field": "content",
+		})
+	}
+
+	postID := input.PostID
+	if input.ParentID != nil {
+		parent, err := uc.commentRepo.FindByID(ctx, *input.ParentID)
+		if err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+		// Replies stay on the parent's post and cannot target hidden comments
+		if parent == nil || !parent.IsApproved() || (postID != 0 && parent.PostID != postID) {
+			return nil, errors.ErrCommentNotFound
+		}
+		postID = parent.PostID
+	}
+
+	post, err := uc.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if post == nil || post.Status != "published" {
+		return nil, errors.ErrPostNotFound
+	}
+
+	comment := &entity.Comment{
+		PostID:   post.ID,
+		ParentID: input.ParentID,
+		Content:  content,
+	}
+
+	var author *entity.User
+	if input.UserID != nil {
+		author, err = uc.userRepo.FindByID(ctx, *input.UserID)
+		if err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+		if author == nil {
+			return nil, errors.ErrUserNotFound
+		}
+		comment.UserID = &author.ID
+	} else {
+		if err := uc.setAnonymousAuthor(comment, input); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := renderContent(ctx, uc.userRepo, comment); err != nil {
+		return nil, err
+	}
+
+	// Only the network is kept, so the commenter can be banned without
+	// storing their address
+	comment.AuthorIPHash = utils.HashIP(utils.NormalizeIP(input.IP))
+
+	comment.Status = entity.CommentStatusApproved
+	if !isModerator(author) {
+		if err := uc.banGuard.Check(ctx, ban.Subject{
+			IP:     input.IP,
+			Email:  derefString(comment.AuthorEmail),
+			UserID: comment.UserID,
+		}); err != nil {
+			return nil, err
+		}
+
+		verdict := uc.spamChecker.Check(ctx, &spam.Submission{
+			PostID:      post.ID,
+			UserID:      comment.UserID,
+			AuthorName:  derefString(comment.AuthorName),
+			AuthorEmail: derefString(comment.AuthorEmail),
+			Content:     content,
+			IP:          input.IP,
+			UserAgent:   input.UserAgent,
+			Honeypot:    input.Honeypot,
+			FormToken:   input.FormToken,
+			SubmittedAt: time.Now(),
+		})
+		comment.SpamScore = verdict.Score
+		comment.SpamReasons = strings.Join(verdict.Reasons(), ",")
+
+		switch {
+		case verdict.Decision == spam.DecisionSpam:
+			comment.Status = entity.CommentStatusSpam
+		case holdReason(uc.cfg, author, content, verdict) != "":
+			comment.Status = entity.CommentStatusPending
+		}
+	}
+
+	if err := uc.commentRepo.Create(ctx, comment); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	comment.User = author
+	comment.Post = post
+	if comment.IsApproved() {
+		if err := uc.postRepo.IncrementCommentCount(ctx, post.ID); err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+		if err := uc.mentions.Notify(ctx, comment); err != nil {
+			return nil, err
+		}
+		if err := uc.replies.Notify(ctx, comment); err != nil {
+			return nil, err
+		}
+	}
+	if input.NotifyReplies && author == nil && comment.Status != entity.CommentStatusSpam {
+		if err := uc.replies.Subscribe(ctx, comment, input.Locale); err != nil {
+			return nil, err
+		}
+	}
+
+	return comment, nil
+}
+
+// setAnonymousAuthor validates and stores an anonymous commenter's details
+func (uc *CreateUseCase) setAnonymousAuthor(comment *entity.Comment, input CreateInput) error {
+	name := strings.TrimSpace(input.AuthorName)
+	if name == "" {
+		return errors.ErrValidation.WithDetails(map[string]interface{}{
+			"field": "author_name",
+		})
+	}
+	if input.AuthorPassword == "" {
+		return errors.ErrValidation.WithDetails(map[string]interface{}{
+			"field": "author_password",
+		})
+	}
+
+	hashed, err := password.Hash(input.AuthorPassword)
+	if err != nil {
+		return errors.ErrInternal.WithError(err)
+	}
+	comment.AuthorName = &name
+	comment.AuthorPassword = &hashed
+
+	if email := strings.TrimSpace(input.AuthorEmail); email != "" {
+		if !validator.IsValidEmail(email) {
+			return errors.ErrInvalidEmail
+		}
+		comment.AuthorEmail = &email
+	} else if input.NotifyReplies {
+		// Reply emails need an address to go to
+		return errors.ErrValidation.WithDetails(map[string]interface{}{
+			"field": "author_email",
+		})
+	}
+	return nil
+}
+
+// holdReason returns the first hold rule the comment matches, or "" when it
+// can be published right away
+func holdReason(cfg config.CommentConfig, author *entity.User, content string, verdict spam.Verdict) string {
+	switch {
+	case verdict.Decision == spam.DecisionHold:
+		return HoldReasonSpamCheck
+	case cfg.HoldAll:
+		return HoldReasonAll
+	case cfg.HoldAnonymous && author == nil:
+		return HoldReasonAnonymous
+	case cfg.HoldLinks && len(spam.Links(content)) > 0:
+		return HoldReasonLinks
+	}
+	return ""
+}
+
+// isModerator reports whether the author's comments skip spam checks and hold rules
+func isModerator(author *entity.User) bool {
+	return author != nil && author.Role.Has(entity.PermissionCommentsModerate)
+}
+
+// derefString returns the string a pointer points to, or ""
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,113 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// ListUseCase handles listing the public comments of a post
+type ListUseCase struct {
+	commentRepo repository.CommentRepository
+	postRepo    repository.PostRepository
+}
+
+// NewListUseCase creates a new ListUseCase
+func NewListUseCase(
+	commentRepo repository.CommentRepository,
+	postRepo repository.PostRepository,
+) *ListUseCase {
+	return &ListUseCase{
+		commentRepo: commentRepo,
+		postRepo:    postRepo,
+	}
+}
+
+// Execute retrieves the approved top-level comments of a published post,
+// the pinned comment first, with their reply counts. An empty sort lists
+// the oldest first.
+func (uc *ListUseCase) Execute(ctx context.Context, postID uint, sort repository.CommentSort) ([]entity.Comment, error) {
+	if sort == "" {
+		sort = repository.CommentSortOldest
+	}
+	if !sort.IsValid() {
+		return nil, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"param": "sort",
+		})
+	}
+
+	post, err := uc.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if post == nil || post.Status != "published" {
+		return nil, errors.ErrPostNotFound
+	}
+
+	comments, err := uc.commentRepo.FindByPostID(ctx, postID, sort)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if err := withThreadInfo(ctx, uc.commentRepo, comments, post); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// ListRepliesUseCase handles listing the public replies to a comment
+type ListRepliesUseCase struct {
+	commentRepo repository.CommentRepository
+}
+
+// NewListRepliesUseCase creates a new ListRepliesUseCase
+func NewListRepliesUseCase(commentRepo repository.CommentRepository) *ListRepliesUseCase {
+	return &ListRepliesUseCase{
+		commentRepo: commentRepo,
+	}
+}
+
+// Execute retrieves the approved replies to an approved comment on a
+// published post, oldest first, with their own reply counts
+func (uc *ListRepliesUseCase) Execute(ctx context.Context, commentID uint) ([]entity.Comment, error) {
+	parent, err := uc.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if parent == nil || !parent.IsApproved() {
+		return nil, errors.ErrCommentNotFound
+	}
+	if parent.Post == nil || parent.Post.Status != "published" {
+		return nil, errors.ErrPostNotFound
+	}
+
+	replies, err := uc.commentRepo.FindReplies(ctx, commentID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if err := withThreadInfo(ctx, uc.commentRepo, replies, parent.Post); err != nil {
+		return nil, err
+	}
+	return replies, nil
+}
+
+// withThreadInfo sets the reply counts of the comments, and their post so
+// replies by the post's author can be flagged
+func withThreadInfo(ctx context.Context, commentRepo repository.CommentRepository, comments []entity.Comment, post *entity.Post) error {
+	ids := make([]uint, len(comments))
+	for i := range comments {
+		ids[i] = comments[i].ID
+	}
+
+	counts, err := commentRepo.CountReplies(ctx, ids)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+
+	for i := range comments {
+		comments[i].ReplyCount = counts[comments[i].ID]
+		comments[i].Post = post
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/markdown"
+)
+
+// maxMentions limits how many users one comment can notify
+const maxMentions = 10
+
+// renderContent renders a comment's Markdown and records the users it
+// mentions. Mentions of unknown nicknames stay plain text, and authors are
+// not recorded as mentioning themselves.
+func renderContent(ctx context.Context, userRepo repository.UserRepository, comment *entity.Comment) error {
+	nicknames := markdown.Mentions(comment.Content)
+	if len(nicknames) > maxMentions {
+		nicknames = nicknames[:maxMentions]
+	}
+
+	mentioned := make(map[string]*entity.User, len(nicknames))
+	comment.Mentions = nil
+	for _, nickname := range nicknames {
+		user, err := userRepo.FindByNickname(ctx, nickname)
+		if err != nil {
+			return errors.ErrDatabaseError.WithError(err)
+		}
+		if user == nil {
+			continue
+		}
+		mentioned[nickname] = user
+		if comment.UserID == nil || *comment.UserID != user.ID {
+			comment.Mentions = append(comment.Mentions, entity.CommentMention{UserID: user.ID})
+		}
+	}
+
+	comment.ContentHTML = markdown.Render(comment.Content, func(nickname string) string {
+		if _, ok := mentioned[nickname]; !ok {
+			return ""
+		}
+		return "/users/" + url.PathEscape(nickname)
+	})
+	return nil
+}
+
+// MentionNotifier notifies users mentioned in a comment once it is visible
+type MentionNotifier struct {
+	notificationRepo repository.NotificationRepository
+}
+
+// NewMentionNotifier creates a new MentionNotifier
+func NewMentionNotifier(notificationRepo repository.NotificationRepository) *MentionNotifier {
+	return &MentionNotifier{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// Notify sends a mention notification to every user the comment mentions.
+// The comment's Mentions, and its User and Post when set, are used.
+func (n *MentionNotifier) Notify(ctx context.Context, comment *entity.Comment) error {
+	if len(comment.Mentions) == 0 {
+		return nil
+	}
+
+	author := "Someone"
+	if comment.User != nil {
+		author = comment.User.Nickname
+	} else if comment.AuthorName != nil {
+		author = *comment.AuthorName
+	}
+	message := fmt.Sprintf("%s mentioned you in a comment.", author)
+	if comment.Post != nil {
+		message = fmt.Sprintf("%s mentioned you in a comment on \"%s\".", author, comment.Post.Title)
+	}
+
+	for _, mention := range comment.Mentions {
+		if err := n.notificationRepo.Create(ctx, &entity.Notification{
+			UserID:    mention.UserID,
+			Type:      entity.NotificationTypeMention,
+			Title:     "You were mentioned",
+			Message:   message,
+			PostID:    &comment.PostID,
+			CommentID: &comment.ID,
+			ActorID:   comment.UserID,
+			Link:      fmt.Sprintf("/posts/%d#comment-%d", comment.PostID, comment.ID),
+		}); err != nil {
+			return errors.ErrDatabaseError.WithError(err)
+		}
+	}
+	return nil
+}
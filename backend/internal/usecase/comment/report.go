@@ -0,0 +1,136 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/utils"
+)
+
+// ReportInput represents a reader's report of a comment
+type ReportInput struct {
+	CommentID uint
+	UserID    *uint  // nil for anonymous readers
+	IP        string // Identifies anonymous readers; only its hash is stored
+	Reason    entity.ReportReason
+	Details   string
+}
+
+// ReportOutput is the result of a report
+type ReportOutput struct {
+	Report *entity.CommentReport
+	// Hidden means this report took the comment over the report threshold
+	// and it was moved back to the moderation queue
+	Hidden bool
+}
+
+// ReportUseCase handles readers reporting comments
+type ReportUseCase struct {
+	commentRepo repository.CommentRepository
+	postRepo    repository.PostRepository
+	reportRepo  repository.CommentReportRepository
+	threshold   int
+	keySecret   string
+}
+
+// NewReportUseCase creates a new ReportUseCase
+func NewReportUseCase(
+	commentRepo repository.CommentRepository,
+	postRepo repository.PostRepository,
+	reportRepo repository.CommentReportRepository,
+	cfg config.CommentConfig,
+) *ReportUseCase {
+	return &ReportUseCase{
+		commentRepo: commentRepo,
+		postRepo:    postRepo,
+		reportRepo:  reportRepo,
+		threshold:   cfg.ReportThreshold,
+		keySecret:   cfg.ReporterKeySecret,
+	}
+}
+
+// Execute files a report against a visible comment. Each user, or each IP
+// for anonymous readers, can report a comment once. Once a comment has
+// collected ReportThreshold open reports it is hidden as pending.
+func (uc *ReportUseCase) Execute(ctx context.Context, input ReportInput) (*ReportOutput, error) {
+	if !input.Reason.IsValid() {
+		return nil, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"field": "reason",
+		})
+	}
+
+	comment, err := uc.commentRepo.FindByID(ctx, input.CommentID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if comment == nil || !comment.IsApproved() {
+		return nil, errors.ErrCommentNotFound
+	}
+	if input.UserID != nil && comment.UserID != nil && *input.UserID == *comment.UserID {
+		return nil, errors.ErrInvalidOperation.WithDetails(map[string]interface{}{
+			"reason": "own_comment",
+		})
+	}
+
+	reporterKey := utils.HMACToken(uc.keySecret, utils.NormalizeIP(input.IP))
+	if input.UserID != nil {
+		reporterKey = fmt.Sprintf("user:%d", *input.UserID)
+	}
+
+	report := &entity.CommentReport{
+		CommentID:   comment.ID,
+		Reason:      input.Reason,
+		Details:     strings.TrimSpace(input.Details),
+		ReporterID:  input.UserID,
+		ReporterKey: reporterKey,
+		Status:      entity.ReportStatusOpen,
+	}
+	created, err := uc.reportRepo.CreateUnlessReported(ctx, report)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if !created {
+		return nil, errors.ErrAlreadyReported
+	}
+
+	hidden, err := uc.hideIfOverThreshold(ctx, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReportOutput{Report: report, Hidden: hidden}, nil
+}
+
+// hideIfOverThreshold moves the comment back to pending once it has enough
+// open reports. It no longer counts towards the post until approved again.
+func (uc *ReportUseCase) hideIfOverThreshold(ctx context.Context, comment *entity.Comment) (bool, error) {
+	if uc.threshold <= 0 {
+		return false, nil
+	}
+
+	open, err := uc.reportRepo.CountOpenByComment(ctx, comment.ID)
+	if err != nil {
+		return false, errors.ErrDatabaseError.WithError(err)
+	}
+	if open < int64(uc.threshold) {
+		return false, nil
+	}
+
+	now := time.Now()
+	comment.Status = entity.CommentStatusPending
+	comment.ModeratedAt = &now
+	comment.ModeratedBy = nil
+	if err := uc.commentRepo.UpdateModeration(ctx, comment); err != nil {
+		return false, errors.ErrDatabaseError.WithError(err)
+	}
+	if err := uc.postRepo.DecrementCommentCount(ctx, comment.PostID); err != nil {
+		return false, errors.ErrDatabaseError.WithError(err)
+	}
+	return true, nil
+}
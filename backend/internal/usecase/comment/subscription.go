@@ -0,0 +1,255 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/infrastructure/mail"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/utils"
+)
+
+const (
+	// subscriptionTokenBytes is the amount of randomness in subscription tokens
+	subscriptionTokenBytes = 32
+	// replyExcerptLength is the number of characters of a reply quoted in the email
+	replyExcerptLength = 200
+)
+
+// ReplyNotifier emails anonymous commenters who subscribed to replies on
+// their comment. Emails go through the mail queue, which limits how many
+// each address receives.
+type ReplyNotifier struct {
+	subscriptionRepo repository.CommentSubscriptionRepository
+	commentRepo      repository.CommentRepository
+	queue            *mail.Queue
+	account          config.AccountConfig
+	cfg              config.CommentConfig
+}
+
+// NewReplyNotifier creates a new ReplyNotifier
+func NewReplyNotifier(
+	subscriptionRepo repository.CommentSubscriptionRepository,
+	commentRepo repository.CommentRepository,
+	queue *mail.Queue,
+	account config.AccountConfig,
+	cfg config.CommentConfig,
+) *ReplyNotifier {
+	return &ReplyNotifier{
+		subscriptionRepo: subscriptionRepo,
+		commentRepo:      commentRepo,
+		queue:            queue,
+		account:          account,
+		cfg:              cfg,
+	}
+}
+
+// Subscribe records an unconfirmed subscription to replies on an anonymous
+// comment and emails its author a confirmation link. The comment's Post is
+// used for the email when set.
+func (n *ReplyNotifier) Subscribe(ctx context.Context, comment *entity.Comment, locale string) error {
+	if comment.AuthorEmail == nil {
+		return nil
+	}
+
+	confirmToken, err := utils.GenerateSecureToken(subscriptionTokenBytes)
+	if err != nil {
+		return errors.ErrInternal.WithError(err)
+	}
+	unsubscribeToken, err := utils.GenerateSecureToken(subscriptionTokenBytes)
+	if err != nil {
+		return errors.ErrInternal.WithError(err)
+	}
+
+	now := time.Now()
+	confirmHash := utils.HashToken(confirmToken)
+	subscription := &entity.CommentSubscription{
+		CommentID:        comment.ID,
+		Email:            strings.ToLower(*comment.AuthorEmail),
+		Locale:           locale,
+		ConfirmTokenHash: &confirmHash,
+		ConfirmExpiresAt: now.Add(n.cfg.SubscriptionConfirmTTL),
+		UnsubscribeToken: unsubscribeToken,
+	}
+	if err := n.subscriptionRepo.Create(ctx, subscription); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+
+	msg := mail.CommentSubscriptionEmail(
+		locale,
+		subscription.Email,
+		derefString(comment.AuthorName),
+		postTitle(comment),
+		n.link("/comments/subscriptions/confirm", confirmToken),
+		n.cfg.SubscriptionConfirmTTL,
+	)
+	if _, err := n.queue.Enqueue(ctx, msg, now); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	return nil
+}
+
+// Notify emails the confirmed subscribers of the comment a reply answers.
+// Subscribers replying to their own comment are not emailed. The reply's
+// User is used when set.
+func (n *ReplyNotifier) Notify(ctx context.Context, reply *entity.Comment) error {
+	if reply.ParentID == nil {
+		return nil
+	}
+
+	subscriptions, err := n.subscriptionRepo.FindConfirmedByComment(ctx, *reply.ParentID)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	parent, err := n.commentRepo.FindByID(ctx, *reply.ParentID)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if parent == nil {
+		return nil
+	}
+
+	replier := "Someone"
+	if reply.User != nil {
+		replier = reply.User.Nickname
+	} else if reply.AuthorName != nil {
+		replier = *reply.AuthorName
+	}
+	link := strings.TrimRight(n.account.FrontendURL, "/") + fmt.Sprintf("/posts/%d#comment-%d", reply.PostID, reply.ID)
+
+	now := time.Now()
+	for _, subscription := range subscriptions {
+		if reply.AuthorEmail != nil && strings.EqualFold(*reply.AuthorEmail, subscription.Email) {
+			continue
+		}
+
+		msg := mail.CommentReplyEmail(
+			subscription.Locale,
+			subscription.Email,
+			derefString(parent.AuthorName),
+			replier,
+			postTitle(parent),
+			excerpt(reply.Content, replyExcerptLength),
+			link,
+			n.link("/comments/subscriptions/unsubscribe", subscription.UnsubscribeToken),
+		)
+		if _, err := n.queue.Enqueue(ctx, msg, now); err != nil {
+			return errors.ErrDatabaseError.WithError(err)
+		}
+	}
+	return nil
+}
+
+// link builds a frontend link carrying a subscription token
+func (n *ReplyNotifier) link(path, token string) string {
+	return strings.TrimRight(n.account.FrontendURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+// ConfirmSubscriptionUseCase handles confirming a reply notification subscription
+type ConfirmSubscriptionUseCase struct {
+	subscriptionRepo repository.CommentSubscriptionRepository
+}
+
+// NewConfirmSubscriptionUseCase creates a new ConfirmSubscriptionUseCase
+func NewConfirmSubscriptionUseCase(subscriptionRepo repository.CommentSubscriptionRepository) *ConfirmSubscriptionUseCase {
+	return &ConfirmSubscriptionUseCase{
+		subscriptionRepo: subscriptionRepo,
+	}
+}
+
+// Execute consumes a confirmation token and starts sending reply emails
+func (uc *ConfirmSubscriptionUseCase) Execute(ctx context.Context, rawToken string) (*entity.CommentSubscription, error) {
+	now := time.Now()
+
+	subscription, err := uc.subscriptionRepo.FindByConfirmTokenHash(ctx, utils.HashToken(rawToken))
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if subscription == nil || now.After(subscription.ConfirmExpiresAt) {
+		return nil, errors.ErrInvalidLinkToken
+	}
+
+	subscription.ConfirmedAt = &now
+	subscription.ConfirmTokenHash = nil
+	if err := uc.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	return subscription, nil
+}
+
+// UnsubscribeUseCase handles the unsubscribe link in reply emails
+type UnsubscribeUseCase struct {
+	subscriptionRepo repository.CommentSubscriptionRepository
+}
+
+// NewUnsubscribeUseCase creates a new UnsubscribeUseCase
+func NewUnsubscribeUseCase(subscriptionRepo repository.CommentSubscriptionRepository) *UnsubscribeUseCase {
+	return &UnsubscribeUseCase{
+		subscriptionRepo: subscriptionRepo,
+	}
+}
+
+// Execute deletes the subscription the token belongs to
+func (uc *UnsubscribeUseCase) Execute(ctx context.Context, token string) error {
+	subscription, err := uc.subscriptionRepo.FindByUnsubscribeToken(ctx, token)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if subscription == nil {
+		return errors.ErrInvalidLinkToken
+	}
+
+	if err := uc.subscriptionRepo.Delete(ctx, subscription.ID); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	return nil
+}
+
+// PruneSubscriptionsUseCase handles removing subscriptions never confirmed
+type PruneSubscriptionsUseCase struct {
+	subscriptionRepo repository.CommentSubscriptionRepository
+}
+
+// NewPruneSubscriptionsUseCase creates a new PruneSubscriptionsUseCase
+func NewPruneSubscriptionsUseCase(subscriptionRepo repository.CommentSubscriptionRepository) *PruneSubscriptionsUseCase {
+	return &PruneSubscriptionsUseCase{
+		subscriptionRepo: subscriptionRepo,
+	}
+}
+
+// Execute deletes subscriptions whose confirmation link expired and returns
+// how many were deleted
+func (uc *PruneSubscriptionsUseCase) Execute(ctx context.Context, now time.Time) (int64, error) {
+	deleted, err := uc.subscriptionRepo.DeleteUnconfirmedBefore(ctx, now)
+	if err != nil {
+		return 0, errors.ErrDatabaseError.WithError(err)
+	}
+	return deleted, nil
+}
+
+// postTitle returns the title of a comment's post, or "" when it is not loaded
+func postTitle(comment *entity.Comment) string {
+	if comment.Post == nil {
+		return ""
+	}
+	return comment.Post.Title
+}
+
+// excerpt shortens text to at most n characters, ending in an ellipsis when cut
+func excerpt(text string, n int) string {
+	runes := []rune(strings.TrimSpace(text))
+	if len(runes) <= n {
+		return string(runes)
+	}
+	return strings.TrimSpace(string(runes[:n])) + "…"
+}
@@ -0,0 +1,212 @@
+package comment
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/infrastructure/spam"
+	"github.com/yourusername/viblog/internal/usecase/ban"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/password"
+	"github.com/yourusername/viblog/pkg/utils"
+)
+
+// UpdateInput represents an edit of a comment's content
+type UpdateInput struct {
+	CommentID      uint
+	UserID         *uint  // nil for anonymous authors
+	AuthorPassword string // Anonymous comments only
+	Content        string
+	IP             string // Identifies anonymous editors; only its network is stored
+	UserAgent      string
+}
+
+// UpdateUseCase handles editing comments
+type UpdateUseCase struct {
+	commentRepo repository.CommentRepository
+	postRepo    repository.PostRepository
+	userRepo    repository.UserRepository
+	banGuard    *ban.Guard
+	spamChecker spam.Checker
+	mentions    *MentionNotifier
+	cfg         config.CommentConfig
+}
+
+// NewUpdateUseCase creates a new UpdateUseCase
+func NewUpdateUseCase(
+	commentRepo repository.CommentRepository,
+	postRepo repository.PostRepository,
+	userRepo repository.UserRepository,
+	banGuard *ban.Guard,
+	spamChecker spam.Checker,
+	mentions *MentionNotifier,
+	cfg config.CommentConfig,
+) *UpdateUseCase {
+	return &UpdateUseCase{
+		commentRepo: commentRepo,
+		postRepo:    postRepo,
+		userRepo:    userRepo,
+		banGuard:    banGuard,
+		spamChecker: spamChecker,
+		mentions:    mentions,
+		cfg:         cfg,
+	}
+}
+
+// Execute edits a comment. Signed-in authors edit their own comments,
+// anonymous comments are edited with the password they were posted with,
+// and moderators can edit any comment. Edits by anyone but a moderator go
+// through the spam check and hold rules again, like new comments: an edit
+// flagged as spam is filed as spam and one that is doubted or matches a
+// hold rule goes back to pending, taking the comment off its post's count.
+// Every edit is recorded as a revision, and users newly mentioned in a
+// visible comment are notified.
+func (uc *UpdateUseCase) Execute(ctx context.Context, input UpdateInput) (*entity.Comment, error) {
+	content := strings.TrimSpace(input.Content)
+	if content == "" {
+		return nil, errors.ErrValidation.WithDetails(map[string]interface{}{
+			"field": "content",
+		})
+	}
+
+	comments, err := uc.commentRepo.FindByIDs(ctx, []uint{input.CommentID})
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if len(comments) == 0 {
+		return nil, errors.ErrCommentNotFound
+	}
+	comment := &comments[0]
+
+	var editor *entity.User
+	if input.UserID != nil {
+		editor, err = uc.userRepo.FindByID(ctx, *input.UserID)
+		if err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+		if editor == nil {
+			return nil, errors.ErrUserNotFound
+		}
+	}
+
+	if !isModerator(editor) {
+		// Spam and rejected comments are hidden from their authors too
+		if comment.Status == entity.CommentStatusSpam || comment.Status == entity.CommentStatusRejected {
+			return nil, errors.ErrCommentNotFound
+		}
+		if err := authorizeEdit(comment, editor, input.AuthorPassword); err != nil {
+			return nil, err
+		}
+		if err := uc.banGuard.Check(ctx, ban.Subject{
+			IP:     input.IP,
+			Email:  derefString(comment.AuthorEmail),
+			UserID: comment.UserID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if content == comment.Content {
+		return comment, nil
+	}
+
+	mentioned := make(map[uint]bool, len(comment.Mentions))
+	for _, mention := range comment.Mentions {
+		mentioned[mention.UserID] = true
+	}
+
+	revision := &entity.CommentRevision{
+		PreviousContent: comment.Content,
+		Content:         content,
+	}
+	if editor != nil {
+		revision.EditorID = &editor.ID
+		revision.Editor = editor
+	} else {
+		revision.EditorIPHash = utils.HashIP(utils.NormalizeIP(input.IP))
+	}
+
+	comment.Content = content
+	comment.IsEdited = true
+	if err := renderContent(ctx, uc.userRepo, comment); err != nil {
+		return nil, err
+	}
+
+	wasApproved := comment.IsApproved()
+	if !isModerator(editor) {
+		uc.recheck(ctx, comment, editor, input)
+	}
+
+	if err := uc.commentRepo.UpdateContent(ctx, comment, revision); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if wasApproved && !comment.IsApproved() {
+		if err := uc.postRepo.DecrementCommentCount(ctx, comment.PostID); err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+	}
+
+	if comment.IsApproved() {
+		notify := *comment
+		notify.Mentions = nil
+		for _, mention := range comment.Mentions {
+			if !mentioned[mention.UserID] {
+				notify.Mentions = append(notify.Mentions, mention)
+			}
+		}
+		if err := uc.mentions.Notify(ctx, &notify); err != nil {
+			return nil, err
+		}
+	}
+
+	return comment, nil
+}
+
+// recheck runs the edited content through the spam check and hold rules.
+// Edits only ever hide a comment: a pending comment stays pending until a
+// moderator approves it, whatever the verdict.
+func (uc *UpdateUseCase) recheck(ctx context.Context, comment *entity.Comment, editor *entity.User, input UpdateInput) {
+	verdict := uc.spamChecker.Check(ctx, &spam.Submission{
+		PostID:      comment.PostID,
+		UserID:      comment.UserID,
+		AuthorName:  derefString(comment.AuthorName),
+		AuthorEmail: derefString(comment.AuthorEmail),
+		Content:     comment.Content,
+		IP:          input.IP,
+		UserAgent:   input.UserAgent,
+		Edit:        true,
+		SubmittedAt: time.Now(),
+	})
+	comment.SpamScore = verdict.Score
+	comment.SpamReasons = strings.Join(verdict.Reasons(), ",")
+
+	switch {
+	case verdict.Decision == spam.DecisionSpam:
+		comment.Status = entity.CommentStatusSpam
+	case holdReason(uc.cfg, editor, comment.Content, verdict) != "":
+		comment.Status = entity.CommentStatusPending
+	}
+}
+
+// authorizeEdit checks that the editor wrote the comment, or knows the
+// password of an anonymous comment
+func authorizeEdit(comment *entity.Comment, editor *entity.User, authorPassword string) error {
+	if comment.IsAnonymous() {
+		if comment.AuthorPassword == nil || authorPassword == "" {
+			return errors.ErrForbidden
+		}
+		if !password.Verify(authorPassword, *comment.AuthorPassword) {
+			return errors.ErrInvalidPassword
+		}
+		return nil
+	}
+
+	if editor == nil || *comment.UserID != editor.ID {
+		return errors.ErrForbidden
+	}
+	return nil
+}
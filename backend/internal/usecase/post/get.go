@@ -2,33 +2,38 @@ package post
 
 import (
 	"context"
-	"errors"
 
+	"github.com/yourusername/viblog/internal/config"
 	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
 )
 
 // GetUseCase handles getting a single post
 type GetUseCase struct {
-	postRepo repository.PostRepository
+	postRepo      repository.PostRepository
+	defaultLocale string
 }
 
 // NewGetUseCase creates a new GetUseCase
-func NewGetUseCase(postRepo repository.PostRepository) *GetUseCase {
+func NewGetUseCase(postRepo repository.PostRepository, i18n config.I18nConfig) *GetUseCase {
 	return &GetUseCase{
-		postRepo: postRepo,
+		postRepo:      postRepo,
+		defaultLocale: i18n.DefaultLocale,
 	}
 }
 
-// Execute gets a post by ID
-func (uc *GetUseCase) Execute(ctx context.Context, id uint) (*entity.Post, error) {
+// Execute gets a post by ID, localized to the requested locale
+func (uc *GetUseCase) Execute(ctx context.Context, id uint, locale string) (*entity.Post, error) {
 	post, err := uc.postRepo.GetByID(ctx, id)
 	if err != nil {
-		return nil, err
+		return nil, errors.ErrDatabaseError.WithError(err)
 	}
 	if post == nil {
-		return nil, errors.New("post not found")
+		return nil, errors.ErrPostNotFound
 	}
+
+	post.Localize(locale, uc.defaultLocale)
 	return post, nil
 }
 
@@ -3,24 +3,27 @@ package post
 import (
 	"context"
 
+	"github.com/yourusername/viblog/internal/config"
 	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/domain/repository"
 )
 
 // ListUseCase handles listing posts
 type ListUseCase struct {
-	postRepo repository.PostRepository
+	postRepo      repository.PostRepository
+	defaultLocale string
 }
 
 // NewListUseCase creates a new ListUseCase
-func NewListUseCase(postRepo repository.PostRepository) *ListUseCase {
+func NewListUseCase(postRepo repository.PostRepository, i18n config.I18nConfig) *ListUseCase {
 	return &ListUseCase{
-		postRepo: postRepo,
+		postRepo:      postRepo,
+		defaultLocale: i18n.DefaultLocale,
 	}
 }
 
-// Execute lists published posts with pagination
-func (uc *ListUseCase) Execute(ctx context.Context, page, limit int) ([]*entity.Post, int64, error) {
+// Execute lists published posts with pagination, localized to the requested locale
+func (uc *ListUseCase) Execute(ctx context.Context, page, limit int, locale string) ([]*entity.Post, int64, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -28,7 +31,16 @@ func (uc *ListUseCase) Execute(ctx context.Context, page, limit int) ([]*entity.
 		limit = 20
 	}
 
-	return uc.postRepo.ListPublished(ctx, page, limit)
+	posts, total, err := uc.postRepo.ListPublished(ctx, page, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, post := range posts {
+		post.Localize(locale, uc.defaultLocale)
+	}
+
+	return posts, total, nil
 }
 
 // GetLikedAndBookmarkedStatus gets like and bookmark status for posts
@@ -1 +1,47 @@
 package post
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// SearchUseCase handles searching published posts
+type SearchUseCase struct {
+	postRepo      repository.PostRepository
+	defaultLocale string
+}
+
+// NewSearchUseCase creates a new SearchUseCase
+func NewSearchUseCase(postRepo repository.PostRepository, i18n config.I18nConfig) *SearchUseCase {
+	return &SearchUseCase{
+		postRepo:      postRepo,
+		defaultLocale: i18n.DefaultLocale,
+	}
+}
+
+// Execute searches published posts with pagination, localized to the requested locale
+func (uc *SearchUseCase) Execute(ctx context.Context, query string, page, limit int, locale string) ([]*entity.Post, int64, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, 0, errors.ErrInvalidInput.WithDetails(map[string]interface{}{
+			"param": "q",
+		})
+	}
+	page, limit = normalizePage(page, limit)
+
+	posts, total, err := uc.postRepo.Search(ctx, query, page, limit)
+	if err != nil {
+		return nil, 0, errors.ErrDatabaseError.WithError(err)
+	}
+
+	for _, post := range posts {
+		post.Localize(locale, uc.defaultLocale)
+	}
+
+	return posts, total, nil
+}
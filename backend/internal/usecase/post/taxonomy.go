@@ -0,0 +1,140 @@
+package post
+
+import (
+	"context"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// ListCategoriesUseCase handles listing categories for readers
+type ListCategoriesUseCase struct {
+	categoryRepo repository.CategoryRepository
+}
+
+// NewListCategoriesUseCase creates a new ListCategoriesUseCase
+func NewListCategoriesUseCase(categoryRepo repository.CategoryRepository) *ListCategoriesUseCase {
+	return &ListCategoriesUseCase{
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Execute lists all categories, localized to the requested locale
+func (uc *ListCategoriesUseCase) Execute(ctx context.Context, locale string) ([]entity.Category, error) {
+	categories, err := uc.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	for i := range categories {
+		categories[i].Localize(locale)
+	}
+	return categories, nil
+}
+
+// ListTagsUseCase handles listing tags for readers
+type ListTagsUseCase struct {
+	tagRepo repository.TagRepository
+}
+
+// NewListTagsUseCase creates a new ListTagsUseCase
+func NewListTagsUseCase(tagRepo repository.TagRepository) *ListTagsUseCase {
+	return &ListTagsUseCase{
+		tagRepo: tagRepo,
+	}
+}
+
+// Execute lists all tags, localized to the requested locale
+func (uc *ListTagsUseCase) Execute(ctx context.Context, locale string) ([]entity.Tag, error) {
+	tags, err := uc.tagRepo.FindAll(ctx)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	for i := range tags {
+		tags[i].Localize(locale)
+	}
+	return tags, nil
+}
+
+// ListByTaxonomyUseCase handles listing the published posts of a category or tag
+type ListByTaxonomyUseCase struct {
+	postRepo      repository.PostRepository
+	categoryRepo  repository.CategoryRepository
+	tagRepo       repository.TagRepository
+	defaultLocale string
+}
+
+// NewListByTaxonomyUseCase creates a new ListByTaxonomyUseCase
+func NewListByTaxonomyUseCase(
+	postRepo repository.PostRepository,
+	categoryRepo repository.CategoryRepository,
+	tagRepo repository.TagRepository,
+	i18n config.I18nConfig,
+) *ListByTaxonomyUseCase {
+	return &ListByTaxonomyUseCase{
+		postRepo:      postRepo,
+		categoryRepo:  categoryRepo,
+		tagRepo:       tagRepo,
+		defaultLocale: i18n.DefaultLocale,
+	}
+}
+
+// ByCategory lists the published posts of a category with pagination,
+// localized to the requested locale
+func (uc *ListByTaxonomyUseCase) ByCategory(ctx context.Context, slug string, page, limit int, locale string) ([]*entity.Post, int64, error) {
+	category, err := uc.categoryRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		return nil, 0, errors.ErrDatabaseError.WithError(err)
+	}
+	if category == nil {
+		return nil, 0, errors.ErrCategoryNotFound
+	}
+
+	page, limit = normalizePage(page, limit)
+	posts, total, err := uc.postRepo.ListByCategory(ctx, slug, page, limit)
+	if err != nil {
+		return nil, 0, errors.ErrDatabaseError.WithError(err)
+	}
+	uc.localize(posts, locale)
+	return posts, total, nil
+}
+
+// ByTag lists the published posts of a tag with pagination, localized to
+// the requested locale
+func (uc *ListByTaxonomyUseCase) ByTag(ctx context.Context, slug string, page, limit int, locale string) ([]*entity.Post, int64, error) {
+	tag, err := uc.tagRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		return nil, 0, errors.ErrDatabaseError.WithError(err)
+	}
+	if tag == nil {
+		return nil, 0, errors.ErrTagNotFound
+	}
+
+	page, limit = normalizePage(page, limit)
+	posts, total, err := uc.postRepo.ListByTag(ctx, slug, page, limit)
+	if err != nil {
+		return nil, 0, errors.ErrDatabaseError.WithError(err)
+	}
+	uc.localize(posts, locale)
+	return posts, total, nil
+}
+
+func (uc *ListByTaxonomyUseCase) localize(posts []*entity.Post, locale string) {
+	for _, post := range posts {
+		post.Localize(locale, uc.defaultLocale)
+	}
+}
+
+// normalizePage replaces out-of-range pagination parameters with the defaults
+func normalizePage(page, limit int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	return page, limit
+}
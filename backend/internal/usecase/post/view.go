@@ -0,0 +1,93 @@
+package post
+
+import (
+	"context"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/infrastructure/geoip"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/utils"
+)
+
+// maxUTMLength matches the UTM columns of ViewLog
+const maxUTMLength = 100
+
+// RecordViewInput represents a post view reported by a reader's browser
+type RecordViewInput struct {
+	PostID    uint
+	IP        string
+	UserAgent string
+	Referrer  string // URL the reader came from
+	PageURL   string // URL of the post page, carrying any UTM parameters
+}
+
+// RecordViewUseCase counts post views, once per IP address a day, and logs
+// where each counted view came from
+type RecordViewUseCase struct {
+	postRepo repository.PostRepository
+	geo      geoip.Resolver
+	siteHost string
+}
+
+// NewRecordViewUseCase creates a new RecordViewUseCase. Referrers on the
+// frontend's own host are internal navigation and recorded as direct visits.
+func NewRecordViewUseCase(postRepo repository.PostRepository, geo geoip.Resolver, account config.AccountConfig) *RecordViewUseCase {
+	return &RecordViewUseCase{
+		postRepo: postRepo,
+		geo:      geo,
+		siteHost: utils.URLHost(account.FrontendURL),
+	}
+}
+
+// Execute records a view of a published post and reports whether it was
+// counted. Bots and repeat views within 24 hours are not counted.
+func (uc *RecordViewUseCase) Execute(ctx context.Context, input RecordViewInput) (bool, error) {
+	post, err := uc.postRepo.GetByID(ctx, input.PostID)
+	if err != nil {
+		return false, errors.ErrDatabaseError.WithError(err)
+	}
+	if post == nil || post.Status != "published" {
+		return false, errors.ErrPostNotFound
+	}
+
+	client := utils.ParseUserAgent(input.UserAgent)
+	if client.Device == utils.DeviceBot {
+		return false, nil
+	}
+
+	viewed, err := uc.postRepo.HasViewedRecently(ctx, post.ID, input.IP)
+	if err != nil {
+		return false, errors.ErrDatabaseError.WithError(err)
+	}
+	if viewed {
+		return false, nil
+	}
+
+	view := &entity.ViewLog{
+		PostID:    post.ID,
+		IPAddress: input.IP,
+		UserAgent: utils.Truncate(input.UserAgent, 500, ""),
+		Device:    client.Device,
+		Browser:   client.Browser,
+		OS:        client.OS,
+		Country:   uc.geo.Country(input.IP),
+	}
+	if host := utils.URLHost(input.Referrer); host != uc.siteHost {
+		view.ReferrerHost = utils.Truncate(host, 255, "")
+	}
+	// Campaign parameters only count on links to the site itself
+	if host := utils.URLHost(input.PageURL); host == uc.siteHost {
+		utm := utils.GetUTMParams(input.PageURL, maxUTMLength)
+		view.UTMSource, view.UTMMedium, view.UTMCampaign = utm.Source, utm.Medium, utm.Campaign
+	}
+
+	if err := uc.postRepo.RecordView(ctx, view); err != nil {
+		return false, errors.ErrDatabaseError.WithError(err)
+	}
+	if err := uc.postRepo.IncrementViewCount(ctx, post.ID); err != nil {
+		return false, errors.ErrDatabaseError.WithError(err)
+	}
+	return true, nil
+}
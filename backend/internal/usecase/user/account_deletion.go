@@ -0,0 +1,196 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/infrastructure/mail"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/password"
+	"go.uber.org/zap"
+)
+
+// purgeBatchSize is the number of accounts anonymized per query
+const purgeBatchSize = 100
+
+// AccountExport is everything the site stores about a user
+type AccountExport struct {
+	User          *entity.User
+	Comments      []entity.Comment
+	Likes         []entity.Like
+	Bookmarks     []entity.Bookmark
+	Notifications []entity.Notification
+}
+
+// ExportAccountDataUseCase handles exporting a user's data
+type ExportAccountDataUseCase struct {
+	userRepo     repository.UserRepository
+	userDataRepo repository.UserDataRepository
+}
+
+// NewExportAccountDataUseCase creates a new ExportAccountDataUseCase
+func NewExportAccountDataUseCase(
+	userRepo repository.UserRepository,
+	userDataRepo repository.UserDataRepository,
+) *ExportAccountDataUseCase {
+	return &ExportAccountDataUseCase{
+		userRepo:     userRepo,
+		userDataRepo: userDataRepo,
+	}
+}
+
+// Execute collects the user's profile and activity
+func (uc *ExportAccountDataUseCase) Execute(ctx context.Context, userID uint) (*AccountExport, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return nil, errors.ErrUserNotFound
+	}
+
+	export := &AccountExport{User: user}
+	if export.Comments, err = uc.userDataRepo.FindComments(ctx, userID); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if export.Likes, err = uc.userDataRepo.FindLikes(ctx, userID); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if export.Bookmarks, err = uc.userDataRepo.FindBookmarks(ctx, userID); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if export.Notifications, err = uc.userDataRepo.FindNotifications(ctx, userID); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return export, nil
+}
+
+// RequestAccountDeletionUseCase handles a user deleting their own account
+type RequestAccountDeletionUseCase struct {
+	factor  secondFactor
+	queue   *mail.Queue
+	account config.AccountConfig
+	logger  *zap.Logger
+}
+
+// NewRequestAccountDeletionUseCase creates a new RequestAccountDeletionUseCase
+func NewRequestAccountDeletionUseCase(
+	userRepo repository.UserRepository,
+	recoveryCodeRepo repository.RecoveryCodeRepository,
+	queue *mail.Queue,
+	account config.AccountConfig,
+	logger *zap.Logger,
+) *RequestAccountDeletionUseCase {
+	return &RequestAccountDeletionUseCase{
+		factor:  secondFactor{userRepo: userRepo, recoveryCodeRepo: recoveryCodeRepo},
+		queue:   queue,
+		account: account,
+		logger:  logger,
+	}
+}
+
+// RequestAccountDeletionInput represents the input for deleting one's account
+type RequestAccountDeletionInput struct {
+	UserID   uint
+	Password string
+	Code     string // TOTP or recovery code, required when MFA is enabled
+	Locale   string
+}
+
+// Execute confirms the user's password and schedules the account for
+// anonymization after the grace period. Every session is revoked; signing in
+// again before the deadline cancels the deletion.
+func (uc *RequestAccountDeletionUseCase) Execute(ctx context.Context, input RequestAccountDeletionInput) (*entity.User, error) {
+	user, err := uc.factor.findUser(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Admins must hand over their role first so the site is never left
+	// without one
+	if user.Role == entity.RoleAdmin {
+		return nil, errors.ErrCannotDeleteAdmin
+	}
+
+	if !password.Verify(input.Password, user.Password) {
+		return nil, errors.ErrInvalidPassword
+	}
+	if user.IsMFAEnabled() {
+		if err := uc.factor.verify(ctx, user, input.Code); err != nil {
+			return nil, err
+		}
+	}
+
+	scheduledAt := time.Now().Add(uc.account.DeletionGracePeriod)
+	user.DeletionScheduledAt = &scheduledAt
+	user.TokenVersion++
+	if err := uc.factor.userRepo.Update(ctx, user); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	link := strings.TrimRight(uc.account.FrontendURL, "/") + "/login"
+	msg := mail.AccountDeletionEmail(input.Locale, user.Email, user.Nickname, link, uc.account.DeletionGracePeriod)
+	// The deletion is scheduled either way; the email is a courtesy
+	if _, err := uc.queue.Enqueue(ctx, msg, time.Now()); err != nil {
+		uc.logger.Error("Failed to queue account deletion email", zap.Uint("user_id", user.ID), zap.Error(err))
+	}
+
+	return user, nil
+}
+
+// cancelAccountDeletion clears a pending deletion once the user signs in again
+func cancelAccountDeletion(ctx context.Context, userRepo repository.UserRepository, user *entity.User) error {
+	if !user.IsDeletionScheduled() {
+		return nil
+	}
+	user.DeletionScheduledAt = nil
+	if err := userRepo.Update(ctx, user); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	return nil
+}
+
+// PurgeDeletedAccountsUseCase anonymizes accounts whose grace period has passed
+type PurgeDeletedAccountsUseCase struct {
+	userRepo     repository.UserRepository
+	userDataRepo repository.UserDataRepository
+}
+
+// NewPurgeDeletedAccountsUseCase creates a new PurgeDeletedAccountsUseCase
+func NewPurgeDeletedAccountsUseCase(
+	userRepo repository.UserRepository,
+	userDataRepo repository.UserDataRepository,
+) *PurgeDeletedAccountsUseCase {
+	return &PurgeDeletedAccountsUseCase{
+		userRepo:     userRepo,
+		userDataRepo: userDataRepo,
+	}
+}
+
+// Execute anonymizes every account due for deletion at the given time and
+// returns how many were processed
+func (uc *PurgeDeletedAccountsUseCase) Execute(ctx context.Context, now time.Time) (int, error) {
+	purged := 0
+	for {
+		users, err := uc.userRepo.FindDueForDeletion(ctx, now, purgeBatchSize)
+		if err != nil {
+			return purged, errors.ErrDatabaseError.WithError(err)
+		}
+
+		for i := range users {
+			if err := uc.userDataRepo.Anonymize(ctx, &users[i]); err != nil {
+				return purged, errors.ErrDatabaseError.WithError(err)
+			}
+			purged++
+		}
+
+		if len(users) < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/infrastructure/mail"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// RequestEmailVerificationUseCase handles queueing an email verification link
+type RequestEmailVerificationUseCase struct {
+	userRepo  repository.UserRepository
+	tokenRepo repository.UserTokenRepository
+	queue     *mail.Queue
+	account   config.AccountConfig
+	logger    *zap.Logger
+}
+
+// NewRequestEmailVerificationUseCase creates a new RequestEmailVerificationUseCase
+func NewRequestEmailVerificationUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.UserTokenRepository,
+	queue *mail.Queue,
+	account config.AccountConfig,
+	logger *zap.Logger,
+) *RequestEmailVerificationUseCase {
+	return &RequestEmailVerificationUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+		queue:     queue,
+		account:   account,
+		logger:    logger,
+	}
+}
+
+// RequestEmailVerificationInput represents the input for requesting verification
+type RequestEmailVerificationInput struct {
+	Email  string
+	Locale string
+}
+
+// Execute queues a verification link when the email belongs to an unverified
+// account. Unknown and already verified addresses succeed silently so the
+// caller cannot learn which emails are registered.
+func (uc *RequestEmailVerificationUseCase) Execute(ctx context.Context, input RequestEmailVerificationInput) error {
+	user, err := uc.userRepo.FindByEmail(ctx, input.Email)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil || user.IsEmailVerified() {
+		return nil
+	}
+
+	sendTokenEmail(ctx, uc.tokenRepo, uc.queue, uc.logger, user, entity.UserTokenPurposeEmailVerification, uc.account.EmailVerificationTTL, func(token string) mail.Message {
+		link := buildTokenLink(uc.account.FrontendURL, "/verify-email", token)
+		return mail.VerificationEmail(input.Locale, user.Email, user.Nickname, link, uc.account.EmailVerificationTTL)
+	})
+	return nil
+}
+
+// ConfirmEmailVerificationUseCase handles confirming an email verification link
+type ConfirmEmailVerificationUseCase struct {
+	userRepo  repository.UserRepository
+	tokenRepo repository.UserTokenRepository
+}
+
+// NewConfirmEmailVerificationUseCase creates a new ConfirmEmailVerificationUseCase
+func NewConfirmEmailVerificationUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.UserTokenRepository,
+) *ConfirmEmailVerificationUseCase {
+	return &ConfirmEmailVerificationUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Execute consumes a verification token and marks the user's email verified
+func (uc *ConfirmEmailVerificationUseCase) Execute(ctx context.Context, rawToken string) (*entity.User, error) {
+	now := time.Now()
+
+	token, err := uc.tokenRepo.Consume(ctx, entity.UserTokenPurposeEmailVerification, utils.HashToken(rawToken), now)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if token == nil {
+		return nil, errors.ErrInvalidLinkToken
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, token.UserID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return nil, errors.ErrInvalidLinkToken
+	}
+
+	if !user.IsEmailVerified() {
+		user.EmailVerifiedAt = &now
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+	}
+
+	return user, nil
+}
@@ -30,11 +30,11 @@ func (uc *GetProfileUseCase) Execute(ctx context.Context, input GetProfileInput)
 	// Get user from repository
 	user, err := uc.userRepo.FindByID(ctx, input.UserID)
 	if err != nil {
-		return nil, errors.ErrNotFound
+		return nil, errors.ErrDatabaseError.WithError(err)
 	}
 
 	if user == nil {
-		return nil, errors.ErrNotFound
+		return nil, errors.ErrUserNotFound
 	}
 
 	return user, nil
@@ -2,9 +2,11 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/usecase/ban"
 	"github.com/yourusername/viblog/pkg/errors"
 	"github.com/yourusername/viblog/pkg/password"
 	"github.com/yourusername/viblog/pkg/validator"
@@ -13,12 +15,16 @@ import (
 // LoginUseCase handles user login
 type LoginUseCase struct {
 	userRepo repository.UserRepository
+	guard    *LoginGuard
+	banGuard *ban.Guard
 }
 
 // NewLoginUseCase creates a new LoginUseCase
-func NewLoginUseCase(userRepo repository.UserRepository) *LoginUseCase {
+func NewLoginUseCase(userRepo repository.UserRepository, guard *LoginGuard, banGuard *ban.Guard) *LoginUseCase {
 	return &LoginUseCase{
 		userRepo: userRepo,
+		guard:    guard,
+		banGuard: banGuard,
 	}
 }
 
@@ -26,35 +32,71 @@ func NewLoginUseCase(userRepo repository.UserRepository) *LoginUseCase {
 type LoginInput struct {
 	Email    string
 	Password string
+	IP       string
 }
 
-// Execute authenticates a user and returns the user entity
-func (uc *LoginUseCase) Execute(ctx context.Context, input LoginInput) (*entity.User, error) {
+// LoginOutput is the result of the password step of a login
+type LoginOutput struct {
+	User *entity.User
+	// MFARequired means the user must still present a second factor
+	// through VerifyMFALoginUseCase before the login is complete
+	MFARequired bool
+}
+
+// Execute authenticates a user by password
+func (uc *LoginUseCase) Execute(ctx context.Context, input LoginInput) (*LoginOutput, error) {
 	// Validate email format
 	if !validator.IsValidEmail(input.Email) {
 		return nil, errors.ErrInvalidEmail
 	}
 
+	// Reject locked accounts and IPs before looking at the credentials
+	if err := uc.guard.Check(ctx, input.Email, input.IP); err != nil {
+		return nil, err
+	}
+	if err := uc.banGuard.Check(ctx, ban.Subject{IP: input.IP}); err != nil {
+		return nil, err
+	}
+
 	// Find user by email
 	user, err := uc.userRepo.FindByEmail(ctx, input.Email)
 	if err != nil {
 		return nil, errors.ErrDatabaseError.WithError(err)
 	}
+
+	// Verify password. Unknown emails are checked against a dummy hash so
+	// both failures take the same time.
 	if user == nil {
-		return nil, errors.New(
-			errors.ErrCodeUnauthorized,
-			"Invalid email or password",
-			401,
-		)
+		verifyDummyPassword(input.Password)
+	}
+	if user == nil || !password.Verify(input.Password, user.Password) {
+		if err := uc.guard.RecordFailure(ctx, input.Email, input.IP); err != nil {
+			return nil, err
+		}
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	// The password was right, so telling the user why they cannot sign in
+	// reveals nothing
+	if err := checkAccountStanding(user, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.banGuard.Check(ctx, ban.Subject{Email: user.Email, UserID: &user.ID}); err != nil {
+		return nil, err
+	}
+
+	// Failures are only cleared once the second factor has been checked too
+	if user.IsMFAEnabled() {
+		return &LoginOutput{User: user, MFARequired: true}, nil
+	}
+
+	if err := uc.guard.RecordSuccess(ctx, input.Email); err != nil {
+		return nil, err
 	}
 
-	// Verify password
-	if !password.Verify(input.Password, user.Password) {
-		return nil, errors.New(
-			errors.ErrCodeUnauthorized,
-			"Invalid email or password",
-			401,
-		)
+	// Signing in during the grace period keeps the account
+	if err := cancelAccountDeletion(ctx, uc.userRepo, user); err != nil {
+		return nil, err
 	}
 
 	// Update last login timestamp
@@ -63,5 +105,5 @@ func (uc *LoginUseCase) Execute(ctx context.Context, input LoginInput) (*entity.
 		// In production, use proper logging
 	}
 
-	return user, nil
+	return &LoginOutput{User: user}, nil
 }
@@ -0,0 +1,146 @@
+package user
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/password"
+	"github.com/yourusername/viblog/pkg/utils"
+)
+
+// LoginGuard throttles failed logins per account and per client IP with
+// exponential backoff and temporary lockout
+type LoginGuard struct {
+	throttleRepo repository.LoginThrottleRepository
+	cfg          config.LoginThrottleConfig
+}
+
+// NewLoginGuard creates a new LoginGuard
+func NewLoginGuard(throttleRepo repository.LoginThrottleRepository, cfg config.LoginThrottleConfig) *LoginGuard {
+	// Hash the dummy password up front so the first unknown email is not slower
+	dummyPasswordHash()
+
+	return &LoginGuard{
+		throttleRepo: throttleRepo,
+		cfg:          cfg,
+	}
+}
+
+// Check returns ErrLoginThrottled while the account or the IP is locked.
+// Both are looked up on every call so the result takes the same time
+// whether or not the email is registered.
+func (g *LoginGuard) Check(ctx context.Context, email, ip string) error {
+	now := time.Now()
+	var retryAfter time.Duration
+
+	for _, key := range g.keys(email, ip) {
+		throttle, err := g.throttleRepo.Find(ctx, key.scope, key.identifier)
+		if err != nil {
+			return errors.ErrDatabaseError.WithError(err)
+		}
+		if throttle != nil && throttle.IsLocked(now) {
+			if wait := throttle.LockedUntil.Sub(now); wait > retryAfter {
+				retryAfter = wait
+			}
+		}
+	}
+
+	if retryAfter > 0 {
+		return errors.ErrLoginThrottled.WithDetails(map[string]interface{}{
+			"retry_after": int(math.Ceil(retryAfter.Seconds())),
+		})
+	}
+	return nil
+}
+
+// RecordFailure counts a failed attempt against the account and the IP and
+// locks whichever has exceeded its free attempts
+func (g *LoginGuard) RecordFailure(ctx context.Context, email, ip string) error {
+	now := time.Now()
+
+	for _, key := range g.keys(email, ip) {
+		throttle, err := g.throttleRepo.RecordFailure(ctx, key.scope, key.identifier, now, now.Add(-g.cfg.FailureWindow))
+		if err != nil {
+			return errors.ErrDatabaseError.WithError(err)
+		}
+
+		if delay := g.lockDuration(throttle.Failures, key.limits); delay > 0 {
+			if err := g.throttleRepo.Lock(ctx, throttle.ID, now.Add(delay)); err != nil {
+				return errors.ErrDatabaseError.WithError(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RecordSuccess clears the account's failures. IP failures are kept so that
+// logging into one account cannot reset an attack on others.
+func (g *LoginGuard) RecordSuccess(ctx context.Context, email string) error {
+	if err := g.throttleRepo.Reset(ctx, entity.LoginThrottleScopeAccount, normalizeLoginEmail(email)); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	return nil
+}
+
+type throttleKey struct {
+	scope      entity.LoginThrottleScope
+	identifier string
+	limits     config.LoginThrottleLimits
+}
+
+func (g *LoginGuard) keys(email, ip string) []throttleKey {
+	return []throttleKey{
+		{entity.LoginThrottleScopeAccount, normalizeLoginEmail(email), g.cfg.Account},
+		{entity.LoginThrottleScopeIP, utils.NormalizeIP(ip), g.cfg.IP},
+	}
+}
+
+// lockDuration returns how long to block logins after the given number of
+// consecutive failures
+func (g *LoginGuard) lockDuration(failures int, limits config.LoginThrottleLimits) time.Duration {
+	if failures >= limits.LockoutThreshold {
+		return g.cfg.LockoutDuration
+	}
+	if failures <= limits.FreeAttempts {
+		return 0
+	}
+
+	// BaseDelay doubles with every failure past the free attempts
+	exponent := failures - limits.FreeAttempts - 1
+	delay := time.Duration(float64(g.cfg.BaseDelay) * math.Pow(2, float64(exponent)))
+	if delay > g.cfg.MaxDelay || delay <= 0 {
+		delay = g.cfg.MaxDelay
+	}
+	return delay
+}
+
+func normalizeLoginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     string
+)
+
+func dummyPasswordHash() string {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = password.Hash("viblog-dummy-password")
+	})
+	return dummyHash
+}
+
+// verifyDummyPassword spends the same time as checking a real password hash.
+// It is used for unknown emails so response timing does not reveal whether
+// an account exists.
+func verifyDummyPassword(plain string) {
+	password.Verify(plain, dummyPasswordHash())
+}
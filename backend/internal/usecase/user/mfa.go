@@ -0,0 +1,404 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/totp"
+	"github.com/yourusername/viblog/pkg/utils"
+)
+
+const (
+	// recoveryCodeCount is the number of recovery codes issued at a time
+	recoveryCodeCount = 10
+	// totpSkew is the number of periods of clock drift tolerated either way
+	totpSkew = 1
+)
+
+// TOTPEnrollment is the secret a user adds to an authenticator app
+type TOTPEnrollment struct {
+	Secret string
+	URI    string
+}
+
+// MFAStatus describes a user's two-factor setup
+type MFAStatus struct {
+	Enabled                bool
+	EnabledAt              *time.Time
+	RecoveryCodesRemaining int64
+}
+
+// MFACodeInput carries a TOTP or recovery code for the current user
+type MFACodeInput struct {
+	UserID uint
+	Code   string
+}
+
+// secondFactor verifies TOTP and recovery codes for a user
+type secondFactor struct {
+	userRepo         repository.UserRepository
+	recoveryCodeRepo repository.RecoveryCodeRepository
+}
+
+// verify accepts a current TOTP code or an unused recovery code. Accepted TOTP
+// steps are recorded with a conditional update so the same code cannot be
+// replayed, even by concurrent requests.
+func (f secondFactor) verify(ctx context.Context, user *entity.User, code string) error {
+	if !user.IsMFAEnabled() || user.TOTPSecret == nil {
+		return errors.ErrMFANotEnabled
+	}
+
+	code = strings.TrimSpace(code)
+	now := time.Now()
+
+	if len(code) == totp.Digits {
+		step, ok := totp.Validate(*user.TOTPSecret, code, now, totpSkew)
+		if !ok || step <= user.TOTPLastStep {
+			return errors.ErrInvalidMFACode
+		}
+		advanced, err := f.userRepo.AdvanceTOTPStep(ctx, user.ID, step)
+		if err != nil {
+			return errors.ErrDatabaseError.WithError(err)
+		}
+		if !advanced {
+			return errors.ErrInvalidMFACode
+		}
+		user.TOTPLastStep = step
+		return nil
+	}
+
+	used, err := f.recoveryCodeRepo.Consume(ctx, user.ID, hashRecoveryCode(code), now)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if used == nil {
+		return errors.ErrInvalidMFACode
+	}
+	return nil
+}
+
+// issueRecoveryCodes replaces the user's recovery codes and returns the new
+// plaintext codes, which are shown once
+func (f secondFactor) issueRecoveryCodes(ctx context.Context, userID uint) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, errors.ErrInternal.WithError(err)
+		}
+		codes[i] = code
+		hashes[i] = hashRecoveryCode(code)
+	}
+
+	if err := f.recoveryCodeRepo.Replace(ctx, userID, hashes); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	return codes, nil
+}
+
+func (f secondFactor) findUser(ctx context.Context, userID uint) (*entity.User, error) {
+	user, err := f.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return nil, errors.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// recoveryCodeAlphabet is the lowercase base32 alphabet; 32 symbols keep the
+// mapping from random bytes unbiased
+const recoveryCodeAlphabet = "abcdefghijklmnopqrstuvwxyz234567"
+
+// generateRecoveryCode returns a code formatted as xxxxx-xxxxx
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for i, v := range b {
+		if i == 5 {
+			sb.WriteByte('-')
+		}
+		sb.WriteByte(recoveryCodeAlphabet[v&31])
+	}
+	return sb.String(), nil
+}
+
+// hashRecoveryCode hashes a recovery code ignoring case, spaces and dashes
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(code)
+	normalized = strings.NewReplacer("-", "", " ", "").Replace(normalized)
+	return utils.HashToken(normalized)
+}
+
+// GetMFAStatusUseCase handles reading a user's two-factor setup
+type GetMFAStatusUseCase struct {
+	factor secondFactor
+}
+
+// NewGetMFAStatusUseCase creates a new GetMFAStatusUseCase
+func NewGetMFAStatusUseCase(userRepo repository.UserRepository, recoveryCodeRepo repository.RecoveryCodeRepository) *GetMFAStatusUseCase {
+	return &GetMFAStatusUseCase{
+		factor: secondFactor{userRepo: userRepo, recoveryCodeRepo: recoveryCodeRepo},
+	}
+}
+
+// Execute returns the two-factor status of a user
+func (uc *GetMFAStatusUseCase) Execute(ctx context.Context, userID uint) (*MFAStatus, error) {
+	user, err := uc.factor.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &MFAStatus{
+		Enabled:   user.IsMFAEnabled(),
+		EnabledAt: user.TOTPEnabledAt,
+	}
+	if status.Enabled {
+		status.RecoveryCodesRemaining, err = uc.factor.recoveryCodeRepo.CountUnused(ctx, user.ID)
+		if err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+	}
+
+	return status, nil
+}
+
+// EnrollTOTPUseCase handles starting TOTP enrollment
+type EnrollTOTPUseCase struct {
+	userRepo repository.UserRepository
+	issuer   string
+}
+
+// NewEnrollTOTPUseCase creates a new EnrollTOTPUseCase
+func NewEnrollTOTPUseCase(userRepo repository.UserRepository, cfg config.MFAConfig) *EnrollTOTPUseCase {
+	return &EnrollTOTPUseCase{
+		userRepo: userRepo,
+		issuer:   cfg.Issuer,
+	}
+}
+
+// Execute stores a new unconfirmed TOTP secret for the user. Calling it again
+// before confirmation replaces the secret.
+func (uc *EnrollTOTPUseCase) Execute(ctx context.Context, userID uint) (*TOTPEnrollment, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return nil, errors.ErrUserNotFound
+	}
+	if user.IsMFAEnabled() {
+		return nil, errors.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errors.ErrInternal.WithError(err)
+	}
+
+	user.TOTPSecret = &secret
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return &TOTPEnrollment{
+		Secret: secret,
+		URI:    totp.URI(uc.issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTPUseCase handles finishing TOTP enrollment
+type ConfirmTOTPUseCase struct {
+	factor secondFactor
+}
+
+// NewConfirmTOTPUseCase creates a new ConfirmTOTPUseCase
+func NewConfirmTOTPUseCase(userRepo repository.UserRepository, recoveryCodeRepo repository.RecoveryCodeRepository) *ConfirmTOTPUseCase {
+	return &ConfirmTOTPUseCase{
+		factor: secondFactor{userRepo: userRepo, recoveryCodeRepo: recoveryCodeRepo},
+	}
+}
+
+// ConfirmTOTPOutput is the result of enabling two-factor authentication
+type ConfirmTOTPOutput struct {
+	User          *entity.User
+	RecoveryCodes []string
+}
+
+// Execute enables two-factor authentication once the user proves the
+// authenticator works. Existing sessions are revoked since they were not
+// established with a second factor.
+func (uc *ConfirmTOTPUseCase) Execute(ctx context.Context, input MFACodeInput) (*ConfirmTOTPOutput, error) {
+	user, err := uc.factor.findUser(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsMFAEnabled() {
+		return nil, errors.ErrMFAAlreadyEnabled
+	}
+	if user.TOTPSecret == nil {
+		return nil, errors.ErrMFANotEnabled
+	}
+
+	now := time.Now()
+	step, ok := totp.Validate(*user.TOTPSecret, strings.TrimSpace(input.Code), now, totpSkew)
+	if !ok {
+		return nil, errors.ErrInvalidMFACode
+	}
+
+	codes, err := uc.factor.issueRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.TOTPEnabledAt = &now
+	user.TOTPLastStep = step
+	user.TokenVersion++
+	if err := uc.factor.userRepo.Update(ctx, user); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return &ConfirmTOTPOutput{User: user, RecoveryCodes: codes}, nil
+}
+
+// DisableTOTPUseCase handles turning two-factor authentication off
+type DisableTOTPUseCase struct {
+	factor secondFactor
+}
+
+// NewDisableTOTPUseCase creates a new DisableTOTPUseCase
+func NewDisableTOTPUseCase(userRepo repository.UserRepository, recoveryCodeRepo repository.RecoveryCodeRepository) *DisableTOTPUseCase {
+	return &DisableTOTPUseCase{
+		factor: secondFactor{userRepo: userRepo, recoveryCodeRepo: recoveryCodeRepo},
+	}
+}
+
+// Execute removes the TOTP secret and recovery codes after checking a code
+func (uc *DisableTOTPUseCase) Execute(ctx context.Context, input MFACodeInput) error {
+	user, err := uc.factor.findUser(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+	if err := uc.factor.verify(ctx, user, input.Code); err != nil {
+		return err
+	}
+
+	if err := uc.factor.recoveryCodeRepo.DeleteByUser(ctx, user.ID); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+
+	user.TOTPSecret = nil
+	user.TOTPEnabledAt = nil
+	user.TOTPLastStep = 0
+	if err := uc.factor.userRepo.Update(ctx, user); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodesUseCase handles issuing a fresh set of recovery codes
+type RegenerateRecoveryCodesUseCase struct {
+	factor secondFactor
+}
+
+// NewRegenerateRecoveryCodesUseCase creates a new RegenerateRecoveryCodesUseCase
+func NewRegenerateRecoveryCodesUseCase(userRepo repository.UserRepository, recoveryCodeRepo repository.RecoveryCodeRepository) *RegenerateRecoveryCodesUseCase {
+	return &RegenerateRecoveryCodesUseCase{
+		factor: secondFactor{userRepo: userRepo, recoveryCodeRepo: recoveryCodeRepo},
+	}
+}
+
+// Execute replaces every recovery code after checking a code
+func (uc *RegenerateRecoveryCodesUseCase) Execute(ctx context.Context, input MFACodeInput) ([]string, error) {
+	user, err := uc.factor.findUser(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.factor.verify(ctx, user, input.Code); err != nil {
+		return nil, err
+	}
+
+	return uc.factor.issueRecoveryCodes(ctx, user.ID)
+}
+
+// VerifyMFALoginUseCase handles the second step of a login
+type VerifyMFALoginUseCase struct {
+	factor secondFactor
+	guard  *LoginGuard
+}
+
+// NewVerifyMFALoginUseCase creates a new VerifyMFALoginUseCase
+func NewVerifyMFALoginUseCase(
+	userRepo repository.UserRepository,
+	recoveryCodeRepo repository.RecoveryCodeRepository,
+	guard *LoginGuard,
+) *VerifyMFALoginUseCase {
+	return &VerifyMFALoginUseCase{
+		factor: secondFactor{userRepo: userRepo, recoveryCodeRepo: recoveryCodeRepo},
+		guard:  guard,
+	}
+}
+
+// VerifyMFALoginInput represents the second step of a login
+type VerifyMFALoginInput struct {
+	UserID       uint
+	TokenVersion uint
+	Code         string
+	IP           string
+}
+
+// Execute checks the second factor for a user identified by an MFA pending
+// token and completes the login
+func (uc *VerifyMFALoginUseCase) Execute(ctx context.Context, input VerifyMFALoginInput) (*entity.User, error) {
+	user, err := uc.factor.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil || user.TokenVersion != input.TokenVersion {
+		return nil, errors.ErrInvalidToken
+	}
+	if err := checkAccountStanding(user, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// Wrong codes count as failed logins of the account
+	if err := uc.guard.Check(ctx, user.Email, input.IP); err != nil {
+		return nil, err
+	}
+	if err := uc.factor.verify(ctx, user, input.Code); err != nil {
+		if errors.Is(err, errors.ErrInvalidMFACode) {
+			if recordErr := uc.guard.RecordFailure(ctx, user.Email, input.IP); recordErr != nil {
+				return nil, recordErr
+			}
+		}
+		return nil, err
+	}
+	if err := uc.guard.RecordSuccess(ctx, user.Email); err != nil {
+		return nil, err
+	}
+
+	// Signing in during the grace period keeps the account
+	if err := cancelAccountDeletion(ctx, uc.factor.userRepo, user); err != nil {
+		return nil, err
+	}
+
+	// Update last login timestamp
+	if err := uc.factor.userRepo.UpdateLastLoginAt(ctx, user.ID); err != nil {
+		// Log error but don't fail login
+	}
+
+	return user, nil
+}
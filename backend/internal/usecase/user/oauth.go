@@ -0,0 +1,314 @@
+package user
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/infrastructure/oauth"
+	"github.com/yourusername/viblog/internal/usecase/ban"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/password"
+	"github.com/yourusername/viblog/pkg/utils"
+	"github.com/yourusername/viblog/pkg/validator"
+	"golang.org/x/oauth2"
+)
+
+// oauthStateBytes is the amount of randomness in an OAuth state parameter
+const oauthStateBytes = 32
+
+// StartOAuthUseCase handles starting an OAuth authorization request
+type StartOAuthUseCase struct {
+	registry *oauth.Registry
+	states   oauth.StateStore
+	stateTTL time.Duration
+}
+
+// NewStartOAuthUseCase creates a new StartOAuthUseCase
+func NewStartOAuthUseCase(registry *oauth.Registry, states oauth.StateStore, cfg config.OAuthConfig) *StartOAuthUseCase {
+	return &StartOAuthUseCase{
+		registry: registry,
+		states:   states,
+		stateTTL: cfg.StateTTL,
+	}
+}
+
+// StartOAuthOutput represents a started authorization request
+type StartOAuthOutput struct {
+	AuthorizationURL string
+	State            string // Must also be kept by the browser, see OAuthLoginInput.BrowserState
+	ExpiresAt        time.Time
+}
+
+// Execute creates a state and PKCE verifier for the provider and returns the
+// URL the user should be sent to
+func (uc *StartOAuthUseCase) Execute(ctx context.Context, providerName string) (*StartOAuthOutput, error) {
+	provider, ok := uc.registry.Get(providerName)
+	if !ok {
+		return nil, errors.ErrProviderNotFound
+	}
+
+	state, err := utils.GenerateSecureToken(oauthStateBytes)
+	if err != nil {
+		return nil, errors.ErrInternal.WithError(err)
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	authURL, err := provider.AuthCodeURL(ctx, state, verifier)
+	if err != nil {
+		return nil, errors.ErrOAuthExchange.WithError(err)
+	}
+
+	expiresAt := time.Now().Add(uc.stateTTL)
+	uc.states.Save(state, oauth.PendingAuthorization{
+		Provider:  provider.Name(),
+		Verifier:  verifier,
+		ExpiresAt: expiresAt,
+	})
+
+	return &StartOAuthOutput{
+		AuthorizationURL: authURL,
+		State:            state,
+		ExpiresAt:        expiresAt,
+	}, nil
+}
+
+// OAuthLoginUseCase handles completing an OAuth authorization request
+type OAuthLoginUseCase struct {
+	registry     *oauth.Registry
+	states       oauth.StateStore
+	userRepo     repository.UserRepository
+	identityRepo repository.UserIdentityRepository
+	banGuard     *ban.Guard
+}
+
+// NewOAuthLoginUseCase creates a new OAuthLoginUseCase
+func NewOAuthLoginUseCase(
+	registry *oauth.Registry,
+	states oauth.StateStore,
+	userRepo repository.UserRepository,
+	identityRepo repository.UserIdentityRepository,
+	banGuard *ban.Guard,
+) *OAuthLoginUseCase {
+	return &OAuthLoginUseCase{
+		registry:     registry,
+		states:       states,
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		banGuard:     banGuard,
+	}
+}
+
+// OAuthLoginInput represents the parameters returned to the redirect URL
+type OAuthLoginInput struct {
+	Provider string
+	Code     string
+	State    string
+	IP       string
+
+	// BrowserState is the state the browser kept when the login started. It
+	// must match State, so a code and state obtained by someone else cannot
+	// sign the user into that person's account.
+	BrowserState string
+}
+
+// Execute exchanges the authorization code and returns the user for the
+// external identity. Unknown identities are linked to the account with the
+// same verified email, or a new account is created. Users with two-factor
+// authentication enabled must still present their second factor.
+func (uc *OAuthLoginUseCase) Execute(ctx context.Context, input OAuthLoginInput) (*LoginOutput, error) {
+	provider, ok := uc.registry.Get(input.Provider)
+	if !ok {
+		return nil, errors.ErrProviderNotFound
+	}
+
+	if input.BrowserState == "" || subtle.ConstantTimeCompare([]byte(input.State), []byte(input.BrowserState)) != 1 {
+		return nil, errors.ErrOAuthState
+	}
+
+	pending, ok := uc.states.Consume(input.State)
+	if !ok || pending.Provider != provider.Name() {
+		return nil, errors.ErrOAuthState
+	}
+
+	identity, err := provider.Exchange(ctx, input.Code, pending.Verifier)
+	if err != nil {
+		return nil, errors.ErrOAuthExchange.WithError(err)
+	}
+
+	// Banned networks and addresses must not be able to create accounts
+	if err := uc.banGuard.Check(ctx, ban.Subject{IP: input.IP, Email: identity.Email}); err != nil {
+		return nil, err
+	}
+
+	linked, err := uc.identityRepo.FindByProviderSubject(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	var user *entity.User
+	if linked != nil {
+		user, err = uc.userRepo.FindByID(ctx, linked.UserID)
+		if err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+		if user == nil {
+			return nil, errors.ErrUserNotFound
+		}
+	} else {
+		user, err = uc.link(ctx, identity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkAccountStanding(user, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.banGuard.Check(ctx, ban.Subject{Email: user.Email, UserID: &user.ID}); err != nil {
+		return nil, err
+	}
+
+	if user.IsMFAEnabled() {
+		return &LoginOutput{User: user, MFARequired: true}, nil
+	}
+
+	// Signing in during the grace period keeps the account
+	if err := cancelAccountDeletion(ctx, uc.userRepo, user); err != nil {
+		return nil, err
+	}
+
+	// Update last login timestamp
+	if err := uc.userRepo.UpdateLastLoginAt(ctx, user.ID); err != nil {
+		// Log error but don't fail login
+	}
+
+	return &LoginOutput{User: user}, nil
+}
+
+// link attaches a new identity to the account owning its email, creating
+// the account when there is none
+func (uc *OAuthLoginUseCase) link(ctx context.Context, identity *oauth.Identity) (*entity.User, error) {
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, errors.ErrOAuthEmail
+	}
+
+	user, err := uc.userRepo.FindByEmail(ctx, identity.Email)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	now := time.Now()
+	if user == nil {
+		user, err = uc.createUser(ctx, identity, now)
+		if err != nil {
+			return nil, err
+		}
+	} else if !user.IsEmailVerified() {
+		// Anyone could have registered an unverified account with this
+		// address, so their password and sessions must not survive the link
+		hashed, err := randomPasswordHash()
+		if err != nil {
+			return nil, errors.ErrInternal.WithError(err)
+		}
+		user.Password = hashed
+		user.EmailVerifiedAt = &now
+		user.TokenVersion++
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, errors.ErrDatabaseError.WithError(err)
+		}
+	}
+
+	if err := uc.identityRepo.Create(ctx, &entity.UserIdentity{
+		UserID:   user.ID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	}); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return user, nil
+}
+
+// createUser registers an account for a new external identity. The account
+// gets an unusable random password until the user resets it.
+func (uc *OAuthLoginUseCase) createUser(ctx context.Context, identity *oauth.Identity, now time.Time) (*entity.User, error) {
+	hashed, err := randomPasswordHash()
+	if err != nil {
+		return nil, errors.ErrInternal.WithError(err)
+	}
+
+	nickname, err := uc.availableNickname(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &entity.User{
+		Email:           identity.Email,
+		Password:        hashed,
+		Nickname:        nickname,
+		EmailVerifiedAt: &now,
+	}
+	if identity.AvatarURL != "" {
+		avatarURL := identity.AvatarURL
+		user.AvatarURL = &avatarURL
+	}
+
+	if err := uc.userRepo.Create(ctx, user); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return user, nil
+}
+
+var nicknameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// availableNickname derives a valid, unused nickname from the identity
+func (uc *OAuthLoginUseCase) availableNickname(ctx context.Context, identity *oauth.Identity) (string, error) {
+	base := ""
+	for _, candidate := range []string{identity.Login, identity.Name, strings.SplitN(identity.Email, "@", 2)[0]} {
+		base = nicknameInvalidChars.ReplaceAllString(candidate, "")
+		if len(base) >= 2 {
+			break
+		}
+	}
+	if len(base) < 2 {
+		base = "user"
+	}
+	if len(base) > 15 {
+		base = base[:15]
+	}
+
+	nickname := base
+	for attempt := 0; attempt < 5; attempt++ {
+		if validator.IsValidNickname(nickname) {
+			exists, err := uc.userRepo.ExistsByNickname(ctx, nickname)
+			if err != nil {
+				return "", errors.ErrDatabaseError.WithError(err)
+			}
+			if !exists {
+				return nickname, nil
+			}
+		}
+		nickname = fmt.Sprintf("%s-%04d", base, rand.Intn(10000))
+	}
+
+	return "", errors.ErrNicknameExists
+}
+
+// randomPasswordHash returns the hash of a random password nobody knows
+func randomPasswordHash() (string, error) {
+	raw, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return "", err
+	}
+	return password.Hash(raw)
+}
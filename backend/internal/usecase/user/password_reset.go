@@ -0,0 +1,215 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/config"
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/infrastructure/mail"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/password"
+	"github.com/yourusername/viblog/pkg/utils"
+	"github.com/yourusername/viblog/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// RequestPasswordResetUseCase handles queueing a password reset link
+type RequestPasswordResetUseCase struct {
+	userRepo  repository.UserRepository
+	tokenRepo repository.UserTokenRepository
+	queue     *mail.Queue
+	account   config.AccountConfig
+	logger    *zap.Logger
+}
+
+// NewRequestPasswordResetUseCase creates a new RequestPasswordResetUseCase
+func NewRequestPasswordResetUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.UserTokenRepository,
+	queue *mail.Queue,
+	account config.AccountConfig,
+	logger *zap.Logger,
+) *RequestPasswordResetUseCase {
+	return &RequestPasswordResetUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+		queue:     queue,
+		account:   account,
+		logger:    logger,
+	}
+}
+
+// RequestPasswordResetInput represents the input for requesting a password reset
+type RequestPasswordResetInput struct {
+	Email  string
+	Locale string
+}
+
+// Execute queues a reset link when the email belongs to an account.
+// Unknown addresses succeed silently so the caller cannot learn which emails
+// are registered.
+func (uc *RequestPasswordResetUseCase) Execute(ctx context.Context, input RequestPasswordResetInput) error {
+	user, err := uc.userRepo.FindByEmail(ctx, input.Email)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return nil
+	}
+
+	sendTokenEmail(ctx, uc.tokenRepo, uc.queue, uc.logger, user, entity.UserTokenPurposePasswordReset, uc.account.PasswordResetTTL, func(token string) mail.Message {
+		link := buildTokenLink(uc.account.FrontendURL, "/reset-password", token)
+		return mail.PasswordResetEmail(input.Locale, user.Email, user.Nickname, link, uc.account.PasswordResetTTL)
+	})
+	return nil
+}
+
+// ResetPasswordUseCase handles completing a password reset
+type ResetPasswordUseCase struct {
+	userRepo  repository.UserRepository
+	tokenRepo repository.UserTokenRepository
+	patRepo   repository.PersonalAccessTokenRepository
+}
+
+// NewResetPasswordUseCase creates a new ResetPasswordUseCase
+func NewResetPasswordUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.UserTokenRepository,
+	patRepo repository.PersonalAccessTokenRepository,
+) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+		patRepo:   patRepo,
+	}
+}
+
+// ResetPasswordInput represents the input for completing a password reset
+type ResetPasswordInput struct {
+	Token       string
+	NewPassword string
+}
+
+// Execute consumes a reset token, sets the new password and revokes every
+// existing session of the user by bumping their TokenVersion, along with
+// their personal access tokens
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, input ResetPasswordInput) error {
+	// Validate before consuming so a weak password does not burn the link
+	if !validator.IsValidPassword(input.NewPassword) {
+		return errors.ErrPasswordTooWeak
+	}
+
+	now := time.Now()
+
+	token, err := uc.tokenRepo.Consume(ctx, entity.UserTokenPurposePasswordReset, utils.HashToken(input.Token), now)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if token == nil {
+		return errors.ErrInvalidLinkToken
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, token.UserID)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return errors.ErrInvalidLinkToken
+	}
+
+	hashedPassword, err := password.Hash(input.NewPassword)
+	if err != nil {
+		return errors.ErrInternal.WithError(err)
+	}
+
+	user.Password = hashedPassword
+	user.TokenVersion++
+
+	// The reset link proved ownership of the address
+	if !user.IsEmailVerified() {
+		user.EmailVerifiedAt = &now
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+
+	// Any other outstanding reset links are no longer needed
+	if err := uc.tokenRepo.DeleteByUser(ctx, user.ID, entity.UserTokenPurposePasswordReset); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if err := uc.patRepo.DeleteByUserID(ctx, user.ID); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+
+	return nil
+}
+
+// ForcePasswordResetUseCase handles an admin requiring a user to choose a new password
+type ForcePasswordResetUseCase struct {
+	userRepo  repository.UserRepository
+	tokenRepo repository.UserTokenRepository
+	patRepo   repository.PersonalAccessTokenRepository
+	queue     *mail.Queue
+	account   config.AccountConfig
+	logger    *zap.Logger
+}
+
+// NewForcePasswordResetUseCase creates a new ForcePasswordResetUseCase
+func NewForcePasswordResetUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.UserTokenRepository,
+	patRepo repository.PersonalAccessTokenRepository,
+	queue *mail.Queue,
+	account config.AccountConfig,
+	logger *zap.Logger,
+) *ForcePasswordResetUseCase {
+	return &ForcePasswordResetUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+		patRepo:   patRepo,
+		queue:     queue,
+		account:   account,
+		logger:    logger,
+	}
+}
+
+// ForcePasswordResetInput represents the input for forcing a password reset
+type ForcePasswordResetInput struct {
+	UserID uint
+	Locale string
+}
+
+// Execute replaces the user's password with one nobody knows, revokes every
+// session and personal access token and queues a reset link, e.g. after a
+// suspected account takeover
+func (uc *ForcePasswordResetUseCase) Execute(ctx context.Context, input ForcePasswordResetInput) error {
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return errors.ErrUserNotFound
+	}
+
+	hash, err := randomPasswordHash()
+	if err != nil {
+		return errors.ErrInternal.WithError(err)
+	}
+	user.Password = hash
+	user.TokenVersion++
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if err := uc.patRepo.DeleteByUserID(ctx, user.ID); err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+
+	sendTokenEmail(ctx, uc.tokenRepo, uc.queue, uc.logger, user, entity.UserTokenPurposePasswordReset, uc.account.PasswordResetTTL, func(token string) mail.Message {
+		link := buildTokenLink(uc.account.FrontendURL, "/reset-password", token)
+		return mail.PasswordResetEmail(input.Locale, user.Email, user.Nickname, link, uc.account.PasswordResetTTL)
+	})
+	return nil
+}
@@ -0,0 +1,258 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+	"github.com/yourusername/viblog/pkg/utils"
+)
+
+const (
+	// patPrefixLength is the number of characters in a token's public prefix
+	patPrefixLength = 8
+	// patSecretBytes is the amount of randomness in a token's secret
+	patSecretBytes = 32
+	// maxPersonalAccessTokens caps how many tokens a user may hold
+	maxPersonalAccessTokens = 50
+	// patLastUsedInterval limits how often last-use tracking writes to the
+	// database for a busy token
+	patLastUsedInterval = time.Minute
+)
+
+// CreatePersonalAccessTokenInput represents input for creating a token
+type CreatePersonalAccessTokenInput struct {
+	UserID        uint
+	Name          string
+	Scopes        []string
+	ExpiresInDays *int // Nil never expires
+	MFA           bool // The creating session used a second factor
+}
+
+// CreatePersonalAccessTokenOutput carries the new token. Token is the full
+// credential and is only available here.
+type CreatePersonalAccessTokenOutput struct {
+	PersonalAccessToken *entity.PersonalAccessToken
+	Token               string
+}
+
+// CreatePersonalAccessTokenUseCase handles creating personal access tokens
+type CreatePersonalAccessTokenUseCase struct {
+	userRepo repository.UserRepository
+	patRepo  repository.PersonalAccessTokenRepository
+}
+
+// NewCreatePersonalAccessTokenUseCase creates a new CreatePersonalAccessTokenUseCase
+func NewCreatePersonalAccessTokenUseCase(
+	userRepo repository.UserRepository,
+	patRepo repository.PersonalAccessTokenRepository,
+) *CreatePersonalAccessTokenUseCase {
+	return &CreatePersonalAccessTokenUseCase{
+		userRepo: userRepo,
+		patRepo:  patRepo,
+	}
+}
+
+// Execute creates a token limited to scopes the user's role grants
+func (uc *CreatePersonalAccessTokenUseCase) Execute(ctx context.Context, input CreatePersonalAccessTokenInput) (*CreatePersonalAccessTokenOutput, error) {
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil {
+		return nil, errors.ErrUserNotFound
+	}
+
+	scopes := make([]entity.Permission, 0, len(input.Scopes))
+	seen := make(map[entity.Permission]bool, len(input.Scopes))
+	for _, s := range input.Scopes {
+		scope := entity.Permission(strings.TrimSpace(s))
+		if !scope.IsValid() || !user.Role.Has(scope) {
+			return nil, errors.ErrInvalidScope.WithDetails(map[string]interface{}{
+				"scope": s,
+			})
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+
+	count, err := uc.patRepo.CountByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if count >= maxPersonalAccessTokens {
+		return nil, errors.ErrInvalidOperation.WithDetails(map[string]interface{}{
+			"reason": "personal access token limit reached",
+			"limit":  maxPersonalAccessTokens,
+		})
+	}
+
+	prefix, err := generatePATPrefix()
+	if err != nil {
+		return nil, errors.ErrInternal.WithError(err)
+	}
+	secret, err := utils.GenerateSecureToken(patSecretBytes)
+	if err != nil {
+		return nil, errors.ErrInternal.WithError(err)
+	}
+
+	token := &entity.PersonalAccessToken{
+		UserID:         user.ID,
+		Name:           strings.TrimSpace(input.Name),
+		Prefix:         prefix,
+		SecretHash:     utils.HashToken(secret),
+		CreatedWithMFA: input.MFA,
+	}
+	token.SetScopes(scopes)
+	if input.ExpiresInDays != nil {
+		expiresAt := time.Now().AddDate(0, 0, *input.ExpiresInDays)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := uc.patRepo.Create(ctx, token); err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return &CreatePersonalAccessTokenOutput{
+		PersonalAccessToken: token,
+		Token:               entity.PersonalAccessTokenPrefix + prefix + "_" + secret,
+	}, nil
+}
+
+// ListPersonalAccessTokensUseCase handles listing a user's tokens
+type ListPersonalAccessTokensUseCase struct {
+	patRepo repository.PersonalAccessTokenRepository
+}
+
+// NewListPersonalAccessTokensUseCase creates a new ListPersonalAccessTokensUseCase
+func NewListPersonalAccessTokensUseCase(patRepo repository.PersonalAccessTokenRepository) *ListPersonalAccessTokensUseCase {
+	return &ListPersonalAccessTokensUseCase{
+		patRepo: patRepo,
+	}
+}
+
+// Execute lists the user's tokens, newest first
+func (uc *ListPersonalAccessTokensUseCase) Execute(ctx context.Context, userID uint) ([]entity.PersonalAccessToken, error) {
+	tokens, err := uc.patRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	return tokens, nil
+}
+
+// RevokePersonalAccessTokenUseCase handles revoking a token
+type RevokePersonalAccessTokenUseCase struct {
+	patRepo repository.PersonalAccessTokenRepository
+}
+
+// NewRevokePersonalAccessTokenUseCase creates a new RevokePersonalAccessTokenUseCase
+func NewRevokePersonalAccessTokenUseCase(patRepo repository.PersonalAccessTokenRepository) *RevokePersonalAccessTokenUseCase {
+	return &RevokePersonalAccessTokenUseCase{
+		patRepo: patRepo,
+	}
+}
+
+// Execute deletes one of the user's tokens
+func (uc *RevokePersonalAccessTokenUseCase) Execute(ctx context.Context, userID, tokenID uint) error {
+	deleted, err := uc.patRepo.Delete(ctx, tokenID, userID)
+	if err != nil {
+		return errors.ErrDatabaseError.WithError(err)
+	}
+	if !deleted {
+		return errors.ErrNotFound
+	}
+	return nil
+}
+
+// AuthenticatePersonalAccessTokenUseCase resolves a personal access token to its user
+type AuthenticatePersonalAccessTokenUseCase struct {
+	userRepo repository.UserRepository
+	patRepo  repository.PersonalAccessTokenRepository
+}
+
+// NewAuthenticatePersonalAccessTokenUseCase creates a new AuthenticatePersonalAccessTokenUseCase
+func NewAuthenticatePersonalAccessTokenUseCase(
+	userRepo repository.UserRepository,
+	patRepo repository.PersonalAccessTokenRepository,
+) *AuthenticatePersonalAccessTokenUseCase {
+	return &AuthenticatePersonalAccessTokenUseCase{
+		userRepo: userRepo,
+		patRepo:  patRepo,
+	}
+}
+
+// Execute verifies a raw token and records its use. Every failure returns
+// ErrInvalidToken so callers cannot probe which prefixes exist.
+func (uc *AuthenticatePersonalAccessTokenUseCase) Execute(ctx context.Context, raw, ip string) (*entity.User, *entity.PersonalAccessToken, error) {
+	prefix, secret, ok := parsePersonalAccessToken(raw)
+	if !ok {
+		return nil, nil, errors.ErrInvalidToken
+	}
+
+	token, err := uc.patRepo.FindByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if token == nil {
+		return nil, nil, errors.ErrInvalidToken
+	}
+
+	now := time.Now()
+	hash := utils.HashToken(secret)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(token.SecretHash)) != 1 || token.IsExpired(now) {
+		return nil, nil, errors.ErrInvalidToken
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, token.UserID)
+	if err != nil {
+		return nil, nil, errors.ErrDatabaseError.WithError(err)
+	}
+	// Tokens stop working while the account is scheduled for deletion
+	if user == nil || user.IsDeletionScheduled() {
+		return nil, nil, errors.ErrInvalidToken
+	}
+	if err := checkAccountStanding(user, now); err != nil {
+		return nil, nil, err
+	}
+
+	ip = utils.NormalizeIP(ip)
+	recent := token.LastUsedAt != nil && now.Sub(*token.LastUsedAt) < patLastUsedInterval
+	sameIP := token.LastUsedIP != nil && *token.LastUsedIP == ip
+	if !recent || !sameIP {
+		if err := uc.patRepo.UpdateLastUsed(ctx, token.ID, now, ip); err != nil {
+			return nil, nil, errors.ErrDatabaseError.WithError(err)
+		}
+		token.LastUsedAt = &now
+		token.LastUsedIP = &ip
+	}
+
+	return user, token, nil
+}
+
+// parsePersonalAccessToken splits "vbl_<prefix>_<secret>" into its parts
+func parsePersonalAccessToken(raw string) (prefix, secret string, ok bool) {
+	rest, found := strings.CutPrefix(raw, entity.PersonalAccessTokenPrefix)
+	if !found || len(rest) <= patPrefixLength+1 || rest[patPrefixLength] != '_' {
+		return "", "", false
+	}
+	return rest[:patPrefixLength], rest[patPrefixLength+1:], true
+}
+
+// generatePATPrefix returns a random prefix in the recovery code alphabet
+func generatePATPrefix() (string, error) {
+	b := make([]byte, patPrefixLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i, v := range b {
+		b[i] = recoveryCodeAlphabet[v&31]
+	}
+	return string(b), nil
+}
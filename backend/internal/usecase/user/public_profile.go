@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// PublicProfile is what anyone can see about a user
+type PublicProfile struct {
+	User *entity.User
+	// Comments and CommentCount are only filled when the user shows their activity
+	Comments     []entity.Comment
+	CommentCount int64
+	Page         int
+	Limit        int
+}
+
+// GetPublicProfileInput represents the input for viewing a public profile
+type GetPublicProfileInput struct {
+	Nickname string
+	Page     int
+	Limit    int
+}
+
+// GetPublicProfileUseCase handles viewing a user's public profile
+type GetPublicProfileUseCase struct {
+	userRepo    repository.UserRepository
+	commentRepo repository.CommentRepository
+}
+
+// NewGetPublicProfileUseCase creates a new GetPublicProfileUseCase
+func NewGetPublicProfileUseCase(
+	userRepo repository.UserRepository,
+	commentRepo repository.CommentRepository,
+) *GetPublicProfileUseCase {
+	return &GetPublicProfileUseCase{
+		userRepo:    userRepo,
+		commentRepo: commentRepo,
+	}
+}
+
+// Execute finds a user by nickname along with their recent comments on
+// published posts, unless they chose to hide their activity. Banned users
+// and accounts scheduled for deletion have no public profile.
+func (uc *GetPublicProfileUseCase) Execute(ctx context.Context, input GetPublicProfileInput) (*PublicProfile, error) {
+	user, err := uc.userRepo.FindByNickname(ctx, input.Nickname)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil || user.IsBanned() || user.IsDeletionScheduled() {
+		return nil, errors.ErrUserNotFound
+	}
+
+	if input.Page < 1 {
+		input.Page = 1
+	}
+	if input.Limit < 1 || input.Limit > 100 {
+		input.Limit = 20
+	}
+
+	profile := &PublicProfile{User: user, Page: input.Page, Limit: input.Limit}
+	if user.HideActivity {
+		return profile, nil
+	}
+
+	profile.Comments, profile.CommentCount, err = uc.commentRepo.FindPublicByUserID(ctx, user.ID, input.Page, input.Limit)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+
+	return profile, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/yourusername/viblog/internal/domain/entity"
 	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/usecase/ban"
 	"github.com/yourusername/viblog/pkg/errors"
 	"github.com/yourusername/viblog/pkg/password"
 	"github.com/yourusername/viblog/pkg/validator"
@@ -14,12 +15,14 @@ import (
 // RegisterUseCase handles user registration
 type RegisterUseCase struct {
 	userRepo repository.UserRepository
+	banGuard *ban.Guard
 }
 
 // NewRegisterUseCase creates a new RegisterUseCase
-func NewRegisterUseCase(userRepo repository.UserRepository) *RegisterUseCase {
+func NewRegisterUseCase(userRepo repository.UserRepository, banGuard *ban.Guard) *RegisterUseCase {
 	return &RegisterUseCase{
 		userRepo: userRepo,
+		banGuard: banGuard,
 	}
 }
 
@@ -28,6 +31,7 @@ type RegisterInput struct {
 	Email    string
 	Password string
 	Nickname string
+	IP       string
 }
 
 // Execute registers a new user
@@ -44,11 +48,12 @@ func (uc *RegisterUseCase) Execute(ctx context.Context, input RegisterInput) (*e
 
 	// Validate nickname format
 	if !validator.IsValidNickname(input.Nickname) {
-		return nil, errors.New(
-			errors.ErrCodeValidation,
-			"Nickname must be 2-20 characters and contain only alphanumeric, underscore, or hyphen",
-			400,
-		)
+		return nil, errors.ErrInvalidNickname
+	}
+
+	// Reject banned email addresses and networks
+	if err := uc.banGuard.Check(ctx, ban.Subject{IP: input.IP, Email: input.Email}); err != nil {
+		return nil, err
 	}
 
 	// Check if email already exists
@@ -57,11 +62,7 @@ func (uc *RegisterUseCase) Execute(ctx context.Context, input RegisterInput) (*e
 		return nil, errors.ErrDatabaseError.WithError(err)
 	}
 	if existingUser != nil {
-		return nil, errors.New(
-			errors.ErrCodeAlreadyExists,
-			"Email already exists",
-			409,
-		)
+		return nil, errors.ErrEmailExists
 	}
 
 	// Check if nickname already exists
@@ -70,11 +71,7 @@ func (uc *RegisterUseCase) Execute(ctx context.Context, input RegisterInput) (*e
 		return nil, errors.ErrDatabaseError.WithError(err)
 	}
 	if existingNickname != nil {
-		return nil, errors.New(
-			errors.ErrCodeAlreadyExists,
-			"Nickname already exists",
-			409,
-		)
+		return nil, errors.ErrNicknameExists
 	}
 
 	// Hash password
@@ -88,8 +85,8 @@ func (uc *RegisterUseCase) Execute(ctx context.Context, input RegisterInput) (*e
 		Email:    input.Email,
 		Password: hashedPassword,
 		Nickname: input.Nickname,
-		IsAdmin:  false, // Regular users are not admins by default
 	}
+	user.SetRole(entity.RoleMember) // Regular users are not admins by default
 
 	// Save user to database
 	if err := uc.userRepo.Create(ctx, user); err != nil {
@@ -0,0 +1,57 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/pkg/errors"
+)
+
+// ValidateSessionUseCase checks that a token still belongs to an active session
+type ValidateSessionUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewValidateSessionUseCase creates a new ValidateSessionUseCase
+func NewValidateSessionUseCase(userRepo repository.UserRepository) *ValidateSessionUseCase {
+	return &ValidateSessionUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute returns the token's user when its version matches the user's current
+// TokenVersion. Tokens issued before a password reset fail this check, and
+// suspended or banned users are turned away even with a valid token.
+func (uc *ValidateSessionUseCase) Execute(ctx context.Context, userID uint, tokenVersion uint) (*entity.User, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrDatabaseError.WithError(err)
+	}
+	if user == nil || user.TokenVersion != tokenVersion {
+		return nil, errors.ErrInvalidToken
+	}
+	if err := checkAccountStanding(user, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// checkAccountStanding rejects banned users and users under a suspension
+func checkAccountStanding(user *entity.User, now time.Time) error {
+	if user.IsBanned() {
+		return errors.ErrAccountBanned
+	}
+	if user.IsSuspended(now) {
+		details := map[string]interface{}{
+			"suspended_until": user.SuspendedUntil.UTC().Format(time.RFC3339),
+		}
+		if user.SuspensionReason != nil {
+			details["reason"] = *user.SuspensionReason
+		}
+		return errors.ErrAccountSuspended.WithDetails(details)
+	}
+	return nil
+}
@@ -27,6 +27,8 @@ type UpdateProfileInput struct {
 	Nickname  *string
 	AvatarURL *string
 	Bio       *string
+	// HideActivity changes whether comments show on the public profile
+	HideActivity *bool
 }
 
 // Execute updates a user's profile
@@ -37,18 +39,14 @@ func (uc *UpdateProfileUseCase) Execute(ctx context.Context, input UpdateProfile
 		return nil, errors.ErrDatabaseError.WithError(err)
 	}
 	if user == nil {
-		return nil, errors.ErrNotFound
+		return nil, errors.ErrUserNotFound
 	}
 
 	// Update nickname if provided
 	if input.Nickname != nil {
 		// Validate nickname format
 		if !validator.IsValidNickname(*input.Nickname) {
-			return nil, errors.New(
-				errors.ErrCodeValidation,
-				"Nickname must be 2-20 characters and contain only alphanumeric, underscore, or hyphen",
-				400,
-			)
+			return nil, errors.ErrInvalidNickname
 		}
 
 		// Check if nickname is different from current
@@ -59,11 +57,7 @@ func (uc *UpdateProfileUseCase) Execute(ctx context.Context, input UpdateProfile
 				return nil, errors.ErrDatabaseError.WithError(err)
 			}
 			if existingUser != nil && existingUser.ID != user.ID {
-				return nil, errors.New(
-					errors.ErrCodeAlreadyExists,
-					"Nickname already exists",
-					409,
-				)
+				return nil, errors.ErrNicknameExists
 			}
 
 			user.Nickname = *input.Nickname
@@ -84,6 +78,10 @@ func (uc *UpdateProfileUseCase) Execute(ctx context.Context, input UpdateProfile
 		user.Bio = input.Bio
 	}
 
+	if input.HideActivity != nil {
+		user.HideActivity = *input.HideActivity
+	}
+
 	// Save updated user
 	if err := uc.userRepo.Update(ctx, user); err != nil {
 		return nil, errors.ErrDatabaseError.WithError(err)
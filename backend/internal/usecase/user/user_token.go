@@ -0,0 +1,83 @@
+package user
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/yourusername/viblog/internal/domain/entity"
+	"github.com/yourusername/viblog/internal/domain/repository"
+	"github.com/yourusername/viblog/internal/infrastructure/mail"
+	"github.com/yourusername/viblog/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// userTokenBytes is the amount of randomness in an emailed token
+const userTokenBytes = 32
+
+// issueUserToken replaces any outstanding token of the same purpose and
+// returns the raw token to embed in a link. Only its hash is stored.
+func issueUserToken(
+	ctx context.Context,
+	tokenRepo repository.UserTokenRepository,
+	userID uint,
+	purpose entity.UserTokenPurpose,
+	ttl time.Duration,
+) (string, error) {
+	raw, err := utils.GenerateSecureToken(userTokenBytes)
+	if err != nil {
+		return "", err
+	}
+
+	if err := tokenRepo.DeleteByUser(ctx, userID, purpose); err != nil {
+		return "", err
+	}
+
+	token := &entity.UserToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: utils.HashToken(raw),
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	if err := tokenRepo.Create(ctx, token); err != nil {
+		return "", err
+	}
+
+	return raw, nil
+}
+
+// buildTokenLink appends the token as a query parameter to a frontend path
+func buildTokenLink(baseURL, path, token string) string {
+	return strings.TrimRight(baseURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+// sendTokenEmail issues a token of the given purpose and queues the email
+// carrying its link. Failures are logged rather than returned, so requests
+// for registered and unknown addresses get the same response, and the mail
+// server is never waited on.
+func sendTokenEmail(
+	ctx context.Context,
+	tokenRepo repository.UserTokenRepository,
+	queue *mail.Queue,
+	logger *zap.Logger,
+	user *entity.User,
+	purpose entity.UserTokenPurpose,
+	ttl time.Duration,
+	buildMessage func(token string) mail.Message,
+) {
+	token, err := issueUserToken(ctx, tokenRepo, user.ID, purpose, ttl)
+	if err != nil {
+		logger.Error("Failed to issue emailed token", zap.String("purpose", string(purpose)), zap.Uint("user_id", user.ID), zap.Error(err))
+		return
+	}
+
+	queued, err := queue.Enqueue(ctx, buildMessage(token), time.Now())
+	if err != nil {
+		logger.Error("Failed to queue token email", zap.String("purpose", string(purpose)), zap.Uint("user_id", user.ID), zap.Error(err))
+		return
+	}
+	if !queued {
+		logger.Warn("Dropped token email over the address limit", zap.String("purpose", string(purpose)), zap.Uint("user_id", user.ID))
+	}
+}
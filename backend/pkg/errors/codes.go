@@ -5,34 +5,69 @@ type ErrorCode string
 
 const (
 	// Authentication & Authorization
-	ErrCodeUnauthorized     ErrorCode = "UNAUTHORIZED"
-	ErrCodeForbidden        ErrorCode = "FORBIDDEN"
-	ErrCodeInvalidToken     ErrorCode = "INVALID_TOKEN"
-	ErrCodeExpiredToken     ErrorCode = "EXPIRED_TOKEN"
-	ErrCodeInvalidPassword  ErrorCode = "INVALID_PASSWORD"
+	ErrCodeUnauthorized       ErrorCode = "UNAUTHORIZED"
+	ErrCodeForbidden          ErrorCode = "FORBIDDEN"
+	ErrCodeInvalidToken       ErrorCode = "INVALID_TOKEN"
+	ErrCodeExpiredToken       ErrorCode = "EXPIRED_TOKEN"
+	ErrCodeInvalidLinkToken   ErrorCode = "INVALID_LINK_TOKEN"
+	ErrCodeInvalidPassword    ErrorCode = "INVALID_PASSWORD"
+	ErrCodeInvalidCredentials ErrorCode = "INVALID_CREDENTIALS"
+	ErrCodeAdminRequired      ErrorCode = "ADMIN_REQUIRED"
+	ErrCodeOAuthState         ErrorCode = "OAUTH_STATE_INVALID"
+	ErrCodeOAuthExchange      ErrorCode = "OAUTH_EXCHANGE_FAILED"
+	ErrCodeOAuthEmail         ErrorCode = "OAUTH_EMAIL_UNVERIFIED"
+	ErrCodeMFARequired        ErrorCode = "MFA_REQUIRED"
+	ErrCodeInvalidMFACode     ErrorCode = "INVALID_MFA_CODE"
+	ErrCodeMFAAlreadyEnabled  ErrorCode = "MFA_ALREADY_ENABLED"
+	ErrCodeMFANotEnabled      ErrorCode = "MFA_NOT_ENABLED"
+	ErrCodeAccountSuspended   ErrorCode = "ACCOUNT_SUSPENDED"
+	ErrCodeAccountBanned      ErrorCode = "ACCOUNT_BANNED"
+	ErrCodeBanned             ErrorCode = "BANNED"
 
 	// Validation
-	ErrCodeValidation       ErrorCode = "VALIDATION_ERROR"
-	ErrCodeInvalidEmail     ErrorCode = "INVALID_EMAIL"
-	ErrCodeInvalidURL       ErrorCode = "INVALID_URL"
-	ErrCodePasswordTooWeak  ErrorCode = "PASSWORD_TOO_WEAK"
-	ErrCodeInvalidInput     ErrorCode = "INVALID_INPUT"
+	ErrCodeValidation        ErrorCode = "VALIDATION_ERROR"
+	ErrCodeInvalidEmail      ErrorCode = "INVALID_EMAIL"
+	ErrCodeInvalidURL        ErrorCode = "INVALID_URL"
+	ErrCodePasswordTooWeak   ErrorCode = "PASSWORD_TOO_WEAK"
+	ErrCodeInvalidInput      ErrorCode = "INVALID_INPUT"
+	ErrCodeInvalidNickname   ErrorCode = "INVALID_NICKNAME"
+	ErrCodeUnsupportedLocale ErrorCode = "UNSUPPORTED_LOCALE"
+	ErrCodeInvalidRole       ErrorCode = "INVALID_ROLE"
+	ErrCodeInvalidScope      ErrorCode = "INVALID_SCOPE"
 
 	// Resource
 	ErrCodeNotFound         ErrorCode = "NOT_FOUND"
 	ErrCodeAlreadyExists    ErrorCode = "ALREADY_EXISTS"
 	ErrCodeConflict         ErrorCode = "CONFLICT"
+	ErrCodePostNotFound     ErrorCode = "POST_NOT_FOUND"
+	ErrCodeUserNotFound     ErrorCode = "USER_NOT_FOUND"
+	ErrCodeCommentNotFound  ErrorCode = "COMMENT_NOT_FOUND"
+	ErrCodeCategoryNotFound ErrorCode = "CATEGORY_NOT_FOUND"
+	ErrCodeTagNotFound      ErrorCode = "TAG_NOT_FOUND"
+	ErrCodeBanNotFound      ErrorCode = "BAN_NOT_FOUND"
+	ErrCodeReportNotFound   ErrorCode = "REPORT_NOT_FOUND"
+	ErrCodeProviderNotFound ErrorCode = "OAUTH_PROVIDER_NOT_FOUND"
+	ErrCodeEmailExists      ErrorCode = "EMAIL_ALREADY_EXISTS"
+	ErrCodeNicknameExists   ErrorCode = "NICKNAME_ALREADY_EXISTS"
+	ErrCodeCategoryExists   ErrorCode = "CATEGORY_ALREADY_EXISTS"
+	ErrCodeTagExists        ErrorCode = "TAG_ALREADY_EXISTS"
+	ErrCodeBanExists        ErrorCode = "BAN_ALREADY_EXISTS"
+	ErrCodeAlreadyReported  ErrorCode = "ALREADY_REPORTED"
 
 	// Rate Limiting
 	ErrCodeRateLimitExceeded ErrorCode = "RATE_LIMIT_EXCEEDED"
 	ErrCodeTooManyRequests   ErrorCode = "TOO_MANY_REQUESTS"
+	ErrCodeLoginThrottled    ErrorCode = "LOGIN_THROTTLED"
 
 	// Internal
-	ErrCodeInternal         ErrorCode = "INTERNAL_ERROR"
-	ErrCodeDatabaseError    ErrorCode = "DATABASE_ERROR"
-	ErrCodeCacheError       ErrorCode = "CACHE_ERROR"
+	ErrCodeInternal      ErrorCode = "INTERNAL_ERROR"
+	ErrCodeDatabaseError ErrorCode = "DATABASE_ERROR"
+	ErrCodeCacheError    ErrorCode = "CACHE_ERROR"
 
 	// Business Logic
-	ErrCodeInvalidOperation ErrorCode = "INVALID_OPERATION"
-	ErrCodeInsufficientPermission ErrorCode = "INSUFFICIENT_PERMISSION"
+	ErrCodeInvalidOperation         ErrorCode = "INVALID_OPERATION"
+	ErrCodeInsufficientPermission   ErrorCode = "INSUFFICIENT_PERMISSION"
+	ErrCodeCannotDeleteAdmin        ErrorCode = "CANNOT_DELETE_ADMIN"
+	ErrCodeDefaultLocaleTranslation ErrorCode = "DEFAULT_LOCALE_TRANSLATION"
+	ErrCodeLastAdmin                ErrorCode = "LAST_ADMIN"
 )
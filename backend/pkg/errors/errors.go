@@ -28,16 +28,28 @@ func (e *AppError) Unwrap() error {
 	return e.Err
 }
 
-// WithDetails adds details to the error
+// WithDetails returns a copy of the error with details attached.
+// The receiver is left untouched so predefined errors can be shared safely.
 func (e *AppError) WithDetails(details map[string]interface{}) *AppError {
-	e.Details = details
-	return e
+	clone := *e
+	clone.Details = details
+	return &clone
 }
 
-// WithError wraps an underlying error
+// WithError returns a copy of the error wrapping an underlying error
 func (e *AppError) WithError(err error) *AppError {
-	e.Err = err
-	return e
+	clone := *e
+	clone.Err = err
+	return &clone
+}
+
+// LocalizedMessage returns the catalog message for the error code in the given
+// locale, falling back to the error's own message
+func (e *AppError) LocalizedMessage(locale string) string {
+	if msg, ok := Message(e.Code, locale); ok {
+		return msg
+	}
+	return e.Message
 }
 
 // New creates a new AppError
@@ -52,27 +64,59 @@ func New(code ErrorCode, message string, statusCode int) *AppError {
 // Predefined errors
 var (
 	// Authentication & Authorization
-	ErrUnauthorized = New(ErrCodeUnauthorized, "Unauthorized", http.StatusUnauthorized)
-	ErrForbidden    = New(ErrCodeForbidden, "Forbidden", http.StatusForbidden)
-	ErrInvalidToken = New(ErrCodeInvalidToken, "Invalid token", http.StatusUnauthorized)
-	ErrExpiredToken = New(ErrCodeExpiredToken, "Token expired", http.StatusUnauthorized)
-	ErrInvalidPassword = New(ErrCodeInvalidPassword, "Invalid password", http.StatusUnauthorized)
+	ErrUnauthorized       = New(ErrCodeUnauthorized, "Unauthorized", http.StatusUnauthorized)
+	ErrForbidden          = New(ErrCodeForbidden, "Forbidden", http.StatusForbidden)
+	ErrInvalidToken       = New(ErrCodeInvalidToken, "Invalid token", http.StatusUnauthorized)
+	ErrExpiredToken       = New(ErrCodeExpiredToken, "Token expired", http.StatusUnauthorized)
+	ErrInvalidLinkToken   = New(ErrCodeInvalidLinkToken, "Link is invalid, expired or already used", http.StatusBadRequest)
+	ErrInvalidPassword    = New(ErrCodeInvalidPassword, "Invalid password", http.StatusUnauthorized)
+	ErrInvalidCredentials = New(ErrCodeInvalidCredentials, "Invalid email or password", http.StatusUnauthorized)
+	ErrAdminRequired      = New(ErrCodeAdminRequired, "Admin access required", http.StatusForbidden)
+	ErrOAuthState         = New(ErrCodeOAuthState, "OAuth state is invalid or expired", http.StatusBadRequest)
+	ErrOAuthExchange      = New(ErrCodeOAuthExchange, "OAuth provider exchange failed", http.StatusBadGateway)
+	ErrOAuthEmail         = New(ErrCodeOAuthEmail, "OAuth provider did not return a verified email", http.StatusBadRequest)
+	ErrMFARequired        = New(ErrCodeMFARequired, "Two-factor authentication required", http.StatusForbidden)
+	ErrInvalidMFACode     = New(ErrCodeInvalidMFACode, "Invalid two-factor code", http.StatusUnauthorized)
+	ErrMFAAlreadyEnabled  = New(ErrCodeMFAAlreadyEnabled, "Two-factor authentication already enabled", http.StatusConflict)
+	ErrMFANotEnabled      = New(ErrCodeMFANotEnabled, "Two-factor authentication not enabled", http.StatusBadRequest)
+	ErrAccountSuspended   = New(ErrCodeAccountSuspended, "Account suspended", http.StatusForbidden)
+	ErrAccountBanned      = New(ErrCodeAccountBanned, "Account banned", http.StatusForbidden)
+	ErrBanned             = New(ErrCodeBanned, "Banned", http.StatusForbidden)
 
 	// Validation
-	ErrValidation      = New(ErrCodeValidation, "Validation failed", http.StatusBadRequest)
-	ErrInvalidEmail    = New(ErrCodeInvalidEmail, "Invalid email format", http.StatusBadRequest)
-	ErrInvalidURL      = New(ErrCodeInvalidURL, "Invalid URL format", http.StatusBadRequest)
-	ErrPasswordTooWeak = New(ErrCodePasswordTooWeak, "Password does not meet requirements (min 8 chars, letters+numbers+special chars)", http.StatusBadRequest)
-	ErrInvalidInput    = New(ErrCodeInvalidInput, "Invalid input", http.StatusBadRequest)
+	ErrValidation        = New(ErrCodeValidation, "Validation failed", http.StatusBadRequest)
+	ErrInvalidEmail      = New(ErrCodeInvalidEmail, "Invalid email format", http.StatusBadRequest)
+	ErrInvalidURL        = New(ErrCodeInvalidURL, "Invalid URL format", http.StatusBadRequest)
+	ErrPasswordTooWeak   = New(ErrCodePasswordTooWeak, "Password does not meet requirements (min 8 chars, letters+numbers+special chars)", http.StatusBadRequest)
+	ErrInvalidInput      = New(ErrCodeInvalidInput, "Invalid input", http.StatusBadRequest)
+	ErrInvalidNickname   = New(ErrCodeInvalidNickname, "Nickname must be 2-20 characters and contain only alphanumeric, underscore, or hyphen", http.StatusBadRequest)
+	ErrUnsupportedLocale = New(ErrCodeUnsupportedLocale, "Unsupported locale", http.StatusBadRequest)
+	ErrInvalidRole       = New(ErrCodeInvalidRole, "Invalid role", http.StatusBadRequest)
+	ErrInvalidScope      = New(ErrCodeInvalidScope, "Invalid or unauthorized scope", http.StatusBadRequest)
 
 	// Resource
-	ErrNotFound      = New(ErrCodeNotFound, "Resource not found", http.StatusNotFound)
-	ErrAlreadyExists = New(ErrCodeAlreadyExists, "Resource already exists", http.StatusConflict)
-	ErrConflict      = New(ErrCodeConflict, "Resource conflict", http.StatusConflict)
+	ErrNotFound         = New(ErrCodeNotFound, "Resource not found", http.StatusNotFound)
+	ErrAlreadyExists    = New(ErrCodeAlreadyExists, "Resource already exists", http.StatusConflict)
+	ErrConflict         = New(ErrCodeConflict, "Resource conflict", http.StatusConflict)
+	ErrPostNotFound     = New(ErrCodePostNotFound, "Post not found", http.StatusNotFound)
+	ErrUserNotFound     = New(ErrCodeUserNotFound, "User not found", http.StatusNotFound)
+	ErrCommentNotFound  = New(ErrCodeCommentNotFound, "Comment not found", http.StatusNotFound)
+	ErrCategoryNotFound = New(ErrCodeCategoryNotFound, "Category not found", http.StatusNotFound)
+	ErrTagNotFound      = New(ErrCodeTagNotFound, "Tag not found", http.StatusNotFound)
+	ErrBanNotFound      = New(ErrCodeBanNotFound, "Ban not found", http.StatusNotFound)
+	ErrReportNotFound   = New(ErrCodeReportNotFound, "Report not found", http.StatusNotFound)
+	ErrProviderNotFound = New(ErrCodeProviderNotFound, "OAuth provider not found", http.StatusNotFound)
+	ErrEmailExists      = New(ErrCodeEmailExists, "Email already exists", http.StatusConflict)
+	ErrNicknameExists   = New(ErrCodeNicknameExists, "Nickname already exists", http.StatusConflict)
+	ErrCategoryExists   = New(ErrCodeCategoryExists, "Category already exists", http.StatusConflict)
+	ErrTagExists        = New(ErrCodeTagExists, "Tag already exists", http.StatusConflict)
+	ErrBanExists        = New(ErrCodeBanExists, "Ban already exists", http.StatusConflict)
+	ErrAlreadyReported  = New(ErrCodeAlreadyReported, "Comment already reported", http.StatusConflict)
 
 	// Rate Limiting
 	ErrRateLimitExceeded = New(ErrCodeRateLimitExceeded, "Rate limit exceeded", http.StatusTooManyRequests)
 	ErrTooManyRequests   = New(ErrCodeTooManyRequests, "Too many requests", http.StatusTooManyRequests)
+	ErrLoginThrottled    = New(ErrCodeLoginThrottled, "Too many failed login attempts", http.StatusTooManyRequests)
 
 	// Internal
 	ErrInternal      = New(ErrCodeInternal, "Internal server error", http.StatusInternalServerError)
@@ -80,8 +124,11 @@ var (
 	ErrCacheError    = New(ErrCodeCacheError, "Cache error", http.StatusInternalServerError)
 
 	// Business Logic
-	ErrInvalidOperation       = New(ErrCodeInvalidOperation, "Invalid operation", http.StatusBadRequest)
-	ErrInsufficientPermission = New(ErrCodeInsufficientPermission, "Insufficient permission", http.StatusForbidden)
+	ErrInvalidOperation         = New(ErrCodeInvalidOperation, "Invalid operation", http.StatusBadRequest)
+	ErrInsufficientPermission   = New(ErrCodeInsufficientPermission, "Insufficient permission", http.StatusForbidden)
+	ErrCannotDeleteAdmin        = New(ErrCodeCannotDeleteAdmin, "Cannot delete admin user", http.StatusForbidden)
+	ErrDefaultLocaleTranslation = New(ErrCodeDefaultLocaleTranslation, "Default locale content is edited on the post itself", http.StatusBadRequest)
+	ErrLastAdmin                = New(ErrCodeLastAdmin, "Cannot remove the last admin", http.StatusConflict)
 )
 
 // Is checks if the error is of a specific type
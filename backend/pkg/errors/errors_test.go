@@ -155,3 +155,38 @@ func TestPredefinedErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestAppError_WithDetailsDoesNotMutateShared(t *testing.T) {
+	_ = ErrValidation.WithDetails(map[string]interface{}{"field": "email"})
+	_ = ErrValidation.WithError(errors.New("test"))
+
+	if ErrValidation.Details != nil {
+		t.Error("expected predefined error details to stay nil")
+	}
+	if ErrValidation.Err != nil {
+		t.Error("expected predefined error to wrap nothing")
+	}
+}
+
+func TestAppError_LocalizedMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *AppError
+		locale   string
+		expected string
+	}{
+		{"english", ErrPostNotFound, "en", "The post was not found."},
+		{"korean", ErrPostNotFound, "ko", "게시글을 찾을 수 없습니다."},
+		{"region subtag", ErrPostNotFound, "ko-KR", "게시글을 찾을 수 없습니다."},
+		{"unknown locale falls back", ErrPostNotFound, "fr", "The post was not found."},
+		{"unknown code falls back to message", New("CUSTOM", "custom message", http.StatusTeapot), "ko", "custom message"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.LocalizedMessage(tt.locale); got != tt.expected {
+				t.Errorf("expected '%s', got '%s'", tt.expected, got)
+			}
+		})
+	}
+}
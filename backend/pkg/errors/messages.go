@@ -0,0 +1,174 @@
+package errors
+
+import "strings"
+
+// DefaultLocale is the catalog used when a locale has no translation
+const DefaultLocale = "en"
+
+// messages holds user-facing error messages keyed by locale and error code
+var messages = map[string]map[ErrorCode]string{
+	"en": {
+		// Authentication & Authorization
+		ErrCodeUnauthorized:       "Authentication is required.",
+		ErrCodeForbidden:          "You do not have access to this resource.",
+		ErrCodeInvalidToken:       "The token is invalid.",
+		ErrCodeExpiredToken:       "The token has expired.",
+		ErrCodeInvalidLinkToken:   "This link is invalid, has expired or was already used.",
+		ErrCodeInvalidPassword:    "The password is incorrect.",
+		ErrCodeInvalidCredentials: "Invalid email or password.",
+		ErrCodeAdminRequired:      "Administrator access is required.",
+		ErrCodeOAuthState:         "The sign-in request is invalid or has expired. Please start again.",
+		ErrCodeOAuthExchange:      "The sign-in provider could not complete the request.",
+		ErrCodeOAuthEmail:         "The sign-in provider did not confirm a verified email address.",
+		ErrCodeMFARequired:        "Two-factor authentication is required for this action. Enable it and sign in with your authenticator.",
+		ErrCodeInvalidMFACode:     "The two-factor code is incorrect.",
+		ErrCodeMFAAlreadyEnabled:  "Two-factor authentication is already enabled.",
+		ErrCodeMFANotEnabled:      "Two-factor authentication is not enabled.",
+		ErrCodeAccountSuspended:   "Your account is suspended.",
+		ErrCodeAccountBanned:      "Your account has been banned.",
+		ErrCodeBanned:             "You have been banned from this action.",
+
+		// Validation
+		ErrCodeValidation:        "The request failed validation.",
+		ErrCodeInvalidEmail:      "The email address is not valid.",
+		ErrCodeInvalidURL:        "The URL is not valid.",
+		ErrCodePasswordTooWeak:   "Password must be at least 8 characters and include letters, numbers and special characters.",
+		ErrCodeInvalidInput:      "The request contains invalid input.",
+		ErrCodeInvalidNickname:   "Nickname must be 2-20 characters and contain only letters, numbers, underscores or hyphens.",
+		ErrCodeUnsupportedLocale: "The locale is not supported.",
+		ErrCodeInvalidRole:       "The role is not valid.",
+		ErrCodeInvalidScope:      "The scope is not valid or is not granted by your role.",
+
+		// Resource
+		ErrCodeNotFound:         "The requested resource was not found.",
+		ErrCodeAlreadyExists:    "The resource already exists.",
+		ErrCodeConflict:         "The request conflicts with the current state of the resource.",
+		ErrCodePostNotFound:     "The post was not found.",
+		ErrCodeUserNotFound:     "The user was not found.",
+		ErrCodeCommentNotFound:  "The comment was not found.",
+		ErrCodeCategoryNotFound: "The category was not found.",
+		ErrCodeTagNotFound:      "The tag was not found.",
+		ErrCodeBanNotFound:      "The ban was not found.",
+		ErrCodeReportNotFound:   "The report was not found.",
+		ErrCodeProviderNotFound: "The sign-in provider is not available.",
+		ErrCodeEmailExists:      "This email address is already registered.",
+		ErrCodeNicknameExists:   "This nickname is already taken.",
+		ErrCodeCategoryExists:   "A category with this name or slug already exists.",
+		ErrCodeTagExists:        "A tag with this name or slug already exists.",
+		ErrCodeBanExists:        "An active ban for this value already exists.",
+		ErrCodeAlreadyReported:  "You have already reported this comment.",
+
+		// Rate Limiting
+		ErrCodeRateLimitExceeded: "Rate limit exceeded. Please try again later.",
+		ErrCodeTooManyRequests:   "Too many requests. Please try again later.",
+		ErrCodeLoginThrottled:    "Too many failed login attempts. Please try again later.",
+
+		// Internal
+		ErrCodeInternal:      "An internal server error occurred.",
+		ErrCodeDatabaseError: "A database error occurred.",
+		ErrCodeCacheError:    "A cache error occurred.",
+
+		// Business Logic
+		ErrCodeInvalidOperation:         "This operation is not allowed.",
+		ErrCodeInsufficientPermission:   "You do not have permission to perform this action.",
+		ErrCodeCannotDeleteAdmin:        "Administrator accounts cannot be deleted.",
+		ErrCodeDefaultLocaleTranslation: "Default locale content is edited on the original itself.",
+		ErrCodeLastAdmin:                "The last administrator cannot be demoted.",
+	},
+	"ko": {
+		// Authentication & Authorization
+		ErrCodeUnauthorized:       "인증이 필요합니다.",
+		ErrCodeForbidden:          "이 리소스에 접근할 수 없습니다.",
+		ErrCodeInvalidToken:       "유효하지 않은 토큰입니다.",
+		ErrCodeExpiredToken:       "토큰이 만료되었습니다.",
+		ErrCodeInvalidLinkToken:   "유효하지 않거나 만료되었거나 이미 사용된 링크입니다.",
+		ErrCodeInvalidPassword:    "비밀번호가 올바르지 않습니다.",
+		ErrCodeInvalidCredentials: "이메일 또는 비밀번호가 올바르지 않습니다.",
+		ErrCodeAdminRequired:      "관리자 권한이 필요합니다.",
+		ErrCodeOAuthState:         "로그인 요청이 유효하지 않거나 만료되었습니다. 다시 시도해 주세요.",
+		ErrCodeOAuthExchange:      "로그인 제공자와의 인증을 완료하지 못했습니다.",
+		ErrCodeOAuthEmail:         "로그인 제공자가 인증된 이메일 주소를 확인해 주지 않았습니다.",
+		ErrCodeMFARequired:        "이 작업에는 2단계 인증이 필요합니다. 2단계 인증을 설정하고 인증 앱으로 로그인해 주세요.",
+		ErrCodeInvalidMFACode:     "2단계 인증 코드가 올바르지 않습니다.",
+		ErrCodeMFAAlreadyEnabled:  "2단계 인증이 이미 설정되어 있습니다.",
+		ErrCodeMFANotEnabled:      "2단계 인증이 설정되어 있지 않습니다.",
+		ErrCodeAccountSuspended:   "계정이 정지되었습니다.",
+		ErrCodeAccountBanned:      "계정이 영구 정지되었습니다.",
+		ErrCodeBanned:             "차단되어 이 작업을 할 수 없습니다.",
+
+		// Validation
+		ErrCodeValidation:        "요청 값 검증에 실패했습니다.",
+		ErrCodeInvalidEmail:      "이메일 형식이 올바르지 않습니다.",
+		ErrCodeInvalidURL:        "URL 형식이 올바르지 않습니다.",
+		ErrCodePasswordTooWeak:   "비밀번호는 8자 이상이며 영문, 숫자, 특수문자를 포함해야 합니다.",
+		ErrCodeInvalidInput:      "잘못된 입력값입니다.",
+		ErrCodeInvalidNickname:   "닉네임은 2~20자의 영문, 숫자, 밑줄(_), 하이픈(-)만 사용할 수 있습니다.",
+		ErrCodeUnsupportedLocale: "지원하지 않는 언어입니다.",
+		ErrCodeInvalidRole:       "올바르지 않은 역할입니다.",
+		ErrCodeInvalidScope:      "올바르지 않거나 현재 역할에 허용되지 않은 권한 범위입니다.",
+
+		// Resource
+		ErrCodeNotFound:         "요청한 리소스를 찾을 수 없습니다.",
+		ErrCodeAlreadyExists:    "이미 존재하는 리소스입니다.",
+		ErrCodeConflict:         "리소스의 현재 상태와 충돌합니다.",
+		ErrCodePostNotFound:     "게시글을 찾을 수 없습니다.",
+		ErrCodeUserNotFound:     "사용자를 찾을 수 없습니다.",
+		ErrCodeCommentNotFound:  "댓글을 찾을 수 없습니다.",
+		ErrCodeCategoryNotFound: "카테고리를 찾을 수 없습니다.",
+		ErrCodeTagNotFound:      "태그를 찾을 수 없습니다.",
+		ErrCodeBanNotFound:      "차단 항목을 찾을 수 없습니다.",
+		ErrCodeReportNotFound:   "신고를 찾을 수 없습니다.",
+		ErrCodeProviderNotFound: "사용할 수 없는 로그인 제공자입니다.",
+		ErrCodeEmailExists:      "이미 가입된 이메일입니다.",
+		ErrCodeNicknameExists:   "이미 사용 중인 닉네임입니다.",
+		ErrCodeCategoryExists:   "같은 이름 또는 슬러그의 카테고리가 이미 있습니다.",
+		ErrCodeTagExists:        "같은 이름 또는 슬러그의 태그가 이미 있습니다.",
+		ErrCodeBanExists:        "같은 값에 대한 차단이 이미 있습니다.",
+		ErrCodeAlreadyReported:  "이미 신고한 댓글입니다.",
+
+		// Rate Limiting
+		ErrCodeRateLimitExceeded: "요청 한도를 초과했습니다. 잠시 후 다시 시도해 주세요.",
+		ErrCodeTooManyRequests:   "요청이 너무 많습니다. 잠시 후 다시 시도해 주세요.",
+		ErrCodeLoginThrottled:    "로그인 실패 횟수가 너무 많습니다. 잠시 후 다시 시도해 주세요.",
+
+		// Internal
+		ErrCodeInternal:      "서버 내부 오류가 발생했습니다.",
+		ErrCodeDatabaseError: "데이터베이스 오류가 발생했습니다.",
+		ErrCodeCacheError:    "캐시 오류가 발생했습니다.",
+
+		// Business Logic
+		ErrCodeInvalidOperation:         "허용되지 않는 작업입니다.",
+		ErrCodeInsufficientPermission:   "이 작업을 수행할 권한이 없습니다.",
+		ErrCodeCannotDeleteAdmin:        "관리자 계정은 삭제할 수 없습니다.",
+		ErrCodeDefaultLocaleTranslation: "기본 언어 콘텐츠는 원본에서 직접 수정해야 합니다.",
+		ErrCodeLastAdmin:                "마지막 관리자의 역할은 변경할 수 없습니다.",
+	},
+}
+
+// Message returns the catalog message for a code in the given locale.
+// Region subtags are ignored ("ko-KR" resolves to "ko") and unknown locales
+// fall back to DefaultLocale.
+func Message(code ErrorCode, locale string) (string, bool) {
+	locale = strings.ToLower(locale)
+	if i := strings.IndexAny(locale, "-_"); i > 0 {
+		locale = locale[:i]
+	}
+
+	if catalog, ok := messages[locale]; ok {
+		if msg, ok := catalog[code]; ok {
+			return msg, true
+		}
+	}
+
+	msg, ok := messages[DefaultLocale][code]
+	return msg, ok
+}
+
+// Locales returns the locales that have a message catalog
+func Locales() []string {
+	locales := make([]string, 0, len(messages))
+	for locale := range messages {
+		locales = append(locales, locale)
+	}
+	return locales
+}
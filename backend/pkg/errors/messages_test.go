@@ -0,0 +1,27 @@
+package errors
+
+import "testing"
+
+func TestMessages_CatalogsCoverSameCodes(t *testing.T) {
+	base := messages[DefaultLocale]
+
+	for _, locale := range Locales() {
+		catalog := messages[locale]
+		for code := range base {
+			if _, ok := catalog[code]; !ok {
+				t.Errorf("locale %s is missing a message for %s", locale, code)
+			}
+		}
+		for code := range catalog {
+			if _, ok := base[code]; !ok {
+				t.Errorf("locale %s has a message for %s that %s lacks", locale, code, DefaultLocale)
+			}
+		}
+	}
+}
+
+func TestMessage_UnknownCode(t *testing.T) {
+	if _, ok := Message("DOES_NOT_EXIST", "en"); ok {
+		t.Error("expected unknown code to be reported as missing")
+	}
+}
@@ -0,0 +1,305 @@
+// Package markdown renders the Markdown subset allowed in comments.
+//
+// Everything the author writes is HTML-escaped; the only tags in the output
+// are the ones this package emits: p, br, pre, code, strong, em and a. Links
+// must be http, https or mailto and carry rel="nofollow ugc".
+package markdown
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// MentionLink returns the URL a mention of the nickname links to, or "" when
+// the nickname should stay plain text
+type MentionLink func(nickname string) string
+
+// nicknamePattern matches the characters allowed in nicknames (see validator.IsValidNickname)
+var nicknamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{2,20}`)
+
+// languagePattern matches the info string of a fenced code block
+var languagePattern = regexp.MustCompile(`^[a-zA-Z0-9_+-]{1,20}$`)
+
+// Render converts comment Markdown to sanitized HTML. Fenced code blocks,
+// paragraphs, line breaks, inline code, emphasis, links and bare URLs are
+// supported. Mentions are linked when mentionLink returns a URL for them;
+// mentionLink may be nil.
+func Render(src string, mentionLink MentionLink) string {
+	r := &renderer{mentionLink: mentionLink}
+	r.blocks(src)
+	return strings.TrimSuffix(r.out.String(), "\n")
+}
+
+// Mentions returns the nicknames mentioned with @nickname, in order of first
+// appearance. Mentions inside code are ignored.
+func Mentions(src string) []string {
+	var nicknames []string
+	seen := map[string]bool{}
+	Render(src, func(nickname string) string {
+		if !seen[nickname] {
+			seen[nickname] = true
+			nicknames = append(nicknames, nickname)
+		}
+		return ""
+	})
+	return nicknames
+}
+
+// renderer accumulates the HTML output
+type renderer struct {
+	out         strings.Builder
+	mentionLink MentionLink
+}
+
+// blocks splits the source into fenced code blocks and paragraphs
+func (r *renderer) blocks(src string) {
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+
+	var paragraph []string
+	flush := func() {
+		if len(paragraph) > 0 {
+			r.out.WriteString("<p>")
+			r.inline(strings.Join(paragraph, "\n"), true)
+			r.out.WriteString("</p>\n")
+			paragraph = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		if info, ok := fence(line); ok {
+			flush()
+			var code []string
+			for i++; i < len(lines); i++ {
+				if _, closing := fence(lines[i]); closing {
+					break
+				}
+				code = append(code, lines[i])
+			}
+			r.codeBlock(info, strings.Join(code, "\n"))
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+		paragraph = append(paragraph, line)
+	}
+	flush()
+}
+
+// fence reports whether the line opens or closes a fenced code block and
+// returns its info string
+func fence(line string) (string, bool) {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 || !strings.HasPrefix(trimmed, "```") {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimLeft(trimmed, "`")), true
+}
+
+// codeBlock writes a fenced code block
+func (r *renderer) codeBlock(info, code string) {
+	r.out.WriteString("<pre><code")
+	if languagePattern.MatchString(info) {
+		r.out.WriteString(` class="language-` + info + `"`)
+	}
+	r.out.WriteString(">")
+	r.out.WriteString(html.EscapeString(code))
+	r.out.WriteString("</code></pre>\n")
+}
+
+// inline writes text with inline formatting. Links are not nested inside
+// link text.
+func (r *renderer) inline(s string, allowLinks bool) {
+	var text strings.Builder
+	flush := func() {
+		r.out.WriteString(html.EscapeString(text.String()))
+		text.Reset()
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && isPunct(s[i+1]):
+			text.WriteByte(s[i+1])
+			i += 2
+			continue
+
+		case c == '\n':
+			flush()
+			r.out.WriteString("<br>\n")
+			i++
+			continue
+
+		case c == '`':
+			if end := strings.IndexByte(s[i+1:], '`'); end > 0 {
+				flush()
+				r.out.WriteString("<code>")
+				r.out.WriteString(html.EscapeString(s[i+1 : i+1+end]))
+				r.out.WriteString("</code>")
+				i += end + 2
+				continue
+			}
+
+		case (c == '*' || c == '_') && strings.HasPrefix(s[i:], strings.Repeat(string(c), 2)):
+			delim := s[i : i+2]
+			if inner, n, ok := delimited(s, i, delim); ok {
+				flush()
+				r.out.WriteString("<strong>")
+				r.inline(inner, allowLinks)
+				r.out.WriteString("</strong>")
+				i += n
+				continue
+			}
+
+		case c == '*' || (c == '_' && !isWordByte(prev(s, i))):
+			if inner, n, ok := delimited(s, i, string(c)); ok && (c == '*' || !isWordByte(next(s, i+n-1))) {
+				flush()
+				r.out.WriteString("<em>")
+				r.inline(inner, allowLinks)
+				r.out.WriteString("</em>")
+				i += n
+				continue
+			}
+
+		case c == '[' && allowLinks:
+			if label, href, n, ok := link(s[i:]); ok {
+				flush()
+				r.anchor(href, func() { r.inline(label, false) })
+				i += n
+				continue
+			}
+
+		case c == 'h' && allowLinks && !isWordByte(prev(s, i)):
+			if href := bareURL(s[i:]); href != "" {
+				flush()
+				r.anchor(href, func() { r.out.WriteString(html.EscapeString(href)) })
+				i += len(href)
+				continue
+			}
+
+		case c == '@' && allowLinks && r.mentionLink != nil && !isWordByte(prev(s, i)):
+			if nickname := nicknamePattern.FindString(s[i+1:]); nickname != "" {
+				if href := r.mentionLink(nickname); href != "" {
+					flush()
+					r.out.WriteString(`<a href="` + html.EscapeString(href) + `" class="mention">@`)
+					r.out.WriteString(html.EscapeString(nickname))
+					r.out.WriteString("</a>")
+				} else {
+					text.WriteString("@" + nickname)
+				}
+				i += len(nickname) + 1
+				continue
+			}
+		}
+
+		text.WriteByte(c)
+		i++
+	}
+	flush()
+}
+
+// anchor writes a link to an external page
+func (r *renderer) anchor(href string, label func()) {
+	r.out.WriteString(`<a href="` + html.EscapeString(href) + `" rel="nofollow ugc">`)
+	label()
+	r.out.WriteString("</a>")
+}
+
+// delimited finds the text between delim at s[i:] and the next delim. It
+// returns the inner text and the length consumed including both delimiters.
+func delimited(s string, i int, delim string) (string, int, bool) {
+	start := i + len(delim)
+	end := strings.Index(s[start:], delim)
+	if end <= 0 {
+		return "", 0, false
+	}
+	inner := s[start : start+end]
+	if strings.TrimSpace(inner) != inner || strings.Contains(inner, "\n\n") {
+		return "", 0, false
+	}
+	return inner, len(delim)*2 + end, true
+}
+
+// link parses [label](url) at the start of s
+func link(s string) (string, string, int, bool) {
+	closeLabel := strings.Index(s, "](")
+	if closeLabel <= 1 || strings.Contains(s[:closeLabel], "\n") {
+		return "", "", 0, false
+	}
+	closeURL := strings.IndexByte(s[closeLabel+2:], ')')
+	if closeURL <= 0 {
+		return "", "", 0, false
+	}
+	href := strings.TrimSpace(s[closeLabel+2 : closeLabel+2+closeURL])
+	if !safeURL(href) {
+		return "", "", 0, false
+	}
+	return s[1:closeLabel], href, closeLabel + 3 + closeURL, true
+}
+
+// bareURL returns the http(s) URL at the start of s without trailing
+// punctuation, or ""
+func bareURL(s string) string {
+	if !strings.HasPrefix(s, "http://") && !strings.HasPrefix(s, "https://") {
+		return ""
+	}
+	end := strings.IndexAny(s, " \t\n<>\"")
+	if end < 0 {
+		end = len(s)
+	}
+	href := strings.TrimRight(s[:end], ".,;:!?)'*_")
+	if !safeURL(href) {
+		return ""
+	}
+	return href
+}
+
+// safeURL reports whether a link may point to the URL
+func safeURL(href string) bool {
+	if strings.ContainsAny(href, " \t\n") {
+		return false
+	}
+	u, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		return u.Host != ""
+	case "mailto":
+		return u.Opaque != ""
+	}
+	return false
+}
+
+// prev returns the byte before s[i], or 0 at the start
+func prev(s string, i int) byte {
+	if i == 0 {
+		return 0
+	}
+	return s[i-1]
+}
+
+// next returns the byte after s[i], or 0 at the end
+func next(s string, i int) byte {
+	if i+1 >= len(s) {
+		return 0
+	}
+	return s[i+1]
+}
+
+// isWordByte reports whether b is part of a word, so _ and @ inside words
+// such as snake_case or user@example.com are not treated as markup
+func isWordByte(b byte) bool {
+	return b == '_' || b >= 0x80 ||
+		(b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
+
+// isPunct reports whether b may be backslash-escaped
+func isPunct(b byte) bool {
+	return strings.IndexByte("\\`*_[]()@#>!-", b) >= 0
+}
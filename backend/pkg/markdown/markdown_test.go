@@ -0,0 +1,83 @@
+package markdown
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"plain text", "Hello world", "<p>Hello world</p>"},
+		{"paragraphs and line breaks", "one\ntwo\n\nthree", "<p>one<br>\ntwo</p>\n<p>three</p>"},
+		{"emphasis", "*a* _b_ **c** __d__", "<p><em>a</em> <em>b</em> <strong>c</strong> <strong>d</strong></p>"},
+		{"nested emphasis", "**bold *and* more**", "<p><strong>bold <em>and</em> more</strong></p>"},
+		{"snake_case is not emphasis", "use snake_case_names", "<p>use snake_case_names</p>"},
+		{"unclosed emphasis", "2 * 3 = 6", "<p>2 * 3 = 6</p>"},
+		{"inline code", "run `go <test>` now", "<p>run <code>go &lt;test&gt;</code> now</p>"},
+		{"code keeps markup", "`**not bold**`", "<p><code>**not bold**</code></p>"},
+		{"code block", "```go\nif a < b {\n}\n```", "<pre><code class=\"language-go\">if a &lt; b {\n}</code></pre>"},
+		{"unsafe code language", "```\"><script>\nx\n```", "<pre><code>x</code></pre>"},
+		{"unclosed code block", "```\nx", "<pre><code>x</code></pre>"},
+		{"link", "[site](https://example.com/a?b=1&c=2)", `<p><a href="https://example.com/a?b=1&amp;c=2" rel="nofollow ugc">site</a></p>`},
+		{"link label formatting", "[*x*](http://example.com)", `<p><a href="http://example.com" rel="nofollow ugc"><em>x</em></a></p>`},
+		{"mailto link", "[mail](mailto:me@example.com)", `<p><a href="mailto:me@example.com" rel="nofollow ugc">mail</a></p>`},
+		{"javascript link", "[x](javascript:alert(1))", "<p>[x](javascript:alert(1))</p>"},
+		{"relative link", "[x](/admin)", "<p>[x](/admin)</p>"},
+		{"bare URL", "see https://example.com/page.", `<p>see <a href="https://example.com/page" rel="nofollow ugc">https://example.com/page</a>.</p>`},
+		{"raw HTML is escaped", `<script>alert("x")</script>`, "<p>&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;</p>"},
+		{"attribute injection", `[x](https://example.com/"onmouseover="alert(1))`, `<p><a href="https://example.com/&#34;onmouseover=&#34;alert(1" rel="nofollow ugc">x</a>)</p>`},
+		{"escaped markup", `\*not em\*`, "<p>*not em*</p>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Render(tt.input, nil)
+			if result != tt.expected {
+				t.Errorf("Render(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRender_Mentions(t *testing.T) {
+	link := func(nickname string) string {
+		if nickname == "alice" {
+			return "/users/alice"
+		}
+		return ""
+	}
+
+	result := Render("hi @alice and @bob, mail me@example.com", link)
+	expected := `<p>hi <a href="/users/alice" class="mention">@alice</a> and @bob, mail me@example.com</p>`
+	if result != expected {
+		t.Errorf("Render() = %q, expected %q", result, expected)
+	}
+}
+
+func TestMentions(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"none", "hello", nil},
+		{"deduplicated in order", "@bob @alice @bob", []string{"bob", "alice"}},
+		{"emails are not mentions", "write to bob@example.com", nil},
+		{"code is ignored", "`@bob`\n```\n@carol\n```\n@dave", []string{"dave"}},
+		{"too short", "@a", nil},
+		{"inside emphasis", "**@erin**", []string{"erin"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Mentions(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Mentions(%q) = %v, expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
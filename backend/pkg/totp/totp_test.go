@@ -0,0 +1,86 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the RFC 6238 SHA1 test key "12345678901234567890" in base32
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode_RFC6238Vectors(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("Code(%d) error: %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("Code(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	code, _ := Code(rfcSecret, now)
+	previous, _ := Code(rfcSecret, now.Add(-Period*time.Second))
+	stale, _ := Code(rfcSecret, now.Add(-2*Period*time.Second))
+
+	step, ok := Validate(rfcSecret, code, now, 1)
+	if !ok || step != Step(now) {
+		t.Errorf("expected current code to match step %d, got %d (ok=%v)", Step(now), step, ok)
+	}
+
+	step, ok = Validate(rfcSecret, previous, now, 1)
+	if !ok || step != Step(now)-1 {
+		t.Errorf("expected previous code within skew to match step %d, got %d (ok=%v)", Step(now)-1, step, ok)
+	}
+
+	if _, ok := Validate(rfcSecret, stale, now, 1); ok {
+		t.Error("expected code outside skew to be rejected")
+	}
+	if _, ok := Validate(rfcSecret, "12345", now, 1); ok {
+		t.Error("expected short code to be rejected")
+	}
+	if _, ok := Validate("not base32!", code, now, 1); ok {
+		t.Error("expected invalid secret to be rejected")
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(secret) != 32 {
+		t.Errorf("expected 32 base32 characters for 20 bytes, got %d", len(secret))
+	}
+	if _, err := Code(secret, time.Now()); err != nil {
+		t.Errorf("expected generated secret to be usable: %v", err)
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("Viblog", "admin@example.com", rfcSecret)
+
+	if !strings.HasPrefix(uri, "otpauth://totp/Viblog:admin@example.com?") {
+		t.Errorf("unexpected URI label: %s", uri)
+	}
+	for _, param := range []string{"secret=" + rfcSecret, "issuer=Viblog", "digits=6", "period=30"} {
+		if !strings.Contains(uri, param) {
+			t.Errorf("expected URI to contain %s: %s", param, uri)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 )
@@ -28,16 +29,22 @@ func GetAcceptLanguage(r *http.Request) string {
 
 // GetPreferredLanguage extracts the preferred language from Accept-Language header
 func GetPreferredLanguage(r *http.Request, supportedLanguages []string) string {
+	return GetPreferredLanguageWithDefault(r, supportedLanguages, "en")
+}
+
+// GetPreferredLanguageWithDefault extracts the preferred language from Accept-Language header,
+// returning defaultLanguage when no supported language is requested
+func GetPreferredLanguageWithDefault(r *http.Request, supportedLanguages []string, defaultLanguage string) string {
 	acceptLang := GetAcceptLanguage(r)
 	if acceptLang == "" {
-		return "en"
+		return defaultLanguage
 	}
 	
 	// Parse Accept-Language header (simplified version)
 	languages := strings.Split(acceptLang, ",")
 	for _, lang := range languages {
 		// Remove quality value if present (e.g., "ko;q=0.9")
-		lang = strings.TrimSpace(strings.Split(lang, ";")[0])
+		lang = strings.ToLower(strings.TrimSpace(strings.Split(lang, ";")[0]))
 		
 		// Check if it's a supported language
 		for _, supported := range supportedLanguages {
@@ -47,7 +54,17 @@ func GetPreferredLanguage(r *http.Request, supportedLanguages []string) string {
 		}
 	}
 	
-	return "en"
+	return defaultLanguage
+}
+
+// IsSupportedLanguage checks if lang is one of the supported languages
+func IsSupportedLanguage(lang string, supportedLanguages []string) bool {
+	for _, supported := range supportedLanguages {
+		if lang == supported {
+			return true
+		}
+	}
+	return false
 }
 
 // SetJSONContentType sets the content type to application/json
@@ -74,18 +91,62 @@ func SetCacheHeaders(w http.ResponseWriter, maxAge int) {
 
 // IsBotRequest checks if the request is from a bot
 func IsBotRequest(r *http.Request) bool {
-	ua := strings.ToLower(GetUserAgent(r))
+	return IsBotUserAgent(GetUserAgent(r))
+}
+
+// IsBotUserAgent checks if a User-Agent header belongs to a bot
+func IsBotUserAgent(userAgent string) bool {
+	ua := strings.ToLower(userAgent)
 	bots := []string{
 		"bot", "crawler", "spider", "scraper",
 		"googlebot", "bingbot", "slurp", "duckduckbot",
 		"baiduspider", "yandexbot", "facebookexternalhit",
 	}
-	
+
 	for _, bot := range bots {
 		if strings.Contains(ua, bot) {
 			return true
 		}
 	}
-	
+
 	return false
 }
+
+// URLHost returns the lowercased host of an absolute URL without port and
+// leading "www.", or "" when it has none
+func URLHost(rawURL string) string {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+// UTMParams are the campaign parameters of a page URL
+type UTMParams struct {
+	Source   string
+	Medium   string
+	Campaign string
+}
+
+// GetUTMParams extracts the lowercased utm_source, utm_medium and
+// utm_campaign parameters of a URL, each cut to maxLen bytes
+func GetUTMParams(rawURL string, maxLen int) UTMParams {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return UTMParams{}
+	}
+	query := u.Query()
+	param := func(name string) string {
+		v := strings.ToLower(strings.TrimSpace(query.Get(name)))
+		if len(v) > maxLen {
+			v = strings.ToValidUTF8(v[:maxLen], "")
+		}
+		return v
+	}
+	return UTMParams{
+		Source:   param("utm_source"),
+		Medium:   param("utm_medium"),
+		Campaign: param("utm_campaign"),
+	}
+}
@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPreferredLanguageWithDefault(t *testing.T) {
+	supported := []string{"ko", "en"}
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		defaultLang    string
+		expected       string
+	}{
+		{
+			name:        "empty header returns default",
+			defaultLang: "ko",
+			expected:    "ko",
+		},
+		{
+			name:           "exact match",
+			acceptLanguage: "en",
+			defaultLang:    "ko",
+			expected:       "en",
+		},
+		{
+			name:           "region subtag",
+			acceptLanguage: "ko-KR,ko;q=0.9,en-US;q=0.8",
+			defaultLang:    "en",
+			expected:       "ko",
+		},
+		{
+			name:           "uppercase tag",
+			acceptLanguage: "EN-US",
+			defaultLang:    "ko",
+			expected:       "en",
+		},
+		{
+			name:           "unsupported language falls back",
+			acceptLanguage: "fr-FR,de;q=0.8",
+			defaultLang:    "ko",
+			expected:       "ko",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+
+			if got := GetPreferredLanguageWithDefault(req, supported, tt.defaultLang); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetPreferredLanguage(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept-Language", "ja")
+
+	if got := GetPreferredLanguage(req, []string{"ko"}); got != "en" {
+		t.Errorf("expected en, got %s", got)
+	}
+}
+
+func TestIsSupportedLanguage(t *testing.T) {
+	supported := []string{"ko", "en"}
+
+	if !IsSupportedLanguage("ko", supported) {
+		t.Error("expected ko to be supported")
+	}
+	if IsSupportedLanguage("fr", supported) {
+		t.Error("expected fr to be unsupported")
+	}
+}
+
+func TestURLHost(t *testing.T) {
+	tests := map[string]string{
+		"https://www.Google.com/search?q=go": "google.com",
+		"http://news.ycombinator.com:8080/":  "news.ycombinator.com",
+		"android-app://com.slack/":           "com.slack",
+		"/relative/path":                     "",
+		"":                                   "",
+		"://broken":                          "",
+	}
+
+	for rawURL, expected := range tests {
+		if got := URLHost(rawURL); got != expected {
+			t.Errorf("URLHost(%q) = %q, want %q", rawURL, got, expected)
+		}
+	}
+}
+
+func TestGetUTMParams(t *testing.T) {
+	got := GetUTMParams("https://blog.example.com/posts/1?utm_source=Newsletter&utm_medium=email&utm_campaign=spring-launch-2026", 10)
+	expected := UTMParams{Source: "newsletter", Medium: "email", Campaign: "spring-lau"}
+	if got != expected {
+		t.Errorf("GetUTMParams() = %+v, want %+v", got, expected)
+	}
+
+	if got := GetUTMParams("https://blog.example.com/posts/1", 10); got != (UTMParams{}) {
+		t.Errorf("Expected no parameters, got %+v", got)
+	}
+}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateSecureToken(t *testing.T) {
+	first, err := GenerateSecureToken(32)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := GenerateSecureToken(32)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(first) != 43 {
+		t.Errorf("expected 43 characters for 32 bytes, got %d", len(first))
+	}
+	if first == second {
+		t.Error("expected tokens to differ")
+	}
+	if strings.ContainsAny(first, "+/=") {
+		t.Errorf("expected URL-safe token, got %s", first)
+	}
+}
+
+func TestHashToken(t *testing.T) {
+	hash := HashToken("token")
+
+	if len(hash) != 64 {
+		t.Errorf("expected 64 hex characters, got %d", len(hash))
+	}
+	if hash != HashToken("token") {
+		t.Error("expected hashing to be deterministic")
+	}
+	if hash == HashToken("other") {
+		t.Error("expected different tokens to hash differently")
+	}
+}
+
+func TestHMACToken(t *testing.T) {
+	mac := HMACToken("secret", "203.0.113.7")
+
+	if len(mac) != 64 {
+		t.Errorf("expected 64 hex characters, got %d", len(mac))
+	}
+	if mac != HMACToken("secret", "203.0.113.7") {
+		t.Error("expected the HMAC to be deterministic")
+	}
+	if mac == HMACToken("other", "203.0.113.7") {
+		t.Error("expected different secrets to give different HMACs")
+	}
+	if mac == HashToken("203.0.113.7") {
+		t.Error("expected the HMAC to differ from the unkeyed hash")
+	}
+}
@@ -0,0 +1,88 @@
+package utils
+
+import "strings"
+
+// Device classes of a user agent
+const (
+	DeviceDesktop = "desktop"
+	DeviceMobile  = "mobile"
+	DeviceTablet  = "tablet"
+	DeviceBot     = "bot"
+	DeviceOther   = "other"
+)
+
+// UserAgent is the coarse client class parsed from a User-Agent header
+type UserAgent struct {
+	Device  string // One of the Device constants
+	Browser string // e.g. Chrome, Safari; "Other" when unknown
+	OS      string // e.g. Windows, iOS; "Other" when unknown
+}
+
+// browserTokens identifies browsers in order; tokens of browsers built on
+// another come before the ones they also contain
+var browserTokens = []struct {
+	token string
+	name  string
+}{
+	{"edg", "Edge"},
+	{"opr/", "Opera"},
+	{"opera", "Opera"},
+	{"samsungbrowser", "Samsung Internet"},
+	{"whale", "Whale"},
+	{"firefox", "Firefox"},
+	{"fxios", "Firefox"},
+	{"crios", "Chrome"},
+	{"chrome", "Chrome"},
+	{"safari", "Safari"},
+}
+
+// osTokens identifies operating systems in order
+var osTokens = []struct {
+	token string
+	name  string
+}{
+	{"windows", "Windows"},
+	{"iphone", "iOS"},
+	{"ipad", "iOS"},
+	{"ipod", "iOS"},
+	{"android", "Android"},
+	{"cros", "ChromeOS"},
+	{"mac os x", "macOS"},
+	{"macintosh", "macOS"},
+	{"linux", "Linux"},
+}
+
+// ParseUserAgent classifies a User-Agent header by device, browser and OS
+func ParseUserAgent(ua string) UserAgent {
+	lower := strings.ToLower(ua)
+	parsed := UserAgent{Device: DeviceOther, Browser: "Other", OS: "Other"}
+
+	if IsBotUserAgent(ua) {
+		parsed.Device = DeviceBot
+		return parsed
+	}
+
+	for _, b := range browserTokens {
+		if strings.Contains(lower, b.token) {
+			parsed.Browser = b.name
+			break
+		}
+	}
+	for _, o := range osTokens {
+		if strings.Contains(lower, o.token) {
+			parsed.OS = o.name
+			break
+		}
+	}
+
+	switch {
+	case strings.Contains(lower, "ipad") || strings.Contains(lower, "tablet") ||
+		(parsed.OS == "Android" && !strings.Contains(lower, "mobile")):
+		parsed.Device = DeviceTablet
+	case strings.Contains(lower, "mobi") || parsed.OS == "iOS" || parsed.OS == "Android":
+		parsed.Device = DeviceMobile
+	case parsed.OS != "Other":
+		parsed.Device = DeviceDesktop
+	}
+	return parsed
+}
@@ -0,0 +1,64 @@
+package utils
+
+import "testing"
+
+func TestParseUserAgent(t *testing.T) {
+	tests := []struct {
+		name     string
+		ua       string
+		expected UserAgent
+	}{
+		{
+			name:     "chrome on windows",
+			ua:       "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			expected: UserAgent{Device: DeviceDesktop, Browser: "Chrome", OS: "Windows"},
+		},
+		{
+			name:     "edge is not chrome",
+			ua:       "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.0.0",
+			expected: UserAgent{Device: DeviceDesktop, Browser: "Edge", OS: "Windows"},
+		},
+		{
+			name:     "safari on mac",
+			ua:       "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15",
+			expected: UserAgent{Device: DeviceDesktop, Browser: "Safari", OS: "macOS"},
+		},
+		{
+			name:     "safari on iphone",
+			ua:       "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1",
+			expected: UserAgent{Device: DeviceMobile, Browser: "Safari", OS: "iOS"},
+		},
+		{
+			name:     "samsung internet on android phone",
+			ua:       "Mozilla/5.0 (Linux; Android 14; SM-S911B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36",
+			expected: UserAgent{Device: DeviceMobile, Browser: "Samsung Internet", OS: "Android"},
+		},
+		{
+			name:     "android tablet",
+			ua:       "Mozilla/5.0 (Linux; Android 13; SM-X700) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			expected: UserAgent{Device: DeviceTablet, Browser: "Chrome", OS: "Android"},
+		},
+		{
+			name:     "firefox on linux",
+			ua:       "Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0",
+			expected: UserAgent{Device: DeviceDesktop, Browser: "Firefox", OS: "Linux"},
+		},
+		{
+			name:     "crawler",
+			ua:       "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			expected: UserAgent{Device: DeviceBot, Browser: "Other", OS: "Other"},
+		},
+		{
+			name:     "empty",
+			expected: UserAgent{Device: DeviceOther, Browser: "Other", OS: "Other"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseUserAgent(tt.ua); got != tt.expected {
+				t.Errorf("ParseUserAgent() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
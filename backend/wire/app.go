@@ -0,0 +1,13 @@
+package wire
+
+import (
+	"github.com/yourusername/viblog/internal/infrastructure/scheduler"
+	"github.com/yourusername/viblog/internal/interface/http/router"
+)
+
+// App holds the assembled application: the HTTP router and the background
+// jobs that run next to it
+type App struct {
+	Router    *router.Router
+	Scheduler *scheduler.Scheduler
+}
@@ -4,15 +4,27 @@
 package wire
 
 import (
+	"context"
+	"time"
+
 	"github.com/google/wire"
 	"github.com/yourusername/viblog/internal/config"
+	domainRepository "github.com/yourusername/viblog/internal/domain/repository"
 	"github.com/yourusername/viblog/internal/infrastructure/auth"
 	"github.com/yourusername/viblog/internal/infrastructure/database"
+	"github.com/yourusername/viblog/internal/infrastructure/geoip"
 	"github.com/yourusername/viblog/internal/infrastructure/logger"
+	"github.com/yourusername/viblog/internal/infrastructure/mail"
+	"github.com/yourusername/viblog/internal/infrastructure/oauth"
 	"github.com/yourusername/viblog/internal/infrastructure/repository"
+	"github.com/yourusername/viblog/internal/infrastructure/scheduler"
+	"github.com/yourusername/viblog/internal/infrastructure/spam"
 	"github.com/yourusername/viblog/internal/interface/http/handler"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
 	"github.com/yourusername/viblog/internal/interface/http/router"
 	"github.com/yourusername/viblog/internal/usecase/admin"
+	"github.com/yourusername/viblog/internal/usecase/ban"
+	"github.com/yourusername/viblog/internal/usecase/comment"
 	"github.com/yourusername/viblog/internal/usecase/post"
 	"github.com/yourusername/viblog/internal/usecase/user"
 	"go.uber.org/zap"
@@ -20,12 +32,28 @@ import (
 )
 
 // InitializeApp initializes the application with all dependencies
-func InitializeApp(cfg *config.Config) (*router.Router, func(), error) {
+func InitializeApp(cfg *config.Config) (*App, func(), error) {
 	wire.Build(
 		// Infrastructure
 		provideLogger,
 		provideDatabase,
 		provideJWTService,
+		provideServerConfig,
+		provideAnalyticsConfig,
+		provideAuditConfig,
+		provideI18nConfig,
+		provideAccountConfig,
+		provideMailer,
+		provideMailQueue,
+		provideOAuthConfig,
+		provideOAuthRegistry,
+		provideOAuthStateStore,
+		provideMFAConfig,
+		provideLoginThrottleConfig,
+		provideCommentConfig,
+		provideFormTokens,
+		provideGeoIPResolver,
+		provideSpamChecker,
 
 		// Repositories
 		repository.NewUserRepository,
@@ -33,21 +61,97 @@ func InitializeApp(cfg *config.Config) (*router.Router, func(), error) {
 		repository.NewCommentRepository,
 		repository.NewCategoryRepository,
 		repository.NewTagRepository,
+		repository.NewTranslationRepository,
+		repository.NewUserTokenRepository,
+		repository.NewUserIdentityRepository,
+		repository.NewRecoveryCodeRepository,
+		repository.NewLoginThrottleRepository,
+		repository.NewPersonalAccessTokenRepository,
+		repository.NewUserDataRepository,
+		repository.NewNotificationRepository,
+		repository.NewBanRepository,
+		repository.NewCommentReportRepository,
+		repository.NewCommentSubscriptionRepository,
+		repository.NewQueuedEmailRepository,
+		repository.NewAnalyticsRepository,
+		repository.NewAuditLogRepository,
+
+		// Ban enforcement
+		ban.NewGuard,
 
 		// User Use Cases
+		user.NewLoginGuard,
 		user.NewRegisterUseCase,
 		user.NewLoginUseCase,
 		user.NewGetProfileUseCase,
 		user.NewUpdateProfileUseCase,
+		user.NewValidateSessionUseCase,
+		user.NewRequestEmailVerificationUseCase,
+		user.NewConfirmEmailVerificationUseCase,
+		user.NewRequestPasswordResetUseCase,
+		user.NewResetPasswordUseCase,
+		user.NewStartOAuthUseCase,
+		user.NewOAuthLoginUseCase,
+		user.NewGetMFAStatusUseCase,
+		user.NewEnrollTOTPUseCase,
+		user.NewConfirmTOTPUseCase,
+		user.NewDisableTOTPUseCase,
+		user.NewRegenerateRecoveryCodesUseCase,
+		user.NewVerifyMFALoginUseCase,
+		user.NewCreatePersonalAccessTokenUseCase,
+		user.NewListPersonalAccessTokensUseCase,
+		user.NewRevokePersonalAccessTokenUseCase,
+		user.NewAuthenticatePersonalAccessTokenUseCase,
+		user.NewExportAccountDataUseCase,
+		user.NewRequestAccountDeletionUseCase,
+		user.NewPurgeDeletedAccountsUseCase,
+		user.NewGetPublicProfileUseCase,
+		user.NewForcePasswordResetUseCase,
 		post.NewListUseCase,
 		post.NewGetUseCase,
+		post.NewRecordViewUseCase,
+		post.NewSearchUseCase,
+		post.NewListByTaxonomyUseCase,
+		post.NewListCategoriesUseCase,
+		post.NewListTagsUseCase,
+
+		// Comment Use Cases
+		comment.NewCreateUseCase,
+		comment.NewUpdateUseCase,
+		comment.NewListUseCase,
+		comment.NewListRepliesUseCase,
+		comment.NewMentionNotifier,
+		comment.NewReportUseCase,
+		comment.NewReplyNotifier,
+		comment.NewConfirmSubscriptionUseCase,
+		comment.NewUnsubscribeUseCase,
+		comment.NewPruneSubscriptionsUseCase,
 
 		// Admin Use Cases
 		admin.NewGetDashboardUseCase,
+		admin.NewGetTrafficBreakdownUseCase,
 		admin.NewListUsersUseCase,
 		admin.NewDeleteUserUseCase,
+		admin.NewListPostsUseCase,
+		admin.NewListLockoutsUseCase,
+		admin.NewClearLockoutUseCase,
+		admin.NewAssignRoleUseCase,
+		admin.NewUpdateUserUseCase,
+		admin.NewSuspendUserUseCase,
+		admin.NewBanUserUseCase,
+		admin.NewReinstateUserUseCase,
 		admin.NewListCommentsUseCase,
 		admin.NewDeleteCommentUseCase,
+		admin.NewModerateCommentsUseCase,
+		admin.NewPinCommentUseCase,
+		admin.NewGetCommentHistoryUseCase,
+		admin.NewListCommentReportsUseCase,
+		admin.NewResolveCommentReportUseCase,
+		admin.NewListBansUseCase,
+		admin.NewCreateBanUseCase,
+		admin.NewDeleteBanUseCase,
+		admin.NewPruneExpiredBansUseCase,
+		admin.NewRollUpAnalyticsUseCase,
 		admin.NewListCategoriesUseCase,
 		admin.NewCreateCategoryUseCase,
 		admin.NewUpdateCategoryUseCase,
@@ -57,15 +161,52 @@ func InitializeApp(cfg *config.Config) (*router.Router, func(), error) {
 		admin.NewUpdateTagUseCase,
 		admin.NewDeleteTagUseCase,
 
+		// Audit log
+		admin.NewAuditRecorder,
+		admin.NewListAuditLogUseCase,
+		admin.NewPruneAuditLogUseCase,
+
+		// Translation Use Cases
+		admin.NewListPostTranslationsUseCase,
+		admin.NewUpsertPostTranslationUseCase,
+		admin.NewDeletePostTranslationUseCase,
+		admin.NewUpsertCategoryTranslationUseCase,
+		admin.NewDeleteCategoryTranslationUseCase,
+		admin.NewUpsertTagTranslationUseCase,
+		admin.NewDeleteTagTranslationUseCase,
+
 		// Handlers
 		provideUserHandler,
 		providePostHandler,
-		provideCommentHandler,
+		handler.NewCommentHandler,
 		provideAdminHandler,
 		provideNotificationHandler,
+		provideTranslationHandler,
+		handler.NewAccountHandler,
+		provideOAuthHandler,
+		handler.NewMFAHandler,
+		handler.NewLockoutHandler,
+		handler.NewRoleHandler,
+		handler.NewPersonalAccessTokenHandler,
+		handler.NewAccountDataHandler,
+		handler.NewProfileHandler,
+		handler.NewUserModerationHandler,
+		handler.NewCommentModerationHandler,
+		handler.NewBanHandler,
+		handler.NewCommentReportHandler,
+		handler.NewCommentSubscriptionHandler,
+		handler.NewAnalyticsHandler,
+		handler.NewAuditLogHandler,
 
 		// Router
+		provideSessionValidator,
+		providePATAuthenticator,
+		provideAuditor,
 		router.New,
+
+		// Background jobs
+		provideScheduler,
+		wire.Struct(new(App), "*"),
 	)
 	return nil, nil, nil
 }
@@ -98,8 +239,198 @@ func provideDatabase(cfg *config.Config) (*gorm.DB, func(), error) {
 	return db, cleanup, nil
 }
 
-func provideJWTService(cfg *config.Config) *auth.JWTService {
-	return auth.NewJWTService(cfg.JWT.Secret, cfg.JWT.RefreshSecret)
+func provideJWTService(cfg *config.Config, logger *zap.Logger) (*auth.JWTService, func(), error) {
+	jwtService, err := auth.NewJWTService(cfg.JWT)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Rotate signing keys in the background until shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	go jwtService.RunKeyRotation(ctx, logger)
+
+	return jwtService, cancel, nil
+}
+
+func provideScheduler(
+	logger *zap.Logger,
+	purgeUC *user.PurgeDeletedAccountsUseCase,
+	pruneBansUC *admin.PruneExpiredBansUseCase,
+	pruneSubscriptionsUC *comment.PruneSubscriptionsUseCase,
+	mailQueue *mail.Queue,
+	rollupUC *admin.RollUpAnalyticsUseCase,
+	analytics config.AnalyticsConfig,
+	pruneAuditUC *admin.PruneAuditLogUseCase,
+) (*scheduler.Scheduler, func()) {
+	s := scheduler.New(logger,
+		scheduler.Job{
+			Name:     "purge_deleted_accounts",
+			Interval: time.Hour,
+			Run: func(ctx context.Context, now time.Time) error {
+				purged, err := purgeUC.Execute(ctx, now)
+				if purged > 0 {
+					logger.Info("Anonymized deleted accounts", zap.Int("count", purged))
+				}
+				return err
+			},
+		},
+		scheduler.Job{
+			Name:     "prune_expired_bans",
+			Interval: time.Hour,
+			Run: func(ctx context.Context, now time.Time) error {
+				pruned, err := pruneBansUC.Execute(ctx, now)
+				if pruned > 0 {
+					logger.Info("Deleted expired bans", zap.Int64("count", pruned))
+				}
+				return err
+			},
+		},
+		scheduler.Job{
+			Name:     "prune_comment_subscriptions",
+			Interval: time.Hour,
+			Run: func(ctx context.Context, now time.Time) error {
+				pruned, err := pruneSubscriptionsUC.Execute(ctx, now)
+				if pruned > 0 {
+					logger.Info("Deleted unconfirmed comment subscriptions", zap.Int64("count", pruned))
+				}
+				return err
+			},
+		},
+		scheduler.Job{
+			Name:     "deliver_queued_emails",
+			Interval: time.Minute,
+			Run: func(ctx context.Context, now time.Time) error {
+				sent, err := mailQueue.Deliver(ctx, now)
+				if sent > 0 {
+					logger.Info("Delivered queued emails", zap.Int("count", sent))
+				}
+				return err
+			},
+		},
+		scheduler.Job{
+			Name:     "roll_up_analytics",
+			Interval: analytics.RollupInterval,
+			Run: func(ctx context.Context, now time.Time) error {
+				result, err := rollupUC.CatchUp(ctx, now)
+				if err != nil {
+					return err
+				}
+				if result.PrunedViewLogs > 0 {
+					logger.Info("Deleted old view logs", zap.Int64("count", result.PrunedViewLogs))
+				}
+				return nil
+			},
+		},
+		scheduler.Job{
+			Name:     "prune_audit_log",
+			Interval: time.Hour,
+			Run: func(ctx context.Context, now time.Time) error {
+				pruned, err := pruneAuditUC.Execute(ctx, now)
+				if pruned > 0 {
+					logger.Info("Deleted old audit log entries", zap.Int64("count", pruned))
+				}
+				return err
+			},
+		},
+	)
+	return s, s.Stop
+}
+
+func provideServerConfig(cfg *config.Config) config.ServerConfig {
+	return cfg.Server
+}
+
+func provideAnalyticsConfig(cfg *config.Config) config.AnalyticsConfig {
+	return cfg.Analytics
+}
+
+func provideAuditConfig(cfg *config.Config) config.AuditConfig {
+	return cfg.Audit
+}
+
+func provideI18nConfig(cfg *config.Config) config.I18nConfig {
+	return cfg.I18n
+}
+
+func provideAccountConfig(cfg *config.Config) config.AccountConfig {
+	return cfg.Account
+}
+
+func provideMailer(cfg *config.Config, logger *zap.Logger) (mail.Mailer, error) {
+	return mail.NewMailer(cfg.Mail, logger)
+}
+
+func provideMailQueue(cfg *config.Config, repo domainRepository.QueuedEmailRepository, mailer mail.Mailer) *mail.Queue {
+	return mail.NewQueue(repo, mailer, cfg.Mail)
+}
+
+func provideOAuthConfig(cfg *config.Config) config.OAuthConfig {
+	return cfg.OAuth
+}
+
+func provideOAuthRegistry(cfg config.OAuthConfig) *oauth.Registry {
+	return oauth.NewRegistry(cfg)
+}
+
+// oauthStateSweepInterval is how often abandoned social logins are dropped
+const oauthStateSweepInterval = time.Minute
+
+func provideOAuthStateStore(cfg config.OAuthConfig) (oauth.StateStore, func()) {
+	store := oauth.NewMemoryStateStore(cfg.MaxPendingStates)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go store.RunSweeper(ctx, oauthStateSweepInterval)
+
+	return store, cancel
+}
+
+func provideOAuthHandler(
+	cfg *config.Config,
+	registry *oauth.Registry,
+	startOAuthUC *user.StartOAuthUseCase,
+	oauthLoginUC *user.OAuthLoginUseCase,
+	jwtService *auth.JWTService,
+) *handler.OAuthHandler {
+	return handler.NewOAuthHandler(registry, startOAuthUC, oauthLoginUC, jwtService, cfg.Server.Env == "production")
+}
+
+func provideMFAConfig(cfg *config.Config) config.MFAConfig {
+	return cfg.MFA
+}
+
+func provideLoginThrottleConfig(cfg *config.Config) config.LoginThrottleConfig {
+	return cfg.Login
+}
+
+func provideCommentConfig(cfg *config.Config) config.CommentConfig {
+	return cfg.Comment
+}
+
+func provideFormTokens(cfg *config.Config) (*spam.FormTokens, error) {
+	return spam.NewFormTokens(cfg.Spam.FormTokenSecret, cfg.Spam.FormTokenTTL)
+}
+
+func provideGeoIPResolver(cfg *config.Config) (geoip.Resolver, error) {
+	return geoip.NewResolver(cfg.Analytics.GeoIPDatabase)
+}
+
+func provideSpamChecker(cfg *config.Config, commentRepo domainRepository.CommentRepository, tokens *spam.FormTokens, logger *zap.Logger) spam.Checker {
+	return spam.NewChecker(cfg.Spam, commentRepo, tokens, logger)
+}
+
+func provideSessionValidator(validateSessionUC *user.ValidateSessionUseCase) middleware.SessionValidator {
+	return func(ctx context.Context, claims *auth.TokenClaims) error {
+		_, err := validateSessionUC.Execute(ctx, claims.UserID, claims.TokenVersion)
+		return err
+	}
+}
+
+func providePATAuthenticator(authenticateUC *user.AuthenticatePersonalAccessTokenUseCase) middleware.PATAuthenticator {
+	return authenticateUC.Execute
+}
+
+func provideAuditor(recorder *admin.AuditRecorder) middleware.Auditor {
+	return recorder
 }
 
 func provideUserHandler(
@@ -107,27 +438,38 @@ func provideUserHandler(
 	loginUC *user.LoginUseCase,
 	getProfileUC *user.GetProfileUseCase,
 	updateProfileUC *user.UpdateProfileUseCase,
+	validateSessionUC *user.ValidateSessionUseCase,
+	requestVerificationUC *user.RequestEmailVerificationUseCase,
 	jwtService *auth.JWTService,
 ) *handler.UserHandler {
-	return handler.NewUserHandler(registerUC, loginUC, getProfileUC, updateProfileUC, jwtService)
+	return handler.NewUserHandler(
+		registerUC,
+		loginUC,
+		getProfileUC,
+		updateProfileUC,
+		validateSessionUC,
+		requestVerificationUC,
+		jwtService,
+	)
 }
 
 func providePostHandler(
 	listUC *post.ListUseCase,
 	getUC *post.GetUseCase,
+	viewUC *post.RecordViewUseCase,
+	searchUC *post.SearchUseCase,
+	byTaxonomyUC *post.ListByTaxonomyUseCase,
+	listCategoriesUC *post.ListCategoriesUseCase,
+	listTagsUC *post.ListTagsUseCase,
 ) *handler.PostHandler {
-	return handler.NewPostHandler(listUC, getUC)
-}
-
-func provideCommentHandler() *handler.CommentHandler {
-	// TODO: Implement comment use cases
-	return handler.NewCommentHandler(nil)
+	return handler.NewPostHandler(listUC, getUC, viewUC, searchUC, byTaxonomyUC, listCategoriesUC, listTagsUC)
 }
 
 func provideAdminHandler(
 	dashboardUC *admin.GetDashboardUseCase,
 	listUsersUC *admin.ListUsersUseCase,
 	deleteUserUC *admin.DeleteUserUseCase,
+	listPostsUC *admin.ListPostsUseCase,
 	listCommentsUC *admin.ListCommentsUseCase,
 	deleteCommentUC *admin.DeleteCommentUseCase,
 	listCategoriesUC *admin.ListCategoriesUseCase,
@@ -143,6 +485,7 @@ func provideAdminHandler(
 		dashboardUC,
 		listUsersUC,
 		deleteUserUC,
+		listPostsUC,
 		listCommentsUC,
 		deleteCommentUC,
 		listCategoriesUC,
@@ -160,3 +503,23 @@ func provideNotificationHandler() *handler.NotificationHandler {
 	// TODO: Implement notification use cases
 	return handler.NewNotificationHandler(nil)
 }
+
+func provideTranslationHandler(
+	listPostTranslationsUC *admin.ListPostTranslationsUseCase,
+	upsertPostTranslationUC *admin.UpsertPostTranslationUseCase,
+	deletePostTranslationUC *admin.DeletePostTranslationUseCase,
+	upsertCategoryTranslationUC *admin.UpsertCategoryTranslationUseCase,
+	deleteCategoryTranslationUC *admin.DeleteCategoryTranslationUseCase,
+	upsertTagTranslationUC *admin.UpsertTagTranslationUseCase,
+	deleteTagTranslationUC *admin.DeleteTagTranslationUseCase,
+) *handler.TranslationHandler {
+	return handler.NewTranslationHandler(
+		listPostTranslationsUC,
+		upsertPostTranslationUC,
+		deletePostTranslationUC,
+		upsertCategoryTranslationUC,
+		deleteCategoryTranslationUC,
+		upsertTagTranslationUC,
+		deleteTagTranslationUC,
+	)
+}
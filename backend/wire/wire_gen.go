@@ -7,64 +7,219 @@
 package wire
 
 import (
+	"context"
 	"github.com/yourusername/viblog/internal/config"
+	repository2 "github.com/yourusername/viblog/internal/domain/repository"
 	"github.com/yourusername/viblog/internal/infrastructure/auth"
 	"github.com/yourusername/viblog/internal/infrastructure/database"
+	"github.com/yourusername/viblog/internal/infrastructure/geoip"
 	"github.com/yourusername/viblog/internal/infrastructure/logger"
+	"github.com/yourusername/viblog/internal/infrastructure/mail"
+	"github.com/yourusername/viblog/internal/infrastructure/oauth"
 	"github.com/yourusername/viblog/internal/infrastructure/repository"
+	"github.com/yourusername/viblog/internal/infrastructure/scheduler"
+	"github.com/yourusername/viblog/internal/infrastructure/spam"
 	"github.com/yourusername/viblog/internal/interface/http/handler"
+	"github.com/yourusername/viblog/internal/interface/http/middleware"
 	"github.com/yourusername/viblog/internal/interface/http/router"
 	"github.com/yourusername/viblog/internal/usecase/admin"
+	"github.com/yourusername/viblog/internal/usecase/ban"
+	"github.com/yourusername/viblog/internal/usecase/comment"
 	"github.com/yourusername/viblog/internal/usecase/post"
 	"github.com/yourusername/viblog/internal/usecase/user"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"time"
 )
 
 // Injectors from wire.go:
 
 // InitializeApp initializes the application with all dependencies
-func InitializeApp(cfg *config.Config) (*router.Router, func(), error) {
+func InitializeApp(cfg *config.Config) (*App, func(), error) {
 	logger, err := provideLogger(cfg)
 	if err != nil {
 		return nil, nil, err
 	}
-	jwtService := provideJWTService(cfg)
-	db, cleanup, err := provideDatabase(cfg)
+	jwtService, cleanup, err := provideJWTService(cfg, logger)
 	if err != nil {
 		return nil, nil, err
 	}
+	db, cleanup2, err := provideDatabase(cfg)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	userRepository := repository.NewUserRepository(db)
-	registerUseCase := user.NewRegisterUseCase(userRepository)
-	loginUseCase := user.NewLoginUseCase(userRepository)
+	validateSessionUseCase := user.NewValidateSessionUseCase(userRepository)
+	sessionValidator := provideSessionValidator(validateSessionUseCase)
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenRepository(db)
+	authenticatePersonalAccessTokenUseCase := user.NewAuthenticatePersonalAccessTokenUseCase(userRepository, personalAccessTokenRepository)
+	patAuthenticator := providePATAuthenticator(authenticatePersonalAccessTokenUseCase)
+	banRepository := repository.NewBanRepository(db)
+	guard := ban.NewGuard(banRepository)
+	registerUseCase := user.NewRegisterUseCase(userRepository, guard)
+	loginThrottleRepository := repository.NewLoginThrottleRepository(db)
+	loginThrottleConfig := provideLoginThrottleConfig(cfg)
+	loginGuard := user.NewLoginGuard(loginThrottleRepository, loginThrottleConfig)
+	loginUseCase := user.NewLoginUseCase(userRepository, loginGuard, guard)
 	getProfileUseCase := user.NewGetProfileUseCase(userRepository)
 	updateProfileUseCase := user.NewUpdateProfileUseCase(userRepository)
-	userHandler := provideUserHandler(registerUseCase, loginUseCase, getProfileUseCase, updateProfileUseCase, jwtService)
+	userTokenRepository := repository.NewUserTokenRepository(db)
+	mailer, err := provideMailer(cfg, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	queuedEmailRepository := repository.NewQueuedEmailRepository(db)
+	queue := provideMailQueue(cfg, queuedEmailRepository, mailer)
+	accountConfig := provideAccountConfig(cfg)
+	requestEmailVerificationUseCase := user.NewRequestEmailVerificationUseCase(userRepository, userTokenRepository, queue, accountConfig, logger)
+	userHandler := provideUserHandler(registerUseCase, loginUseCase, getProfileUseCase, updateProfileUseCase, validateSessionUseCase, requestEmailVerificationUseCase, jwtService)
 	postRepository := repository.NewPostRepository(db)
-	listUseCase := post.NewListUseCase(postRepository)
-	getUseCase := post.NewGetUseCase(postRepository)
-	postHandler := providePostHandler(listUseCase, getUseCase)
-	commentHandler := provideCommentHandler()
+	i18nConfig := provideI18nConfig(cfg)
+	listUseCase := post.NewListUseCase(postRepository, i18nConfig)
+	getUseCase := post.NewGetUseCase(postRepository, i18nConfig)
+	resolver, err := provideGeoIPResolver(cfg)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	recordViewUseCase := post.NewRecordViewUseCase(postRepository, resolver, accountConfig)
+	searchUseCase := post.NewSearchUseCase(postRepository, i18nConfig)
+	categoryRepository := repository.NewCategoryRepository(db)
+	tagRepository := repository.NewTagRepository(db)
+	listByTaxonomyUseCase := post.NewListByTaxonomyUseCase(postRepository, categoryRepository, tagRepository, i18nConfig)
+	postListCategoriesUseCase := post.NewListCategoriesUseCase(categoryRepository)
+	postListTagsUseCase := post.NewListTagsUseCase(tagRepository)
+	postHandler := providePostHandler(listUseCase, getUseCase, recordViewUseCase, searchUseCase, listByTaxonomyUseCase, postListCategoriesUseCase, postListTagsUseCase)
 	commentRepository := repository.NewCommentRepository(db)
-	getDashboardUseCase := admin.NewGetDashboardUseCase(userRepository, postRepository, commentRepository)
+	formTokens, err := provideFormTokens(cfg)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	checker := provideSpamChecker(cfg, commentRepository, formTokens, logger)
+	notificationRepository := repository.NewNotificationRepository(db)
+	mentionNotifier := comment.NewMentionNotifier(notificationRepository)
+	commentSubscriptionRepository := repository.NewCommentSubscriptionRepository(db)
+	commentConfig := provideCommentConfig(cfg)
+	replyNotifier := comment.NewReplyNotifier(commentSubscriptionRepository, commentRepository, queue, accountConfig, commentConfig)
+	createUseCase := comment.NewCreateUseCase(commentRepository, postRepository, userRepository, guard, checker, formTokens, mentionNotifier, replyNotifier, commentConfig)
+	updateUseCase := comment.NewUpdateUseCase(commentRepository, postRepository, userRepository, guard, checker, mentionNotifier, commentConfig)
+	commentListUseCase := comment.NewListUseCase(commentRepository, postRepository)
+	listRepliesUseCase := comment.NewListRepliesUseCase(commentRepository)
+	commentReportRepository := repository.NewCommentReportRepository(db)
+	reportUseCase := comment.NewReportUseCase(commentRepository, postRepository, commentReportRepository, commentConfig)
+	commentHandler := handler.NewCommentHandler(createUseCase, updateUseCase, commentListUseCase, listRepliesUseCase, reportUseCase)
+	analyticsRepository := repository.NewAnalyticsRepository(db)
+	serverConfig := provideServerConfig(cfg)
+	getDashboardUseCase := admin.NewGetDashboardUseCase(userRepository, postRepository, commentRepository, analyticsRepository, serverConfig)
 	listUsersUseCase := admin.NewListUsersUseCase(userRepository)
-	deleteUserUseCase := admin.NewDeleteUserUseCase(userRepository)
+	userDataRepository := repository.NewUserDataRepository(db)
+	deleteUserUseCase := admin.NewDeleteUserUseCase(userRepository, userDataRepository)
+	listPostsUseCase := admin.NewListPostsUseCase(postRepository)
 	listCommentsUseCase := admin.NewListCommentsUseCase(commentRepository)
 	deleteCommentUseCase := admin.NewDeleteCommentUseCase(commentRepository, postRepository)
-	categoryRepository := repository.NewCategoryRepository(db)
 	listCategoriesUseCase := admin.NewListCategoriesUseCase(categoryRepository)
 	createCategoryUseCase := admin.NewCreateCategoryUseCase(categoryRepository)
 	updateCategoryUseCase := admin.NewUpdateCategoryUseCase(categoryRepository)
 	deleteCategoryUseCase := admin.NewDeleteCategoryUseCase(categoryRepository)
-	tagRepository := repository.NewTagRepository(db)
 	listTagsUseCase := admin.NewListTagsUseCase(tagRepository)
 	createTagUseCase := admin.NewCreateTagUseCase(tagRepository)
 	updateTagUseCase := admin.NewUpdateTagUseCase(tagRepository)
 	deleteTagUseCase := admin.NewDeleteTagUseCase(tagRepository)
-	adminHandler := provideAdminHandler(getDashboardUseCase, listUsersUseCase, deleteUserUseCase, listCommentsUseCase, deleteCommentUseCase, listCategoriesUseCase, createCategoryUseCase, updateCategoryUseCase, deleteCategoryUseCase, listTagsUseCase, createTagUseCase, updateTagUseCase, deleteTagUseCase)
+	adminHandler := provideAdminHandler(getDashboardUseCase, listUsersUseCase, deleteUserUseCase, listPostsUseCase, listCommentsUseCase, deleteCommentUseCase, listCategoriesUseCase, createCategoryUseCase, updateCategoryUseCase, deleteCategoryUseCase, listTagsUseCase, createTagUseCase, updateTagUseCase, deleteTagUseCase)
 	notificationHandler := provideNotificationHandler()
-	routerRouter := router.New(cfg, logger, jwtService, userHandler, postHandler, commentHandler, adminHandler, notificationHandler)
-	return routerRouter, func() {
+	translationRepository := repository.NewTranslationRepository(db)
+	listPostTranslationsUseCase := admin.NewListPostTranslationsUseCase(postRepository, translationRepository)
+	upsertPostTranslationUseCase := admin.NewUpsertPostTranslationUseCase(postRepository, translationRepository, i18nConfig)
+	deletePostTranslationUseCase := admin.NewDeletePostTranslationUseCase(translationRepository)
+	upsertCategoryTranslationUseCase := admin.NewUpsertCategoryTranslationUseCase(categoryRepository, translationRepository, i18nConfig)
+	deleteCategoryTranslationUseCase := admin.NewDeleteCategoryTranslationUseCase(translationRepository)
+	upsertTagTranslationUseCase := admin.NewUpsertTagTranslationUseCase(tagRepository, translationRepository, i18nConfig)
+	deleteTagTranslationUseCase := admin.NewDeleteTagTranslationUseCase(translationRepository)
+	translationHandler := provideTranslationHandler(listPostTranslationsUseCase, upsertPostTranslationUseCase, deletePostTranslationUseCase, upsertCategoryTranslationUseCase, deleteCategoryTranslationUseCase, upsertTagTranslationUseCase, deleteTagTranslationUseCase)
+	confirmEmailVerificationUseCase := user.NewConfirmEmailVerificationUseCase(userRepository, userTokenRepository)
+	requestPasswordResetUseCase := user.NewRequestPasswordResetUseCase(userRepository, userTokenRepository, queue, accountConfig, logger)
+	resetPasswordUseCase := user.NewResetPasswordUseCase(userRepository, userTokenRepository, personalAccessTokenRepository)
+	accountHandler := handler.NewAccountHandler(requestEmailVerificationUseCase, confirmEmailVerificationUseCase, requestPasswordResetUseCase, resetPasswordUseCase)
+	oAuthConfig := provideOAuthConfig(cfg)
+	registry := provideOAuthRegistry(oAuthConfig)
+	stateStore, cleanup3 := provideOAuthStateStore(oAuthConfig)
+	startOAuthUseCase := user.NewStartOAuthUseCase(registry, stateStore, oAuthConfig)
+	userIdentityRepository := repository.NewUserIdentityRepository(db)
+	oAuthLoginUseCase := user.NewOAuthLoginUseCase(registry, stateStore, userRepository, userIdentityRepository, guard)
+	oAuthHandler := provideOAuthHandler(cfg, registry, startOAuthUseCase, oAuthLoginUseCase, jwtService)
+	recoveryCodeRepository := repository.NewRecoveryCodeRepository(db)
+	getMFAStatusUseCase := user.NewGetMFAStatusUseCase(userRepository, recoveryCodeRepository)
+	mfaConfig := provideMFAConfig(cfg)
+	enrollTOTPUseCase := user.NewEnrollTOTPUseCase(userRepository, mfaConfig)
+	confirmTOTPUseCase := user.NewConfirmTOTPUseCase(userRepository, recoveryCodeRepository)
+	disableTOTPUseCase := user.NewDisableTOTPUseCase(userRepository, recoveryCodeRepository)
+	regenerateRecoveryCodesUseCase := user.NewRegenerateRecoveryCodesUseCase(userRepository, recoveryCodeRepository)
+	verifyMFALoginUseCase := user.NewVerifyMFALoginUseCase(userRepository, recoveryCodeRepository, loginGuard)
+	mfaHandler := handler.NewMFAHandler(getMFAStatusUseCase, enrollTOTPUseCase, confirmTOTPUseCase, disableTOTPUseCase, regenerateRecoveryCodesUseCase, verifyMFALoginUseCase, jwtService)
+	listLockoutsUseCase := admin.NewListLockoutsUseCase(loginThrottleRepository)
+	clearLockoutUseCase := admin.NewClearLockoutUseCase(loginThrottleRepository)
+	lockoutHandler := handler.NewLockoutHandler(listLockoutsUseCase, clearLockoutUseCase)
+	assignRoleUseCase := admin.NewAssignRoleUseCase(userRepository)
+	roleHandler := handler.NewRoleHandler(assignRoleUseCase)
+	listPersonalAccessTokensUseCase := user.NewListPersonalAccessTokensUseCase(personalAccessTokenRepository)
+	createPersonalAccessTokenUseCase := user.NewCreatePersonalAccessTokenUseCase(userRepository, personalAccessTokenRepository)
+	revokePersonalAccessTokenUseCase := user.NewRevokePersonalAccessTokenUseCase(personalAccessTokenRepository)
+	personalAccessTokenHandler := handler.NewPersonalAccessTokenHandler(listPersonalAccessTokensUseCase, createPersonalAccessTokenUseCase, revokePersonalAccessTokenUseCase)
+	exportAccountDataUseCase := user.NewExportAccountDataUseCase(userRepository, userDataRepository)
+	requestAccountDeletionUseCase := user.NewRequestAccountDeletionUseCase(userRepository, recoveryCodeRepository, queue, accountConfig, logger)
+	accountDataHandler := handler.NewAccountDataHandler(exportAccountDataUseCase, requestAccountDeletionUseCase)
+	getPublicProfileUseCase := user.NewGetPublicProfileUseCase(userRepository, commentRepository)
+	profileHandler := handler.NewProfileHandler(getPublicProfileUseCase)
+	updateUserUseCase := admin.NewUpdateUserUseCase(userRepository)
+	suspendUserUseCase := admin.NewSuspendUserUseCase(userRepository)
+	banUserUseCase := admin.NewBanUserUseCase(userRepository)
+	reinstateUserUseCase := admin.NewReinstateUserUseCase(userRepository)
+	forcePasswordResetUseCase := user.NewForcePasswordResetUseCase(userRepository, userTokenRepository, personalAccessTokenRepository, queue, accountConfig, logger)
+	userModerationHandler := handler.NewUserModerationHandler(updateUserUseCase, suspendUserUseCase, banUserUseCase, reinstateUserUseCase, forcePasswordResetUseCase)
+	moderateCommentsUseCase := admin.NewModerateCommentsUseCase(commentRepository, postRepository, notificationRepository, mentionNotifier, replyNotifier)
+	pinCommentUseCase := admin.NewPinCommentUseCase(commentRepository)
+	getCommentHistoryUseCase := admin.NewGetCommentHistoryUseCase(commentRepository)
+	commentModerationHandler := handler.NewCommentModerationHandler(moderateCommentsUseCase, pinCommentUseCase, getCommentHistoryUseCase)
+	listBansUseCase := admin.NewListBansUseCase(banRepository)
+	createBanUseCase := admin.NewCreateBanUseCase(banRepository, userRepository)
+	deleteBanUseCase := admin.NewDeleteBanUseCase(banRepository)
+	banHandler := handler.NewBanHandler(listBansUseCase, createBanUseCase, deleteBanUseCase)
+	listCommentReportsUseCase := admin.NewListCommentReportsUseCase(commentReportRepository)
+	resolveCommentReportUseCase := admin.NewResolveCommentReportUseCase(commentReportRepository, commentRepository, postRepository, deleteCommentUseCase)
+	commentReportHandler := handler.NewCommentReportHandler(listCommentReportsUseCase, resolveCommentReportUseCase)
+	confirmSubscriptionUseCase := comment.NewConfirmSubscriptionUseCase(commentSubscriptionRepository)
+	unsubscribeUseCase := comment.NewUnsubscribeUseCase(commentSubscriptionRepository)
+	commentSubscriptionHandler := handler.NewCommentSubscriptionHandler(confirmSubscriptionUseCase, unsubscribeUseCase)
+	analyticsConfig := provideAnalyticsConfig(cfg)
+	rollUpAnalyticsUseCase := admin.NewRollUpAnalyticsUseCase(analyticsRepository, serverConfig, analyticsConfig)
+	getTrafficBreakdownUseCase := admin.NewGetTrafficBreakdownUseCase(postRepository, analyticsRepository, serverConfig)
+	analyticsHandler := handler.NewAnalyticsHandler(rollUpAnalyticsUseCase, getTrafficBreakdownUseCase)
+	auditLogRepository := repository.NewAuditLogRepository(db)
+	auditRecorder := admin.NewAuditRecorder(auditLogRepository, userRepository, postRepository, commentRepository, commentReportRepository, categoryRepository, tagRepository, banRepository, loginThrottleRepository)
+	auditor := provideAuditor(auditRecorder)
+	listAuditLogUseCase := admin.NewListAuditLogUseCase(auditLogRepository)
+	auditLogHandler := handler.NewAuditLogHandler(listAuditLogUseCase)
+	routerRouter := router.New(cfg, logger, jwtService, sessionValidator, patAuthenticator, auditor, userHandler, postHandler, commentHandler, adminHandler, notificationHandler, translationHandler, accountHandler, oAuthHandler, mfaHandler, lockoutHandler, roleHandler, personalAccessTokenHandler, accountDataHandler, profileHandler, userModerationHandler, commentModerationHandler, banHandler, commentReportHandler, commentSubscriptionHandler, analyticsHandler, auditLogHandler)
+	purgeDeletedAccountsUseCase := user.NewPurgeDeletedAccountsUseCase(userRepository, userDataRepository)
+	pruneExpiredBansUseCase := admin.NewPruneExpiredBansUseCase(banRepository)
+	pruneSubscriptionsUseCase := comment.NewPruneSubscriptionsUseCase(commentSubscriptionRepository)
+	auditConfig := provideAuditConfig(cfg)
+	pruneAuditLogUseCase := admin.NewPruneAuditLogUseCase(auditLogRepository, auditConfig)
+	scheduler, cleanup4 := provideScheduler(logger, purgeDeletedAccountsUseCase, pruneExpiredBansUseCase, pruneSubscriptionsUseCase, queue, rollUpAnalyticsUseCase, analyticsConfig, pruneAuditLogUseCase)
+	app := &App{
+		Router:    routerRouter,
+		Scheduler: scheduler,
+	}
+	return app, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -96,8 +251,196 @@ func provideDatabase(cfg *config.Config) (*gorm.DB, func(), error) {
 	return db, cleanup, nil
 }
 
-func provideJWTService(cfg *config.Config) *auth.JWTService {
-	return auth.NewJWTService(cfg.JWT.Secret, cfg.JWT.RefreshSecret)
+func provideJWTService(cfg *config.Config, logger2 *zap.Logger) (*auth.JWTService, func(), error) {
+	jwtService, err := auth.NewJWTService(cfg.JWT)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go jwtService.RunKeyRotation(ctx, logger2)
+
+	return jwtService, cancel, nil
+}
+
+func provideScheduler(logger2 *zap.Logger,
+	purgeUC *user.PurgeDeletedAccountsUseCase,
+	pruneBansUC *admin.PruneExpiredBansUseCase,
+	pruneSubscriptionsUC *comment.PruneSubscriptionsUseCase,
+	mailQueue *mail.Queue,
+	rollupUC *admin.RollUpAnalyticsUseCase,
+	analytics config.AnalyticsConfig,
+	pruneAuditUC *admin.PruneAuditLogUseCase,
+) (*scheduler.Scheduler, func()) {
+	s := scheduler.New(logger2, scheduler.Job{
+		Name:     "purge_deleted_accounts",
+		Interval: time.Hour,
+		Run: func(ctx context.Context, now time.Time) error {
+			purged, err := purgeUC.Execute(ctx, now)
+			if purged > 0 {
+				logger2.
+					Info("Anonymized deleted accounts", zap.Int("count", purged))
+			}
+			return err
+		},
+	}, scheduler.Job{
+		Name:     "prune_expired_bans",
+		Interval: time.Hour,
+		Run: func(ctx context.Context, now time.Time) error {
+			pruned, err := pruneBansUC.Execute(ctx, now)
+			if pruned > 0 {
+				logger2.
+					Info("Deleted expired bans", zap.Int64("count", pruned))
+			}
+			return err
+		},
+	}, scheduler.Job{
+		Name:     "prune_comment_subscriptions",
+		Interval: time.Hour,
+		Run: func(ctx context.Context, now time.Time) error {
+			pruned, err := pruneSubscriptionsUC.Execute(ctx, now)
+			if pruned > 0 {
+				logger2.
+					Info("Deleted unconfirmed comment subscriptions", zap.Int64("count", pruned))
+			}
+			return err
+		},
+	}, scheduler.Job{
+		Name:     "deliver_queued_emails",
+		Interval: time.Minute,
+		Run: func(ctx context.Context, now time.Time) error {
+			sent, err := mailQueue.Deliver(ctx, now)
+			if sent > 0 {
+				logger2.
+					Info("Delivered queued emails", zap.Int("count", sent))
+			}
+			return err
+		},
+	}, scheduler.Job{
+		Name:     "roll_up_analytics",
+		Interval: analytics.RollupInterval,
+		Run: func(ctx context.Context, now time.Time) error {
+			result, err := rollupUC.CatchUp(ctx, now)
+			if err != nil {
+				return err
+			}
+			if result.PrunedViewLogs > 0 {
+				logger2.
+					Info("Deleted old view logs", zap.Int64("count", result.PrunedViewLogs))
+			}
+			return nil
+		},
+	}, scheduler.Job{
+		Name:     "prune_audit_log",
+		Interval: time.Hour,
+		Run: func(ctx context.Context, now time.Time) error {
+			pruned, err := pruneAuditUC.Execute(ctx, now)
+			if pruned > 0 {
+				logger2.
+					Info("Deleted old audit log entries", zap.Int64("count", pruned))
+			}
+			return err
+		},
+	},
+	)
+	return s, s.Stop
+}
+
+func provideServerConfig(cfg *config.Config) config.ServerConfig {
+	return cfg.Server
+}
+
+func provideAnalyticsConfig(cfg *config.Config) config.AnalyticsConfig {
+	return cfg.Analytics
+}
+
+func provideAuditConfig(cfg *config.Config) config.AuditConfig {
+	return cfg.Audit
+}
+
+func provideI18nConfig(cfg *config.Config) config.I18nConfig {
+	return cfg.I18n
+}
+
+func provideAccountConfig(cfg *config.Config) config.AccountConfig {
+	return cfg.Account
+}
+
+func provideMailer(cfg *config.Config, logger2 *zap.Logger) (mail.Mailer, error) {
+	return mail.NewMailer(cfg.Mail, logger2)
+}
+
+func provideMailQueue(cfg *config.Config, repo repository2.QueuedEmailRepository, mailer mail.Mailer) *mail.Queue {
+	return mail.NewQueue(repo, mailer, cfg.Mail)
+}
+
+func provideOAuthConfig(cfg *config.Config) config.OAuthConfig {
+	return cfg.OAuth
+}
+
+func provideOAuthRegistry(cfg config.OAuthConfig) *oauth.Registry {
+	return oauth.NewRegistry(cfg)
+}
+
+// oauthStateSweepInterval is how often abandoned social logins are dropped
+const oauthStateSweepInterval = time.Minute
+
+func provideOAuthStateStore(cfg config.OAuthConfig) (oauth.StateStore, func()) {
+	store := oauth.NewMemoryStateStore(cfg.MaxPendingStates)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go store.RunSweeper(ctx, oauthStateSweepInterval)
+
+	return store, cancel
+}
+
+func provideOAuthHandler(
+	cfg *config.Config,
+	registry *oauth.Registry,
+	startOAuthUC *user.StartOAuthUseCase,
+	oauthLoginUC *user.OAuthLoginUseCase,
+	jwtService *auth.JWTService,
+) *handler.OAuthHandler {
+	return handler.NewOAuthHandler(registry, startOAuthUC, oauthLoginUC, jwtService, cfg.Server.Env == "production")
+}
+
+func provideMFAConfig(cfg *config.Config) config.MFAConfig {
+	return cfg.MFA
+}
+
+func provideLoginThrottleConfig(cfg *config.Config) config.LoginThrottleConfig {
+	return cfg.Login
+}
+
+func provideCommentConfig(cfg *config.Config) config.CommentConfig {
+	return cfg.Comment
+}
+
+func provideFormTokens(cfg *config.Config) (*spam.FormTokens, error) {
+	return spam.NewFormTokens(cfg.Spam.FormTokenSecret, cfg.Spam.FormTokenTTL)
+}
+
+func provideGeoIPResolver(cfg *config.Config) (geoip.Resolver, error) {
+	return geoip.NewResolver(cfg.Analytics.GeoIPDatabase)
+}
+
+func provideSpamChecker(cfg *config.Config, commentRepo repository2.CommentRepository, tokens *spam.FormTokens, logger2 *zap.Logger) spam.Checker {
+	return spam.NewChecker(cfg.Spam, commentRepo, tokens, logger2)
+}
+
+func provideSessionValidator(validateSessionUC *user.ValidateSessionUseCase) middleware.SessionValidator {
+	return func(ctx context.Context, claims *auth.TokenClaims) error {
+		_, err := validateSessionUC.Execute(ctx, claims.UserID, claims.TokenVersion)
+		return err
+	}
+}
+
+func providePATAuthenticator(authenticateUC *user.AuthenticatePersonalAccessTokenUseCase) middleware.PATAuthenticator {
+	return authenticateUC.Execute
+}
+
+func provideAuditor(recorder *admin.AuditRecorder) middleware.Auditor {
+	return recorder
 }
 
 func provideUserHandler(
@@ -105,27 +448,38 @@ func provideUserHandler(
 	loginUC *user.LoginUseCase,
 	getProfileUC *user.GetProfileUseCase,
 	updateProfileUC *user.UpdateProfileUseCase,
+	validateSessionUC *user.ValidateSessionUseCase,
+	requestVerificationUC *user.RequestEmailVerificationUseCase,
 	jwtService *auth.JWTService,
 ) *handler.UserHandler {
-	return handler.NewUserHandler(registerUC, loginUC, getProfileUC, updateProfileUC, jwtService)
+	return handler.NewUserHandler(
+		registerUC,
+		loginUC,
+		getProfileUC,
+		updateProfileUC,
+		validateSessionUC,
+		requestVerificationUC,
+		jwtService,
+	)
 }
 
 func providePostHandler(
 	listUC *post.ListUseCase,
 	getUC *post.GetUseCase,
+	viewUC *post.RecordViewUseCase,
+	searchUC *post.SearchUseCase,
+	byTaxonomyUC *post.ListByTaxonomyUseCase,
+	listCategoriesUC *post.ListCategoriesUseCase,
+	listTagsUC *post.ListTagsUseCase,
 ) *handler.PostHandler {
-	return handler.NewPostHandler(listUC, getUC)
-}
-
-func provideCommentHandler() *handler.CommentHandler {
-
-	return handler.NewCommentHandler(nil)
+	return handler.NewPostHandler(listUC, getUC, viewUC, searchUC, byTaxonomyUC, listCategoriesUC, listTagsUC)
 }
 
 func provideAdminHandler(
 	dashboardUC *admin.GetDashboardUseCase,
 	listUsersUC *admin.ListUsersUseCase,
 	deleteUserUC *admin.DeleteUserUseCase,
+	listPostsUC *admin.ListPostsUseCase,
 	listCommentsUC *admin.ListCommentsUseCase,
 	deleteCommentUC *admin.DeleteCommentUseCase,
 	listCategoriesUC *admin.ListCategoriesUseCase,
@@ -141,6 +495,7 @@ func provideAdminHandler(
 		dashboardUC,
 		listUsersUC,
 		deleteUserUC,
+		listPostsUC,
 		listCommentsUC,
 		deleteCommentUC,
 		listCategoriesUC,
@@ -158,3 +513,23 @@ func provideNotificationHandler() *handler.NotificationHandler {
 
 	return handler.NewNotificationHandler(nil)
 }
+
+func provideTranslationHandler(
+	listPostTranslationsUC *admin.ListPostTranslationsUseCase,
+	upsertPostTranslationUC *admin.UpsertPostTranslationUseCase,
+	deletePostTranslationUC *admin.DeletePostTranslationUseCase,
+	upsertCategoryTranslationUC *admin.UpsertCategoryTranslationUseCase,
+	deleteCategoryTranslationUC *admin.DeleteCategoryTranslationUseCase,
+	upsertTagTranslationUC *admin.UpsertTagTranslationUseCase,
+	deleteTagTranslationUC *admin.DeleteTagTranslationUseCase,
+) *handler.TranslationHandler {
+	return handler.NewTranslationHandler(
+		listPostTranslationsUC,
+		upsertPostTranslationUC,
+		deletePostTranslationUC,
+		upsertCategoryTranslationUC,
+		deleteCategoryTranslationUC,
+		upsertTagTranslationUC,
+		deleteTagTranslationUC,
+	)
+}